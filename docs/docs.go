@@ -19,42 +19,67 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/admin/complaints": {
+        "/admin/analytics": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "returns the list of complaints",
+                "description": "Signups, new teachers, approved skills, lessons booked/started/completed/cancelled/rejected, cancellation and rejection rates and GMV (sum of prices of completed lessons) for days range: total and by days, weeks or months. Top categories by completed lessons and skill approval backlog with median approval time. Everything is counted by day (UTC) of event. Data is refreshed every few minutes, backlog is current",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "get complaint's list",
+                "summary": "Get platform analytics dashboard",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First day of range, YYYY-MM-DD (default 29 days before to)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of range, YYYY-MM-DD (default today)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "day",
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "description": "Grouping period (default day)",
+                        "name": "period",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/admin.getComplaintListResponse"
+                            "$ref": "#/definitions/admin.platformAnalyticsResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
@@ -68,26 +93,68 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/skills": {
+        "/admin/audit": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "returns the list of skills and have one flag unactive, if it's true, returns only anactive else =\u003e all (+ skill's teachers sort data as addtional list)",
+                "description": "returns one page of admin mutations (newest first) with before and after snapshots of changed entity. Use next_cursor from response as cursor param to get the next page (empty next_cursor means the last page)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "get skill's list (and their teachers sort data)",
+                "summary": "get admin audit log",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "flag for only unactive",
-                        "name": "unactive",
+                        "type": "integer",
+                        "description": "Admin's user ID",
+                        "name": "admin_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action, e.g. skill.approve",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entity type, e.g. skill",
+                        "name": "entity_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "From time inclusive (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To time exclusive (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the page",
+                        "name": "cursor",
                         "in": "query"
                     }
                 ],
@@ -95,7 +162,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/admin.getSkillListResponse"
+                            "$ref": "#/definitions/admin.auditLogResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
                     "401": {
@@ -119,48 +192,80 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/skills/{id}/approve": {
-            "put": {
+        "/admin/audit/export": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "handler for approving teacher's skill",
+                "description": "returns all admin mutations matching filters (newest first) as CSV file, at most 10000 entries (narrow time range to export more)",
                 "produces": [
-                    "application/json"
+                    "text/csv"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "approve teacher'skill",
+                "summary": "export admin audit log",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "skillID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Admin's user ID",
+                        "name": "admin_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Action, e.g. skill.approve",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entity type, e.g. skill",
+                        "name": "entity_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "From time inclusive (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To time exclusive (RFC3339)",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
@@ -174,45 +279,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/login": {
-            "post": {
-                "description": "Login with email and password",
-                "consumes": [
-                    "application/json"
+        "/admin/categories": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "returns all categories including archived ones, sorted by parent and position",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
-                ],
-                "summary": "Login user",
-                "parameters": [
-                    {
-                        "description": "Login Credentials",
-                        "name": "loginRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user.loginRequest"
-                        }
-                    }
+                    "admin"
                 ],
+                "summary": "get all categories",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user.authResponse"
+                            "$ref": "#/definitions/admin.getAdminCategoriesResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
@@ -224,11 +320,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/auth/signup": {
+            },
             "post": {
-                "description": "Register a new user (student) in the system",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "create category, it becomes subcategory if parent_id is set. New category is the last one among its siblings",
                 "consumes": [
                     "application/json"
                 ],
@@ -236,17 +335,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "Register new user",
+                "summary": "create category",
                 "parameters": [
                     {
-                        "description": "Registration Info",
-                        "name": "registrationRequest",
+                        "description": "Category data",
+                        "name": "createCategoryRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/user.registrationRequest"
+                            "$ref": "#/definitions/admin.createCategoryRequest"
                         }
                     }
                 ],
@@ -254,7 +353,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/user.authResponse"
+                            "$ref": "#/definitions/admin.createCategoryResponse"
                         }
                     },
                     "400": {
@@ -263,39 +362,22 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "413": {
-                        "description": "Request Entity Too Large"
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
-                    }
-                }
-            }
-        },
-        "/categories": {
-            "get": {
-                "description": "Get list of all categories",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "categories"
-                ],
-                "summary": "Get categories",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
-                            "$ref": "#/definitions/category.getCategoriesResponse"
+                            "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
                     "500": {
@@ -307,14 +389,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/complaint": {
-            "post": {
+        "/admin/categories/reorder": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creating a new complaint to user (reported_id =\u003e user_id which you would like to report)",
+                "description": "set order of subcategories of parent_id (root categories if parent_id is omitted). category_ids must contain all of them in new order",
                 "consumes": [
                     "application/json"
                 ],
@@ -322,23 +404,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "complaint"
+                    "admin"
                 ],
-                "summary": "Create a new complaint",
+                "summary": "reorder categories",
                 "parameters": [
                     {
-                        "description": "ComplaintData",
-                        "name": "createComplaintRequest",
+                        "description": "New order",
+                        "name": "reorderCategoriesRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/complaint.createComplaintRequest"
+                            "$ref": "#/definitions/admin.reorderCategoriesRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created"
+                    "200": {
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -352,8 +434,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
@@ -367,31 +449,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/image": {
-            "get": {
-                "description": "Get image by filename",
+        "/admin/categories/{id}": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "rename category, change its min_age or move it to another parent (parent_id 0 makes category root). Omitted fields are not changed, moved category becomes the last one among new siblings",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
-                    "image/*"
+                    "application/json"
                 ],
                 "tags": [
-                    "image"
+                    "admin"
                 ],
-                "summary": "Get image",
+                "summary": "update category",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "filename",
-                        "name": "filename",
-                        "in": "query",
+                        "type": "integer",
+                        "description": "categoryID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Changed category data",
+                        "name": "updateCategoryRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.updateCategoryRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Image file",
-                        "schema": {
-                            "type": "file"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -399,62 +495,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/httputils.ErrorStruct"
-                        }
-                    }
-                }
-            }
-        },
-        "/lesson": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Check is all data confirmed and if so create lesson request (pending state)",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "lessons"
-                ],
-                "summary": "Add new pending lesson (lesson request)",
-                "parameters": [
-                    {
-                        "description": "LessonData",
-                        "name": "bookLessonRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/lesson.bookLessonRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/httputils.ErrorStruct"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
@@ -480,72 +528,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/lessons/{id}": {
+        "/admin/categories/{id}/age-exceptions": {
             "get": {
-                "description": "Return lesson data by lesson's id",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "lessons"
-                ],
-                "summary": "Get lesson data by lesson's id",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "LessonID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/lesson.getLessonResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/httputils.ErrorStruct"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/httputils.ErrorStruct"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/httputils.ErrorStruct"
-                        }
-                    }
-                }
-            }
-        },
-        "/lessons/{id}/cancel": {
-            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Set lesson in cancel state if this user related to lesson",
+                "description": "returns users who are allowed to use category despite its min_age",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "lessons"
+                    "admin"
                 ],
-                "summary": "Cancel lesson",
+                "summary": "get category's age exceptions",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "LessonID",
+                        "description": "categoryID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -553,7 +554,10 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/admin.getAgeExceptionsResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -573,6 +577,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -580,30 +590,40 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/lessons/{id}/finish": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Set lesson in finished state if this user is a teacher to lesson and lesson state has been ongoing",
+                "description": "allow student to book lessons (or teacher to register skill) in category despite its min_age. Granting existing exception isn't error",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "lessons"
+                    "admin"
                 ],
-                "summary": "Finished lesson",
+                "summary": "grant age exception",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "LessonID",
+                        "description": "categoryID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "User",
+                        "name": "grantAgeExceptionRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.grantAgeExceptionRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -643,36 +663,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/lessons/{id}/join": {
-            "get": {
+        "/admin/categories/{id}/age-exceptions/{user_id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "generate meet token to join \"ongoing\" lesson (if user related to lesson)",
+                "description": "remove user's exception from category's min_age, already booked lessons are kept",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "lessons"
+                    "admin"
                 ],
-                "summary": "Join the lesson",
+                "summary": "revoke age exception",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "LessonID",
+                        "description": "categoryID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "userID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/lesson.connectLessonResponse"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -692,6 +716,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -701,25 +731,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/lessons/{id}/plan": {
+        "/admin/categories/{id}/archive": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Set lesson in planned state if this user is a teacher to lesson and lesson was pending",
+                "description": "archive category with all its subcategories. Existing skills and lessons are kept, but category is hidden from new skill registrations and catalogue filters",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "lessons"
+                    "admin"
                 ],
-                "summary": "set lesson in planned state",
+                "summary": "archive category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "LessonID",
+                        "description": "categoryID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -762,25 +792,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/lessons/{id}/reject": {
+        "/admin/categories/{id}/restore": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Set reject new (pending) lesson if this user is a teacher to lesson",
+                "description": "restore archived category with all its subcategories, parent category must not be archived",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "lessons"
+                    "admin"
                 ],
-                "summary": "set lesson in rejected state",
+                "summary": "restore category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "LessonID",
+                        "description": "categoryID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -814,6 +844,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -823,40 +859,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/lessons/{id}/short-data": {
+        "/admin/certificates": {
             "get": {
-                "description": "Return lesson short data by lesson's id",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "returns certificates which wait for verification (+ certificate's teachers sort data as addtional list). File can be got by /api/admin/certificates/{certificate_id}/file",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "lessons"
-                ],
-                "summary": "Get lesson really short data by lesson's id",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "LessonID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "admin"
                 ],
+                "summary": "get unverified certificates (and their teachers sort data)",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/lesson.getLessonShortDataResponse"
+                            "$ref": "#/definitions/admin.getCertificateListResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
@@ -870,25 +902,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/lessons/{id}/start": {
-            "put": {
+        "/admin/certificates/{id}/file": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "set lesson in ongoing state and generate meet token.",
+                "description": "download file of any certificate, also not verified one (for verification)",
                 "produces": [
-                    "application/json"
+                    "application/pdf",
+                    "image/png",
+                    "image/jpeg"
                 ],
                 "tags": [
-                    "lessons"
+                    "admin"
                 ],
-                "summary": "Start lesson",
+                "summary": "get certificate file",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "LessonID",
+                        "description": "Certificate ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -896,9 +930,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Certificate file",
                         "schema": {
-                            "$ref": "#/definitions/lesson.connectLessonResponse"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -919,6 +953,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -928,38 +968,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/review": {
-            "post": {
+        "/admin/certificates/{id}/verify": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create review if authorized user (student) had lesson with this teacher and this category",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "handler for verifying teacher's certificate",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "reviews"
+                    "admin"
                 ],
-                "summary": "Create review",
+                "summary": "verify teacher's certificate",
                 "parameters": [
                     {
-                        "description": "Review data",
-                        "name": "addReviewRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/review.addReviewRequest"
-                        }
+                        "type": "integer",
+                        "description": "certificateID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created"
+                    "200": {
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1000,26 +1035,58 @@ const docTemplate = `{
                 }
             }
         },
-        "/student/lessons": {
+        "/admin/complaints": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Return all lessons which have student",
+                "description": "returns one page of complaints (newest first) with their state, assignee and resolution. Use next_cursor from response as cursor param to get the next page (empty next_cursor means the last page)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "admin"
                 ],
-                "summary": "Get lessons for students",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/lesson.getStudentLessonsResponse"
+                "summary": "get complaint's list",
+                "parameters": [
+                    {
+                        "enum": [
+                            "open",
+                            "in_review",
+                            "resolved",
+                            "dismissed"
+                        ],
+                        "type": "string",
+                        "description": "Complaint state",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Assignee: admin's user ID or me",
+                        "name": "assignee",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/admin.getComplaintListResponse"
                         }
                     },
                     "400": {
@@ -1034,6 +1101,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1043,68 +1116,57 @@ const docTemplate = `{
                 }
             }
         },
-        "/teacher": {
+        "/admin/complaints/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all info about teacher (user info + teacher + his skills) by user id in token",
+                "description": "get complaint with its state, assignee, resolution, evidence attachments and internal admin's notes. Complaint about lesson is returned with the lesson and its state transition history",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teachers"
+                    "admin"
+                ],
+                "summary": "get complaint",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Complaint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get teacher data",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/teacher.getTeacherResponse"
+                            "$ref": "#/definitions/admin.getComplaintResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get user id by jwt token, and he became teach (if he was not be registrate himself as teacher)",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "teachers"
-                ],
-                "summary": "User registrate also as teacher",
-                "responses": {
-                    "201": {
-                        "description": "Created"
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
@@ -1118,27 +1180,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/teacher/lessons": {
-            "get": {
+        "/admin/complaints/{id}/assign": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Return all lessons which have teacher",
+                "description": "assign complaint to current admin. Open complaint is taken in review, complaint in review is reassigned",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teachers"
+                    "admin"
+                ],
+                "summary": "assign complaint to yourself",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Complaint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get lessons for teachers",
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/lesson.getTeacherLessonsResponse"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1158,6 +1226,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1167,26 +1247,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/teacher/schedule": {
+        "/admin/complaints/{id}/attachments/{attachment_id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get lessons times from teacher schedule",
+                "description": "download evidence file attached to complaint",
                 "produces": [
-                    "application/json"
+                    "application/pdf",
+                    "image/png",
+                    "image/jpeg",
+                    "image/webp"
                 ],
                 "tags": [
-                    "teachers"
+                    "admin"
+                ],
+                "summary": "get complaint attachment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Complaint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Attachment ID",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get times from schedule",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Attachment file",
                         "schema": {
-                            "$ref": "#/definitions/schedule.getTimesResponse"
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
                     "401": {
@@ -1195,6 +1300,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1208,14 +1319,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/complaints/{id}/notes": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Add time to teacher schedule",
+                "description": "add internal admin's note to complaint, complainer never sees it",
                 "consumes": [
                     "application/json"
                 ],
@@ -1223,23 +1336,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "teachers"
+                    "admin"
                 ],
-                "summary": "Add time to schedule",
+                "summary": "add note to complaint",
                 "parameters": [
                     {
-                        "description": "datetime",
-                        "name": "addTimeRequest",
+                        "type": "integer",
+                        "description": "Complaint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Note",
+                        "name": "addComplaintNoteRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schedule.addTimeRequest"
+                            "$ref": "#/definitions/admin.addComplaintNoteRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created"
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/admin.addComplaintNoteResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1259,8 +1382,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
@@ -1274,14 +1397,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/teacher/skill": {
-            "post": {
+        "/admin/complaints/{id}/resolve": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Registrate new skill for teacher (if he not exists create and registrate skill)",
+                "description": "close complaint as resolved (only in review) or dismissed with resolution. Complainer gets notification with resolution",
                 "consumes": [
                     "application/json"
                 ],
@@ -1289,23 +1412,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "teachers"
+                    "admin"
                 ],
-                "summary": "Registrate new skill",
+                "summary": "resolve complaint",
                 "parameters": [
                     {
-                        "description": "Skill data",
-                        "name": "addSkillRequest",
+                        "type": "integer",
+                        "description": "Complaint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Resolution",
+                        "name": "resolveComplaintRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/teacher.addSkillRequest"
+                            "$ref": "#/definitions/admin.resolveComplaintRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created"
+                    "200": {
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1319,6 +1449,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
                     "409": {
                         "description": "Conflict",
                         "schema": {
@@ -1334,32 +1476,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/teachers": {
+        "/admin/moderation": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get full teachers data (their user data, teacher data and skills)",
+                "description": "returns one page of pending user texts flagged by moderation (oldest first) with reasons. entity_id is id of review, complaint, skill, teacher, user, certificate or review report by kind. Use next_cursor from response as cursor param to get the next page (empty next_cursor means the last page)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teachers"
+                    "admin"
                 ],
-                "summary": "Get full teachers data",
+                "summary": "get content moderation queue",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Filter my teachers",
-                        "name": "is_mine",
+                        "enum": [
+                            "review",
+                            "review_reply",
+                            "complaint",
+                            "skill_about",
+                            "teacher_profile",
+                            "user_name",
+                            "certificate",
+                            "review_report"
+                        ],
+                        "type": "string",
+                        "description": "Content kind",
+                        "name": "kind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter category",
-                        "name": "category",
+                        "description": "Cursor of the page",
+                        "name": "cursor",
                         "in": "query"
                     }
                 ],
@@ -1367,7 +1525,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/teacher.getTeachersResponse"
+                            "$ref": "#/definitions/admin.moderationQueueResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
                     "401": {
@@ -1376,6 +1540,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1385,38 +1555,76 @@ const docTemplate = `{
                 }
             }
         },
-        "/teachers/{id}": {
-            "get": {
-                "description": "Get all info about teacher (user info + teacher + his skills) by his TeacherID in route (/api/teachers/{id})",
+        "/admin/moderation/{id}/decide": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "closes pending item of moderation queue: approved (text is fine) or violation (text breaks rules; act on it with review moderation, complaints or suspensions)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teachers"
+                    "admin"
                 ],
-                "summary": "Get teacher data",
+                "summary": "decide on flagged content",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Teacher's ID",
+                        "description": "Moderation queue item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Decision",
+                        "name": "decideModerationRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.decideModerationRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/teacher.getTeacherResponse"
+                            "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "404": {
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1426,40 +1634,79 @@ const docTemplate = `{
                 }
             }
         },
-        "/teachers/{id}/reviews": {
+        "/admin/reviews/moderation": {
             "get": {
-                "description": "Get all reviews about teacher",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "get reviews and teacher replies (target) with pending reports, most reported first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teachers"
+                    "admin"
                 ],
-                "summary": "Get reviews",
-                "parameters": [
+                "summary": "get review moderation queue",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/admin.reviewModerationQueueResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/reviews/repair-ratings": {
+            "post": {
+                "security": [
                     {
-                        "type": "integer",
-                        "description": "Teacher ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "BearerAuth": []
                     }
                 ],
+                "description": "recompute reviews count, total score and rate of all skills and teachers from reviews, returns every fixed drift (old and new values)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "repair rating aggregates",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/review.getReviewResponse"
+                            "$ref": "#/definitions/admin.repairRatingsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
@@ -1473,30 +1720,56 @@ const docTemplate = `{
                 }
             }
         },
-        "/teachers/{id}/schedule": {
-            "get": {
-                "description": "Get lessons times from teacher schedule (by teacher ID)",
+        "/admin/reviews/{id}/moderate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "hide, restore or delete review or teacher reply (target: review (default) or reply) with reason. Pending reports are resolved, author gets notification with reason",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teachers"
+                    "admin"
                 ],
-                "summary": "Get times from schedule",
+                "summary": "moderate review",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Teacher's ID",
+                        "description": "Review ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Moderation decision",
+                        "name": "moderateReviewRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.moderateReviewRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/schedule.getTimesResponse"
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
                     "403": {
@@ -1520,26 +1793,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/is-admin": {
+        "/admin/roles": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Return boolean value is user an admin or not",
+                "description": "returns all admin roles with permissions given by them",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Return boolean value is user an admin",
+                "summary": "get roles",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user.BoolResponse"
+                            "$ref": "#/definitions/admin.getRolesResponse"
                         }
                     },
                     "401": {
@@ -1548,6 +1821,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1557,26 +1836,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/profile": {
+        "/admin/skills": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get info about user by jwt token (in Authorization enter: Bearer \u003cyour_jwt_token\u003e)",
+                "description": "returns the list of skills and have one flag unactive, if it's true, returns only anactive else =\u003e all (+ skill's teachers sort data as addtional list)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
+                ],
+                "summary": "get skill's list (and their teachers sort data)",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "flag for only unactive",
+                        "name": "unactive",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get user profile",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user.getUserResponse"
+                            "$ref": "#/definitions/admin.getSkillListResponse"
                         }
                     },
                     "401": {
@@ -1585,6 +1872,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1592,53 +1885,53 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/admin/skills/{id}/approve": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Edit base data about user (optional fields)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "handler for approving teacher's skill",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Edit user",
+                "summary": "approve teacher'skill",
                 "parameters": [
                     {
-                        "description": "Update Info",
-                        "name": "editUserRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user.editUserRequest"
-                        }
+                        "type": "integer",
+                        "description": "skillID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK"
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
-                    "413": {
-                        "description": "Request Entity Too Large"
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -1649,30 +1942,67 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}/profile": {
+        "/admin/skills/{id}/video": {
             "get": {
-                "description": "Get info about user by user id in route (/api/users/{id}/profile)",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "stream uploaded video card of any skill, also not approved one (for approval). Supports HTTP range requests for seeking",
                 "produces": [
-                    "application/json"
+                    "video/mp4",
+                    "video/quicktime",
+                    "video/webm"
                 ],
                 "tags": [
-                    "users"
+                    "admin"
                 ],
-                "summary": "Get user profile",
+                "summary": "preview skill's video card",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
+                        "description": "Skill ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Range of bytes, e.g. bytes=0-1023",
+                        "name": "Range",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Video card",
                         "schema": {
-                            "$ref": "#/definitions/user.getUserResponse"
+                            "type": "file"
+                        }
+                    },
+                    "206": {
+                        "description": "Part of video card",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
                     "404": {
@@ -1681,6 +2011,9 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputils.ErrorStruct"
                         }
                     },
+                    "416": {
+                        "description": "Requested Range Not Satisfiable"
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1689,280 +2022,5775 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "admin.getComplaintListResponse": {
-            "type": "object",
-            "properties": {
-                "complaints": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/admin.respComplaint"
-                    }
-                }
-            }
         },
-        "admin.getSkillListResponse": {
-            "type": "object",
-            "properties": {
-                "skills": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/admin.respSkill"
+        "/admin/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "returns one page of users (newest first) whose id equals query or whose email, name or surname contain it, empty query returns all users. Use next_cursor from response as cursor param to get the next page (empty next_cursor means the last page)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "search users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID, part of email, name or surname",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/admin.searchUsersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "returns user with his roles, lessons (as student and as teacher, newest first), skills, complaints filed and received and all suspensions and bans including lifted ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "get user for admin",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/admin.userOverviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}/avatar": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "replace user's avatar with default one, uploaded avatar is deleted",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "reset user's avatar",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}/email-verification": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "make user confirm his email again: he gets letter with verification token (see /auth/email/confirm), his sessions are revoked and he can't log in until he confirms email. email_verification_required flag is shown in his profile",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "force email re-verification",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "move lessons, reviews, complaints, favourites, notifications, sanctions and teacher profile of duplicate account to user and delete duplicate account. Duplicate favourites of the same teacher are dropped. Admins, suspended users, two teachers and users having lessons with each other can't be merged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "merge duplicate account into user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID (kept account)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Duplicate account",
+                        "name": "mergeUserRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.mergeUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}/roles": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "give admin role to user, user gets access to admin routes allowed by role's permissions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "assign role to user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role",
+                        "name": "assignRoleRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.assignRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}/roles/{role}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "take admin role from user. The last superadmin can't lose superadmin role",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "revoke role from user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role name",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}/suspend": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "suspend user until date or ban permanently with reason, current suspension is replaced. Tokens of suspended user are rejected immediately. Teacher's skills are hidden from catalogue, his future lessons are cancelled (pending ones are rejected) and students are notified",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "suspend or ban user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Suspension (until or permanent)",
+                        "name": "suspendUserRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.suspendUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}/suspension": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "lift current suspension or ban of user. Cancelled lessons are not restored",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "lift user suspension",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/email/confirm": {
+            "post": {
+                "description": "Confirm email with token from the letter sent when admin required email verification, then user can log in again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Confirm email",
+                "parameters": [
+                    {
+                        "description": "Verification token",
+                        "name": "confirmEmailRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.confirmEmailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "Login with email and password, starts new session. Suspended and banned users and users who have to confirm email (required by admin) are rejected",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Login user",
+                "parameters": [
+                    {
+                        "description": "Login Credentials",
+                        "name": "loginRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.loginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.authResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/logout": {
+            "post": {
+                "description": "End session of refresh token: its access and refresh tokens stop working immediately. With all_sessions user is logged out on all devices (e.g. phone is stolen), then refresh token must be valid (current one)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Logout",
+                "parameters": [
+                    {
+                        "description": "Refresh token of session",
+                        "name": "logoutRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.logoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "description": "Exchange refresh token for new access and refresh tokens. Refresh token can be used only once: using it again revokes the whole session (token is considered stolen). Suspended and banned users are rejected",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Refresh tokens",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "refreshRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.refreshRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.authResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/signup": {
+            "post": {
+                "description": "Register a new user (student) in the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Register new user",
+                "parameters": [
+                    {
+                        "description": "Registration Info",
+                        "name": "registrationRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.registrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/user.authResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list of not archived categories, subcategories have parent_id, categories are sorted by position. Token is optional, with token categories its user is too young for are marked by is_too_young",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Get categories",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/category.getCategoriesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/complaint": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creating a new complaint to user (reported_id =\u003e user_id which you would like to report). Complaint can be about a lesson (lesson_id) you took part in, then reported_id may be omitted (the other participant is reported). Up to 5 evidence attachments (base64 encoded pdf, png, jpeg or webp, max 5MB each)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "complaint"
+                ],
+                "summary": "Create a new complaint",
+                "parameters": [
+                    {
+                        "description": "ComplaintData",
+                        "name": "createComplaintRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/complaint.createComplaintRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/complaint.createComplaintResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/image": {
+            "get": {
+                "description": "Get image by filename",
+                "produces": [
+                    "image/*"
+                ],
+                "tags": [
+                    "image"
+                ],
+                "summary": "Get image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "filename",
+                        "name": "filename",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Image file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/lesson": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Check is all data confirmed and if so create lesson request (pending state)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lessons"
+                ],
+                "summary": "Add new pending lesson (lesson request)",
+                "parameters": [
+                    {
+                        "description": "LessonData",
+                        "name": "bookLessonRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/lesson.bookLessonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/lessons/{id}": {
+            "get": {
+                "description": "Return lesson data by lesson's id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lessons"
+                ],
+                "summary": "Get lesson data by lesson's id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "LessonID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/lesson.getLessonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/lessons/{id}/cancel": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set lesson in cancelled state if this user related to lesson",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lessons"
+                ],
+                "summary": "Cancel lesson",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "LessonID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/lessons/{id}/finish": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set lesson in finished state if this user is a teacher to lesson and lesson state has been ongoing",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lessons"
+                ],
+                "summary": "Finished lesson",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "LessonID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/lessons/{id}/join": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "generate meet token to join \"ongoing\" lesson (if user related to lesson)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lessons"
+                ],
+                "summary": "Join the lesson",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "LessonID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/lesson.connectLessonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/lessons/{id}/plan": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set lesson in planned state if this user is a teacher to lesson and lesson was pending",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lessons"
+                ],
+                "summary": "set lesson in planned state",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "LessonID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/lessons/{id}/reject": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set reject new (pending) lesson if this user is a teacher to lesson",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lessons"
+                ],
+                "summary": "set lesson in rejected state",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "LessonID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/lessons/{id}/short-data": {
+            "get": {
+                "description": "Return lesson short data by lesson's id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lessons"
+                ],
+                "summary": "Get lesson really short data by lesson's id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "LessonID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/lesson.getLessonShortDataResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/lessons/{id}/start": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "set lesson in ongoing state and generate meet token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lessons"
+                ],
+                "summary": "Start lesson",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "LessonID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/lesson.connectLessonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get notifications of user (id from token) from newest to oldest. Types: review_replied, review_reply_updated, review_hidden, review_restored, review_deleted, review_reply_hidden, review_reply_restored, review_reply_deleted (entity_id is review id, message is moderation reason)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Get notifications",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Return only unread notifications",
+                        "name": "unread",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/notification.getNotificationsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{id}/read": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark notification of user (id from token) as read",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Mark notification read",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Notification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/review": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create review about finished lesson of authorized user (student), only one review per lesson",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Create review",
+                "parameters": [
+                    {
+                        "description": "Review data",
+                        "name": "addReviewRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/review.addReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/review/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change rate and comment of own review, it's possible within 14 days since review creation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Edit review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New review data",
+                        "name": "updateReviewRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/review.updateReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete own review, it's possible within 14 days since review creation",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Delete review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/review/{id}/reply": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Teacher (user id from token) changes his reply to review about him. Review author gets notification",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Edit reply to review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New reply data",
+                        "name": "reviewReplyRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/review.reviewReplyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Teacher (user id from token) posts public reply to review about him, only one reply per review. Review author gets notification",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Reply to review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reply data",
+                        "name": "reviewReplyRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/review.reviewReplyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/review/{id}/report": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Report review or teacher's reply to it (target: review (default) or reply) for moderation, one report per user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Report review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Report data",
+                        "name": "reportReviewRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/review.reportReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/student/lessons": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Return all lessons which have student",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "Get lessons for students",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/lesson.getStudentLessonsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/student/progress": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Timeline of studied hours by weeks (UTC, weeks start on monday) and categories for days range, and for all time: totals, streaks (weeks in a row with finished lessons), teachers and reached milestones. Hours are counted by actual lesson duration (1 hour if unknown)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lessons"
+                ],
+                "summary": "Get student's learning progress",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First day of range, YYYY-MM-DD (default 83 days before to)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of range, YYYY-MM-DD (default today)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/lesson.getStudentProgressResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teacher": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all info about teacher (user info + teacher + his skills) by user id in token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Get teacher data",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/teacher.getTeacherResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get user id by jwt token, and he became teach (if he was not be registrate himself as teacher)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "User registrate also as teacher",
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teacher/analytics": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lessons, earnings, cancellation/rejection/no-show rates, repeat students and average rating of teacher (user id from token) for days range: total, by weeks or months and by categories. Lessons are counted by day (UTC) of their time, reviews by day of creation. No-show is a planned lesson which was never started or was cancelled after its start. Data is refreshed every few minutes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Get teacher's analytics dashboard",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First day of range, YYYY-MM-DD (default 89 days before to)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of range, YYYY-MM-DD (default today)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "description": "Grouping period (default week)",
+                        "name": "period",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/teacher.getAnalyticsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teacher/certificates": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload certificate file (base64 encoded pdf, png or jpeg, max 5MB) of teacher (user id from token). Certificate is unverified until admin verifies it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Add certificate",
+                "parameters": [
+                    {
+                        "description": "Certificate data",
+                        "name": "addCertificateRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/teacher.addCertificateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/teacher.addCertificateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teacher/certificates/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete certificate (and its file) of teacher (user id from token)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Delete certificate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Certificate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teacher/certificates/{id}/file": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get file of certificate (also not verified yet) of teacher (user id from token)",
+                "produces": [
+                    "application/pdf",
+                    "image/png",
+                    "image/jpeg"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Get own certificate file",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Certificate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Certificate file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teacher/lessons": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Return all lessons which have teacher",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Get lessons for teachers",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/lesson.getTeacherLessonsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teacher/profile": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace headline, bio, languages (level is one of A1, A2, B1, B2, C1, C2, native) and education of teacher (user id from token)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Update teacher's profile",
+                "parameters": [
+                    {
+                        "description": "Profile data",
+                        "name": "updateProfileRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/teacher.updateProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teacher/schedule": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get lessons times from teacher schedule",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Get times from schedule",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule.getTimesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add time to teacher schedule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Add time to schedule",
+                "parameters": [
+                    {
+                        "description": "datetime",
+                        "name": "addTimeRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule.addTimeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teacher/skill": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registrate new skill for teacher (if he not exists create and registrate skill). video_card_link is optional (deprecated), upload video card file by /api/teacher/skills/{id}/video",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Registrate new skill",
+                "parameters": [
+                    {
+                        "description": "Skill data",
+                        "name": "addSkillRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/teacher.addSkillRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teacher/skills/{id}/video": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get uploaded video card of teacher's (user id from token) skill, also not approved yet. Supports HTTP range requests for seeking",
+                "produces": [
+                    "video/mp4",
+                    "video/quicktime",
+                    "video/webm"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Get own skill's video card",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Range of bytes, e.g. bytes=0-1023",
+                        "name": "Range",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Video card",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "206": {
+                        "description": "Part of video card",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "416": {
+                        "description": "Requested Range Not Satisfiable"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload video card file (mp4, mov or webm, max 100MB, from 5 seconds to 3 minutes) of teacher's skill. Skill becomes inactive until admin approves it again",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Upload skill's video card",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Video card file",
+                        "name": "video",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teachers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get one page of teachers data (their user data, teacher data and skills matched by filters). Use next_cursor from response as cursor param to get the next page (empty next_cursor means the last page)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Get full teachers data",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Filter my teachers",
+                        "name": "is_mine",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter categories (can be repeated)",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimal teacher's rating",
+                        "name": "min_rating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimal skill price",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximal skill price",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Has an available schedule time within the next N hours",
+                        "name": "available_within",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ranking",
+                            "rating",
+                            "reviews",
+                            "lessons",
+                            "price",
+                            "newest"
+                        ],
+                        "type": "string",
+                        "description": "Sorting field (default ranking: Bayesian average of reviews rate, of the best matched skill if categories are chosen; rating is raw average)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sorting order (default: asc for price, desc for others)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/teacher.getTeachersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teachers/certificates/{id}/file": {
+            "get": {
+                "description": "Get file of teacher's verified certificate by certificate ID",
+                "produces": [
+                    "application/pdf",
+                    "image/png",
+                    "image/jpeg"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Get certificate file",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Certificate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Certificate file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teachers/favorites": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get one page of favorite teachers of user in token. Supports the same filters, sorting and cursor pagination as teachers catalogue",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Get favorite teachers",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter categories (can be repeated)",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimal teacher's rating",
+                        "name": "min_rating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimal skill price",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximal skill price",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Has an available schedule time within the next N hours",
+                        "name": "available_within",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ranking",
+                            "rating",
+                            "reviews",
+                            "lessons",
+                            "price",
+                            "newest"
+                        ],
+                        "type": "string",
+                        "description": "Sorting field (default ranking: Bayesian average of reviews rate, rating is raw average)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sorting order (default: asc for price, desc for others)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/teacher.getTeachersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teachers/recommendations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Teachers ranked for current student by categories he booked or reviewed, teachers of students with similar lessons, rating and free time in the near future. Teachers with finished lessons are excluded. Reason explains the main signal (e.g. \"because you studied Maths\")",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Recommended teachers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Max count of teachers (default 10, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/teacher.recommendTeachersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teachers/search": {
+            "get": {
+                "description": "Full-text search of teachers by name, surname, skills description and categories. Every word is matched as prefix (can be used for autocomplete). Results are ranked, headline contains matched fragments highlighted with \u003cb\u003e\u003c/b\u003e",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Search teachers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max count of results (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/teacher.searchTeachersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teachers/skills/{id}/video": {
+            "get": {
+                "description": "Get uploaded video card of active (approved) skill. Supports HTTP range requests for seeking",
+                "produces": [
+                    "video/mp4",
+                    "video/quicktime",
+                    "video/webm"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Get skill's video card",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Range of bytes, e.g. bytes=0-1023",
+                        "name": "Range",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Video card",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "206": {
+                        "description": "Part of video card",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "416": {
+                        "description": "Requested Range Not Satisfiable"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teachers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all info about teacher (user info + teacher + his skills) by his TeacherID in route (/api/teachers/{id}). Token is optional, with token is_favorite is filled for its user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Get teacher data",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Teacher's ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/teacher.getTeacherResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teachers/{id}/favorite": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Bookmark teacher by TeacherID for user in token (adding already favorite teacher is not an error)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Add teacher to favorites",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Teacher's ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove bookmark of teacher by TeacherID for user in token (removing not favorite teacher is not an error)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Remove teacher from favorites",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Teacher's ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teachers/{id}/reviews": {
+            "get": {
+                "description": "Get one page of reviews about teacher with teacher's replies and reviewed lesson time. Use next_cursor from response as cursor param to get the next page (empty next_cursor means the last page). Summary (rates distribution and averages by categories) is about all teacher's reviews. If rating weighting is enabled, weighted_rate is teacher rate where reviews of recent lessons weigh more",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Get reviews",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Teacher ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by category",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "highest",
+                            "lowest"
+                        ],
+                        "type": "string",
+                        "description": "Sorting (default newest), reviews with the same rate are ordered from newest",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/review.getReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/teachers/{id}/schedule": {
+            "get": {
+                "description": "Get lessons times from teacher schedule (by teacher ID)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teachers"
+                ],
+                "summary": "Get times from schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Teacher's ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule.getTimesResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/is-admin": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Return boolean value is user an admin or not (has any role), his roles and permissions given by them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Return boolean value is user an admin",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.BoolResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/profile": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get info about user by jwt token (in Authorization enter: Bearer \u003cyour_jwt_token\u003e)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user profile",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.getUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Edit base data about user (optional fields)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Edit user",
+                "parameters": [
+                    {
+                        "description": "Update Info",
+                        "name": "editUserRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.editUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/profile": {
+            "get": {
+                "description": "Get info about user by user id in route (/api/users/{id}/profile)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user profile",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.getUserResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputils.ErrorStruct"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "admin.addComplaintNoteRequest": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "text": {
+                    "type": "string",
+                    "example": "asked reported user for explanation"
+                }
+            }
+        },
+        "admin.addComplaintNoteResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "admin.assignRoleRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "example": "support"
+                }
+            }
+        },
+        "admin.auditLogResponse": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respAuditEntry"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJpZCI6MTJ9"
+                }
+            }
+        },
+        "admin.createCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "min_age": {
+                    "type": "integer",
+                    "example": 16
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Business English"
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "admin.createCategoryResponse": {
+            "description": "id of created category.",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 13
+                }
+            }
+        },
+        "admin.decideModerationRequest": {
+            "type": "object",
+            "required": [
+                "decision"
+            ],
+            "properties": {
+                "decision": {
+                    "type": "string",
+                    "example": "approved"
+                }
+            }
+        },
+        "admin.getAdminCategoriesResponse": {
+            "description": "all categories getAdminCategoriesResponse.",
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respAdminCategory"
+                    }
+                }
+            }
+        },
+        "admin.getAgeExceptionsResponse": {
+            "description": "category's age exceptions getAgeExceptionsResponse.",
+            "type": "object",
+            "properties": {
+                "exceptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respAgeException"
+                    }
+                }
+            }
+        },
+        "admin.getCertificateListResponse": {
+            "type": "object",
+            "properties": {
+                "certificates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respCertificate"
+                    }
+                },
+                "teachers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respTeacherShortData"
+                    }
+                }
+            }
+        },
+        "admin.getComplaintListResponse": {
+            "type": "object",
+            "properties": {
+                "complaints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respComplaint"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJpZCI6MTJ9"
+                }
+            }
+        },
+        "admin.getComplaintResponse": {
+            "type": "object",
+            "properties": {
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respComplaintAttachment"
+                    }
+                },
+                "complaint": {
+                    "$ref": "#/definitions/admin.respComplaint"
+                },
+                "lesson": {
+                    "$ref": "#/definitions/admin.respComplaintLesson"
+                },
+                "notes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respComplaintNote"
+                    }
+                }
+            }
+        },
+        "admin.getRolesResponse": {
+            "type": "object",
+            "properties": {
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respRole"
+                    }
+                }
+            }
+        },
+        "admin.getSkillListResponse": {
+            "type": "object",
+            "properties": {
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respSkill"
+                    }
+                },
+                "teachers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respTeacherShortData"
+                    }
+                }
+            }
+        },
+        "admin.grantAgeExceptionRequest": {
+            "type": "object",
+            "properties": {
+                "user_id": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "admin.mergeUserRequest": {
+            "type": "object",
+            "required": [
+                "source_user_id"
+            ],
+            "properties": {
+                "source_user_id": {
+                    "description": "duplicate account, it is deleted",
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "admin.moderateReviewRequest": {
+            "type": "object",
+            "required": [
+                "action",
+                "reason"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "hide"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "offensive language"
+                },
+                "target": {
+                    "type": "string",
+                    "example": "review"
+                }
+            }
+        },
+        "admin.moderationQueueResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respModerationQueueItem"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJpZCI6MTJ9"
+                }
+            }
+        },
+        "admin.platformAnalyticsResponse": {
+            "description": "platform dashboard platformAnalyticsResponse.",
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string",
+                    "example": "2025-01-01"
+                },
+                "period": {
+                    "type": "string",
+                    "example": "day"
+                },
+                "periods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respPlatformPeriod"
+                    }
+                },
+                "skill_approval": {
+                    "$ref": "#/definitions/admin.respSkillApproval"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-01-30"
+                },
+                "top_categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respPlatformCategory"
+                    }
+                },
+                "total": {
+                    "$ref": "#/definitions/admin.respPlatformStats"
+                }
+            }
+        },
+        "admin.reorderCategoriesRequest": {
+            "type": "object",
+            "properties": {
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        14,
+                        13,
+                        15
+                    ]
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "admin.repairRatingsResponse": {
+            "description": "fixed drifts repairRatingsResponse.",
+            "type": "object",
+            "properties": {
+                "drifts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respRatingDrift"
+                    }
+                }
+            }
+        },
+        "admin.resolveComplaintRequest": {
+            "type": "object",
+            "required": [
+                "resolution",
+                "state"
+            ],
+            "properties": {
+                "resolution": {
+                    "type": "string",
+                    "example": "user was warned"
+                },
+                "state": {
+                    "type": "string",
+                    "example": "resolved"
+                }
+            }
+        },
+        "admin.respAdminCategory": {
+            "description": "data of respAdminCategory.",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 13
+                },
+                "is_archived": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "min_age": {
+                    "type": "integer",
+                    "example": 16
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Business English"
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "admin.respAdminLesson": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "category_name": {
+                    "type": "string",
+                    "example": "Programming"
+                },
+                "datetime": {
+                    "type": "string",
+                    "example": "2025-02-01T09:00:00Z"
+                },
+                "lesson_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "status": {
+                    "type": "string",
+                    "example": "finished"
+                },
+                "student_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "teacher_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "admin.respAdminUser": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string",
+                    "example": "uuid.png"
+                },
+                "birthdate": {
+                    "type": "string",
+                    "example": "2002-09-09T10:10:10+09:00"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "qwerty@example.com"
+                },
+                "email_verification_required": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "registration_date": {
+                    "type": "string",
+                    "example": "2022-09-09T10:10:10+09:00"
+                },
+                "surname": {
+                    "type": "string",
+                    "example": "Smith"
+                }
+            }
+        },
+        "admin.respAgeException": {
+            "description": "data of respAgeException.",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T12:00:00Z"
+                },
+                "granted_by": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "admin.respAuditEntry": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "skill.approve"
+                },
+                "admin_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "after": {
+                    "type": "object"
+                },
+                "before": {
+                    "type": "object"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-09T10:10:10Z"
+                },
+                "entity_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "entity_type": {
+                    "type": "string",
+                    "example": "skill"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "host/abcdef-000001"
+                }
+            }
+        },
+        "admin.respCertificate": {
+            "type": "object",
+            "properties": {
+                "certificate_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T10:10:10Z"
+                },
+                "teacher_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "title": {
+                    "type": "string",
+                    "example": "IELTS 8.0"
+                }
+            }
+        },
+        "admin.respComplaint": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "complainer_avatar": {
+                    "type": "string",
+                    "example": "uuid.png"
+                },
+                "complainer_email": {
+                    "type": "string",
+                    "example": "test@test.com"
+                },
+                "complainer_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "complainer_name": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "complainer_surname": {
+                    "type": "string",
+                    "example": "Smith"
+                },
+                "complaint_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-01-09T10:10:10+09:00"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "description"
+                },
+                "lesson_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "reason"
+                },
+                "reported_avatar": {
+                    "type": "string",
+                    "example": "uuid.png"
+                },
+                "reported_email": {
+                    "type": "string",
+                    "example": "test@test.com"
+                },
+                "reported_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reported_name": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "reported_surname": {
+                    "type": "string",
+                    "example": "Smith"
+                },
+                "resolution": {
+                    "type": "string",
+                    "example": "user was warned"
+                },
+                "resolved_at": {
+                    "type": "string",
+                    "example": "2025-01-10T10:10:10+09:00"
+                },
+                "resolved_by": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "state": {
+                    "type": "string",
+                    "example": "in_review"
+                }
+            }
+        },
+        "admin.respComplaintAttachment": {
+            "type": "object",
+            "properties": {
+                "attachment_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-09T10:10:10Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "/api/admin/complaints/1/attachments/1"
+                }
+            }
+        },
+        "admin.respComplaintLesson": {
+            "type": "object",
+            "properties": {
+                "category_name": {
+                    "type": "string",
+                    "example": "Programming"
+                },
+                "datetime": {
+                    "type": "string",
+                    "example": "2025-01-09T10:00:00Z"
+                },
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respStateTransition"
+                    }
+                },
+                "lesson_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "student_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "teacher_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "admin.respComplaintNote": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-09T10:10:10Z"
+                },
+                "note_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "text": {
+                    "type": "string",
+                    "example": "asked reported user for explanation"
+                }
+            }
+        },
+        "admin.respModerationQueueItem": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-09T10:10:10Z"
+                },
+                "entity_id": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "review"
+                },
+                "reasons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "text": {
+                    "type": "string",
+                    "example": "write me at john@example.com"
+                }
+            }
+        },
+        "admin.respPlatformCategory": {
+            "description": "lessons stats of one category respPlatformCategory.",
+            "type": "object",
+            "properties": {
+                "cancellation_rate": {
+                    "type": "number",
+                    "example": 0.075
+                },
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "category_name": {
+                    "type": "string",
+                    "example": "Programming"
+                },
+                "gmv": {
+                    "type": "integer",
+                    "example": 50000
+                },
+                "lessons_booked": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "lessons_cancelled": {
+                    "type": "integer",
+                    "example": 9
+                },
+                "lessons_completed": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "admin.respPlatformPeriod": {
+            "description": "stats of one day, week or month respPlatformPeriod.",
+            "type": "object",
+            "properties": {
+                "cancellation_rate": {
+                    "type": "number",
+                    "example": 0.07
+                },
+                "gmv": {
+                    "type": "integer",
+                    "example": 140000
+                },
+                "lessons_booked": {
+                    "type": "integer",
+                    "example": 340
+                },
+                "lessons_cancelled": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "lessons_completed": {
+                    "type": "integer",
+                    "example": 280
+                },
+                "lessons_rejected": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "lessons_started": {
+                    "type": "integer",
+                    "example": 290
+                },
+                "new_teachers": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "period_start": {
+                    "type": "string",
+                    "example": "2025-01-06"
+                },
+                "rejection_rate": {
+                    "type": "number",
+                    "example": 0.03
+                },
+                "signups": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "skills_approved": {
+                    "type": "integer",
+                    "example": 11
+                }
+            }
+        },
+        "admin.respPlatformStats": {
+            "description": "aggregated platform stats respPlatformStats, rates are from 0 to 1.",
+            "type": "object",
+            "properties": {
+                "cancellation_rate": {
+                    "type": "number",
+                    "example": 0.07
+                },
+                "gmv": {
+                    "type": "integer",
+                    "example": 140000
+                },
+                "lessons_booked": {
+                    "type": "integer",
+                    "example": 340
+                },
+                "lessons_cancelled": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "lessons_completed": {
+                    "type": "integer",
+                    "example": 280
+                },
+                "lessons_rejected": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "lessons_started": {
+                    "type": "integer",
+                    "example": 290
+                },
+                "new_teachers": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "rejection_rate": {
+                    "type": "number",
+                    "example": 0.03
+                },
+                "signups": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "skills_approved": {
+                    "type": "integer",
+                    "example": 11
+                }
+            }
+        },
+        "admin.respRatingDrift": {
+            "description": "stored and actual (computed from reviews) rating aggregates respRatingDrift.",
+            "type": "object",
+            "properties": {
+                "actual_count": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "actual_rate": {
+                    "type": "number",
+                    "example": 4.5
+                },
+                "actual_score": {
+                    "type": "integer",
+                    "example": 9
+                },
+                "entity": {
+                    "type": "string",
+                    "example": "teacher"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "stored_count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "stored_rate": {
+                    "type": "number",
+                    "example": 4
+                },
+                "stored_score": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "admin.respReviewModerationItem": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "example": "some comment"
+                },
+                "first_reported_at": {
+                    "type": "string",
+                    "example": "2025-01-09T10:10:10Z"
+                },
+                "is_hidden": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "rate": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reasons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reports_count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "review_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "student_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "target": {
+                    "type": "string",
+                    "example": "review"
+                },
+                "teacher_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "admin.respRole": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "moderates user content, skills, certificates and users"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "moderator"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "skills.view",
+                        "skills.approve"
+                    ]
+                }
+            }
+        },
+        "admin.respSkill": {
+            "type": "object",
+            "properties": {
+                "about": {
+                    "type": "string",
+                    "example": "about me..."
+                },
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "is_active": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "rate": {
+                    "type": "number",
+                    "example": 5
+                },
+                "reviews_count": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "skill_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "teacher_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "video_card_duration": {
+                    "description": "seconds",
+                    "type": "integer",
+                    "example": 60
+                },
+                "video_card_link": {
+                    "type": "string",
+                    "example": "https://youtu.be/HIcSWuKMwOw?si=FtxN1QJU9ZWnXy85"
+                },
+                "video_card_url": {
+                    "description": "for preview, empty if video isn't uploaded",
+                    "type": "string",
+                    "example": "/api/admin/skills/1/video"
+                }
+            }
+        },
+        "admin.respSkillApproval": {
+            "description": "skill approval backlog respSkillApproval.",
+            "type": "object",
+            "properties": {
+                "approved_count": {
+                    "type": "integer",
+                    "example": 11
+                },
+                "median_approval_time_seconds": {
+                    "description": "of approvals in range",
+                    "type": "integer",
+                    "example": 86400
+                },
+                "oldest_pending_since": {
+                    "type": "string",
+                    "example": "2025-01-02T10:10:10Z"
+                },
+                "pending_count": {
+                    "type": "integer",
+                    "example": 14
+                }
+            }
+        },
+        "admin.respStateTransition": {
+            "type": "object",
+            "properties": {
+                "changed_at": {
+                    "type": "string",
+                    "example": "2025-01-09T09:00:00Z"
+                },
+                "from_state": {
+                    "description": "empty for lesson creation",
+                    "type": "string",
+                    "example": "planned"
+                },
+                "to_state": {
+                    "type": "string",
+                    "example": "cancelled"
+                }
+            }
+        },
+        "admin.respTeacherShortData": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string",
+                    "example": "uuid.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "surname": {
+                    "type": "string",
+                    "example": "Smith"
+                },
+                "teacher_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "admin.respUserSuspension": {
+            "type": "object",
+            "properties": {
+                "admin_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-09T10:10:10+09:00"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "lifted_at": {
+                    "type": "string",
+                    "example": "2025-01-10T10:10:10+09:00"
+                },
+                "lifted_by": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "spam in reviews"
+                },
+                "until": {
+                    "description": "empty for permanent ban",
+                    "type": "string",
+                    "example": "2025-02-01T00:00:00Z"
+                }
+            }
+        },
+        "admin.reviewModerationQueueResponse": {
+            "description": "reported reviews and replies reviewModerationQueueResponse.",
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respReviewModerationItem"
+                    }
+                }
+            }
+        },
+        "admin.searchUsersResponse": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJpZCI6MTJ9"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respAdminUser"
+                    }
+                }
+            }
+        },
+        "admin.suspendUserRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "permanent": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "spam in reviews"
+                },
+                "until": {
+                    "type": "string",
+                    "example": "2025-02-01T00:00:00Z"
+                }
+            }
+        },
+        "admin.updateCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "min_age": {
+                    "type": "integer",
+                    "example": 16
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Business English"
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "admin.userOverviewResponse": {
+            "type": "object",
+            "properties": {
+                "complaints_filed": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respComplaint"
+                    }
+                },
+                "complaints_received": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respComplaint"
+                    }
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "support"
+                    ]
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respSkill"
+                    }
+                },
+                "student_lessons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respAdminLesson"
+                    }
+                },
+                "suspensions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respUserSuspension"
+                    }
+                },
+                "teacher_lessons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.respAdminLesson"
+                    }
+                },
+                "user": {
+                    "$ref": "#/definitions/admin.respAdminUser"
+                }
+            }
+        },
+        "category.getCategoriesResponse": {
+            "description": "get categories getCategoriesResponse.",
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/category.respCategory"
+                    }
+                }
+            }
+        },
+        "category.respCategory": {
+            "description": "data of respCategory.",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "is_too_young": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "min_age": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Programing"
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "complaint.createComplaintRequest": {
+            "type": "object",
+            "required": [
+                "description",
+                "reason"
+            ],
+            "properties": {
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "base64 encoded file"
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "example": "your description..."
+                },
+                "lesson_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Rude attitude"
+                },
+                "reported_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "complaint.createComplaintResponse": {
+            "type": "object",
+            "properties": {
+                "complaint_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "httputils.ErrorStruct": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "lesson.bookLessonRequest": {
+            "description": "book lesson body bookLessonRequest.",
+            "type": "object",
+            "required": [
+                "category_id",
+                "schedule_time_id",
+                "teacher_id"
+            ],
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "schedule_time_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "teacher_id": {
+                    "description": "@Description exactly teacherID, not his userID",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "lesson.connectLessonResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                }
+            }
+        },
+        "lesson.getLessonResponse": {
+            "description": "data about lesson getLessonResponse.",
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "category_name": {
+                    "type": "string",
+                    "example": "Programming"
+                },
+                "datetime": {
+                    "type": "string",
+                    "example": "2025-02-01T09:00:00Z"
+                },
+                "lesson_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "state_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "state_name": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "verification"
+                },
+                "student_avatar": {
+                    "type": "string",
+                    "example": "uuid.png"
+                },
+                "student_email": {
+                    "type": "string",
+                    "example": "test@test.com"
+                },
+                "student_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "student_name": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "student_surname": {
+                    "type": "string",
+                    "example": "Smith"
+                },
+                "teacher_avatar": {
+                    "type": "string",
+                    "example": "uuid.png"
+                },
+                "teacher_email": {
+                    "type": "string",
+                    "example": "test@test.com"
+                },
+                "teacher_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "teacher_name": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "teacher_surname": {
+                    "type": "string",
+                    "example": "Smith"
+                },
+                "teacher_user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "lesson.getLessonShortDataResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "category_name": {
+                    "type": "string",
+                    "example": "Programming"
+                },
+                "lesson_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "student_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "teacher_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "teacher_user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "lesson.getStudentLessonsResponse": {
+            "type": "object",
+            "properties": {
+                "lessons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/lesson.respStudentLessons"
+                    }
+                }
+            }
+        },
+        "lesson.getStudentProgressResponse": {
+            "description": "student's learning progress getStudentProgressResponse.",
+            "type": "object",
+            "properties": {
+                "current_streak_weeks": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2025-01-06"
+                },
+                "longest_streak_weeks": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "milestones": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/lesson.respProgressMilestone"
                     }
                 },
                 "teachers": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/admin.respTeacherShortData"
+                        "$ref": "#/definitions/lesson.respProgressTeacher"
+                    }
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-03-31"
+                },
+                "total_hours": {
+                    "type": "number",
+                    "example": 30.5
+                },
+                "total_lessons": {
+                    "type": "integer",
+                    "example": 27
+                },
+                "weeks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/lesson.respProgressWeek"
                     }
                 }
             }
         },
-        "admin.respComplaint": {
+        "lesson.getTeacherLessonsResponse": {
             "type": "object",
             "properties": {
-                "complainer_avatar": {
+                "lessons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/lesson.respTeacherLessons"
+                    }
+                }
+            }
+        },
+        "lesson.respProgressCategory": {
+            "description": "studied hours of one category respProgressCategory.",
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "category_name": {
+                    "type": "string",
+                    "example": "Programming"
+                },
+                "hours": {
+                    "type": "number",
+                    "example": 1.5
+                },
+                "lessons_count": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "lesson.respProgressMilestone": {
+            "description": "reached milestone respProgressMilestone.",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "lessons_10"
+                },
+                "reached_at": {
+                    "type": "string",
+                    "example": "2025-02-13T10:00:00Z"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "10 lessons finished"
+                }
+            }
+        },
+        "lesson.respProgressTeacher": {
+            "description": "teacher worked with respProgressTeacher.",
+            "type": "object",
+            "properties": {
+                "avatar": {
                     "type": "string",
                     "example": "uuid.png"
                 },
-                "complainer_email": {
+                "first_lesson": {
+                    "type": "string",
+                    "example": "2025-01-09T10:00:00Z"
+                },
+                "hours": {
+                    "type": "number",
+                    "example": 11
+                },
+                "last_lesson": {
+                    "type": "string",
+                    "example": "2025-03-27T10:00:00Z"
+                },
+                "lessons_count": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ivan"
+                },
+                "surname": {
+                    "type": "string",
+                    "example": "Ivanov"
+                },
+                "teacher_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "lesson.respProgressWeek": {
+            "description": "studied hours of one week respProgressWeek.",
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/lesson.respProgressCategory"
+                    }
+                },
+                "hours": {
+                    "type": "number",
+                    "example": 2.5
+                },
+                "week_start": {
+                    "type": "string",
+                    "example": "2025-01-06"
+                }
+            }
+        },
+        "lesson.respStudentLessons": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "category_name": {
+                    "type": "string",
+                    "example": "Programming"
+                },
+                "datetime": {
+                    "type": "string",
+                    "example": "2025-02-01T09:00:00Z"
+                },
+                "lesson_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "state_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "state_name": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "verification"
+                },
+                "teacher_avatar": {
+                    "type": "string",
+                    "example": "uuid.png"
+                },
+                "teacher_email": {
                     "type": "string",
                     "example": "test@test.com"
                 },
-                "complainer_id": {
+                "teacher_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "complainer_name": {
+                "teacher_name": {
                     "type": "string",
                     "example": "John"
                 },
-                "complainer_surname": {
+                "teacher_surname": {
                     "type": "string",
                     "example": "Smith"
                 },
-                "complaint_id": {
+                "teacher_user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "lesson.respTeacherLessons": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "category_name": {
+                    "type": "string",
+                    "example": "Programming"
+                },
+                "datetime": {
+                    "type": "string",
+                    "example": "2025-02-01T09:00:00Z"
+                },
+                "lesson_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "state_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "date": {
-                    "type": "string",
-                    "example": "2025-01-09T10:10:10+09:00"
-                },
-                "description": {
+                "state_name": {
                     "type": "string",
-                    "example": "description"
+                    "example": "pending"
                 },
-                "reason": {
+                "status": {
                     "type": "string",
-                    "example": "reason"
+                    "example": "verification"
                 },
-                "reported_avatar": {
+                "student_avatar": {
                     "type": "string",
                     "example": "uuid.png"
                 },
-                "reported_email": {
+                "student_email": {
                     "type": "string",
                     "example": "test@test.com"
                 },
-                "reported_id": {
+                "student_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "reported_name": {
+                "student_name": {
                     "type": "string",
                     "example": "John"
                 },
-                "reported_surname": {
+                "student_surname": {
                     "type": "string",
                     "example": "Smith"
                 }
             }
         },
-        "admin.respSkill": {
+        "notification.getNotificationsResponse": {
             "type": "object",
             "properties": {
-                "about": {
+                "notifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/notification.respNotification"
+                    }
+                }
+            }
+        },
+        "notification.respNotification": {
+            "type": "object",
+            "properties": {
+                "created_at": {
                     "type": "string",
-                    "example": "about me..."
+                    "example": "2025-01-09T10:10:10Z"
                 },
-                "category_id": {
+                "entity_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "is_active": {
+                "is_read": {
                     "type": "boolean",
                     "example": false
                 },
-                "rate": {
-                    "type": "number",
-                    "example": 5
-                },
-                "reviews_count": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "skill_id": {
-                    "type": "integer",
-                    "example": 1
+                "message": {
+                    "type": "string",
+                    "example": "offensive language"
                 },
-                "teacher_id": {
+                "notification_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "video_card_link": {
+                "type": {
                     "type": "string",
-                    "example": "https://youtu.be/HIcSWuKMwOw?si=FtxN1QJU9ZWnXy85"
+                    "example": "review_replied"
                 }
             }
         },
-        "admin.respTeacherShortData": {
+        "review.addReviewRequest": {
             "type": "object",
+            "required": [
+                "comment",
+                "lesson_id",
+                "rate"
+            ],
             "properties": {
-                "avatar": {
-                    "type": "string",
-                    "example": "uuid.png"
-                },
-                "name": {
+                "comment": {
                     "type": "string",
-                    "example": "John"
+                    "example": "some comment"
                 },
-                "surname": {
-                    "type": "string",
-                    "example": "Smith"
+                "lesson_id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "teacher_id": {
+                "rate": {
                     "type": "integer",
                     "example": 1
                 }
             }
         },
-        "category.getCategoriesResponse": {
-            "description": "get categories getCategoriesResponse.",
+        "review.getReviewResponse": {
             "type": "object",
             "properties": {
-                "categories": {
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJzIjoibmV3ZXN0IiwiciI6NSwidCI6IjIwMjUtMDEtMDlUMTA6MTA6MTBaIiwiaWQiOjEyfQ"
+                },
+                "reviews": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/category.respCategory"
+                        "$ref": "#/definitions/review.respReview"
                     }
-                }
-            }
-        },
-        "category.respCategory": {
-            "description": "data of respCategory.",
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "integer",
-                    "example": 1
                 },
-                "min_age": {
-                    "type": "integer",
-                    "example": 12
+                "summary": {
+                    "$ref": "#/definitions/review.respReviewSummary"
                 },
-                "name": {
-                    "type": "string",
-                    "example": "Programing"
+                "weighted_rate": {
+                    "type": "number",
+                    "example": 4.3
                 }
             }
         },
-        "complaint.createComplaintRequest": {
+        "review.reportReviewRequest": {
             "type": "object",
             "required": [
-                "description",
-                "reason",
-                "reported_id"
+                "reason"
             ],
             "properties": {
-                "description": {
-                    "type": "string",
-                    "example": "your description..."
-                },
                 "reason": {
                     "type": "string",
-                    "example": "Rude attitude"
+                    "example": "offensive language"
                 },
-                "reported_id": {
-                    "type": "integer",
-                    "example": 1
-                }
-            }
-        },
-        "httputils.ErrorStruct": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string"
+                "target": {
+                    "type": "string",
+                    "example": "review"
                 }
             }
         },
-        "lesson.bookLessonRequest": {
-            "description": "book lesson body bookLessonRequest.",
+        "review.respCategoryRate": {
             "type": "object",
-            "required": [
-                "category_id",
-                "schedule_time_id",
-                "teacher_id"
-            ],
             "properties": {
+                "average_rate": {
+                    "type": "number",
+                    "example": 4.75
+                },
                 "category_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "schedule_time_id": {
-                    "type": "integer",
-                    "example": 1
+                "category_name": {
+                    "type": "string",
+                    "example": "Programming"
                 },
-                "teacher_id": {
-                    "description": "@Description exactly teacherID, not his userID",
+                "reviews_count": {
                     "type": "integer",
-                    "example": 1
+                    "example": 4
                 }
             }
         },
-        "lesson.connectLessonResponse": {
+        "review.respRateCount": {
             "type": "object",
             "properties": {
-                "token": {
-                    "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                "rate": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "reviews_count": {
+                    "type": "integer",
+                    "example": 7
                 }
             }
         },
-        "lesson.getLessonResponse": {
-            "description": "data about lesson getLessonResponse.",
+        "review.respReview": {
             "type": "object",
             "properties": {
                 "category_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "category_name": {
+                "comment": {
                     "type": "string",
-                    "example": "Programming"
+                    "example": "This is a comment"
                 },
-                "datetime": {
+                "created_at": {
                     "type": "string",
-                    "example": "2025-02-01T09:00:00Z"
+                    "example": "2025-01-09T10:10:10Z"
+                },
+                "lesson_datetime": {
+                    "type": "string",
+                    "example": "2025-01-08T10:00:00Z"
                 },
                 "lesson_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "state_id": {
+                "rate": {
                     "type": "integer",
-                    "example": 1
+                    "example": 5
                 },
-                "state_name": {
-                    "type": "string",
-                    "example": "pending"
+                "reply": {
+                    "$ref": "#/definitions/review.respReviewReply"
                 },
-                "status": {
-                    "type": "string",
-                    "example": "verification"
+                "review_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "skill_id": {
+                    "type": "integer",
+                    "example": 1
                 },
                 "student_avatar": {
                     "type": "string",
@@ -1970,7 +7798,7 @@ const docTemplate = `{
                 },
                 "student_email": {
                     "type": "string",
-                    "example": "test@test.com"
+                    "example": "qwerty@example.com"
                 },
                 "student_id": {
                     "type": "integer",
@@ -1984,384 +7812,625 @@ const docTemplate = `{
                     "type": "string",
                     "example": "Smith"
                 },
-                "teacher_avatar": {
+                "teacher_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-01-10T10:10:10Z"
+                }
+            }
+        },
+        "review.respReviewReply": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "example": "Thank you for feedback!"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-11T10:10:10Z"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-01-12T10:10:10Z"
+                }
+            }
+        },
+        "review.respReviewSummary": {
+            "type": "object",
+            "properties": {
+                "average_rate": {
+                    "type": "number",
+                    "example": 4.5
+                },
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/review.respCategoryRate"
+                    }
+                },
+                "distribution": {
+                    "description": "from 5 to 1 stars",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/review.respRateCount"
+                    }
+                },
+                "reviews_count": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "review.reviewReplyRequest": {
+            "type": "object",
+            "required": [
+                "comment"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "example": "Thank you for feedback!"
+                }
+            }
+        },
+        "review.updateReviewRequest": {
+            "type": "object",
+            "required": [
+                "comment",
+                "rate"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "example": "some new comment"
+                },
+                "rate": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "schedule.addTimeRequest": {
+            "type": "object",
+            "required": [
+                "datetime"
+            ],
+            "properties": {
+                "datetime": {
+                    "type": "string",
+                    "example": "2025-02-01T00:00:00Z"
+                }
+            }
+        },
+        "schedule.getTimesResponse": {
+            "type": "object",
+            "properties": {
+                "datetimes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.respTimes"
+                    }
+                }
+            }
+        },
+        "schedule.respTimes": {
+            "type": "object",
+            "properties": {
+                "datetime": {
                     "type": "string",
-                    "example": "uuid.png"
+                    "example": "0001-01-01T00:00:00Z"
                 },
-                "teacher_email": {
-                    "type": "string",
-                    "example": "test@test.com"
+                "is_available": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "teacher_id": {
+                "schedule_time_id": {
                     "type": "integer",
                     "example": 1
-                },
-                "teacher_name": {
+                }
+            }
+        },
+        "teacher.addCertificateRequest": {
+            "type": "object",
+            "required": [
+                "file",
+                "title"
+            ],
+            "properties": {
+                "file": {
                     "type": "string",
-                    "example": "John"
+                    "example": "base64 encoded file"
                 },
-                "teacher_surname": {
+                "title": {
                     "type": "string",
-                    "example": "Smith"
-                },
-                "teacher_user_id": {
-                    "type": "integer",
-                    "example": 1
+                    "example": "IELTS 8.0"
                 }
             }
         },
-        "lesson.getLessonShortDataResponse": {
+        "teacher.addCertificateResponse": {
             "type": "object",
             "properties": {
-                "category_id": {
+                "certificate_id": {
                     "type": "integer",
                     "example": 1
-                },
-                "category_name": {
+                }
+            }
+        },
+        "teacher.addSkillRequest": {
+            "type": "object",
+            "required": [
+                "category_id"
+            ],
+            "properties": {
+                "about": {
                     "type": "string",
-                    "example": "Programming"
-                },
-                "lesson_id": {
-                    "type": "integer",
-                    "example": 1
+                    "example": "I am Groot"
                 },
-                "student_id": {
+                "category_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "teacher_id": {
+                "price": {
                     "type": "integer",
-                    "example": 1
+                    "example": 500
                 },
-                "teacher_user_id": {
-                    "type": "integer",
-                    "example": 1
+                "video_card_link": {
+                    "type": "string",
+                    "example": "https://youtu.be/HIcSWuKMwOw?si=FtxN1QJU9ZWnXy85"
                 }
             }
         },
-        "lesson.getStudentLessonsResponse": {
+        "teacher.getAnalyticsResponse": {
+            "description": "teacher's dashboard getAnalyticsResponse.",
             "type": "object",
             "properties": {
-                "lessons": {
+                "categories": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/lesson.respStudentLessons"
+                        "$ref": "#/definitions/teacher.respAnalyticsCategory"
                     }
-                }
-            }
-        },
-        "lesson.getTeacherLessonsResponse": {
-            "type": "object",
-            "properties": {
-                "lessons": {
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2025-01-01"
+                },
+                "period": {
+                    "type": "string",
+                    "example": "week"
+                },
+                "periods": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/lesson.respTeacherLessons"
+                        "$ref": "#/definitions/teacher.respAnalyticsPeriod"
                     }
+                },
+                "repeat_student_rate": {
+                    "type": "number",
+                    "example": 0.42
+                },
+                "repeat_students_count": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "students_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-03-31"
+                },
+                "total": {
+                    "$ref": "#/definitions/teacher.respAnalyticsStats"
                 }
             }
         },
-        "lesson.respStudentLessons": {
+        "teacher.getTeacherResponse": {
             "type": "object",
             "properties": {
-                "category_id": {
-                    "type": "integer",
-                    "example": 1
+                "avatar": {
+                    "type": "string",
+                    "example": "uuid.png"
                 },
-                "category_name": {
+                "bio": {
                     "type": "string",
-                    "example": "Programming"
+                    "example": "long story about me..."
                 },
-                "datetime": {
+                "birthdate": {
                     "type": "string",
-                    "example": "2025-02-01T09:00:00Z"
+                    "example": "2002-09-09T10:10:10+09:00"
                 },
-                "lesson_id": {
+                "certificates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/teacher.respCertificate"
+                    }
+                },
+                "common_rate": {
+                    "type": "number",
+                    "example": 0
+                },
+                "common_reviews_count": {
                     "type": "integer",
-                    "example": 1
+                    "example": 0
                 },
-                "state_id": {
+                "count_of_students": {
                     "type": "integer",
-                    "example": 1
+                    "example": 0
                 },
-                "state_name": {
-                    "type": "string",
-                    "example": "pending"
+                "education": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/teacher.respEducation"
+                    }
                 },
-                "status": {
+                "email": {
                     "type": "string",
-                    "example": "verification"
+                    "example": "qwerty@example.com"
                 },
-                "teacher_avatar": {
-                    "type": "string",
-                    "example": "uuid.png"
+                "favorites_count": {
+                    "description": "only for teacher himself",
+                    "type": "integer",
+                    "example": 3
                 },
-                "teacher_email": {
+                "finished_lessons": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "headline": {
+                    "description": "profile (only in teacher's profile, not in lists)",
                     "type": "string",
-                    "example": "test@test.com"
+                    "example": "Math teacher with 10 years of experience"
                 },
-                "teacher_id": {
-                    "type": "integer",
-                    "example": 1
+                "is_favorite": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "teacher_name": {
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/teacher.respLanguage"
+                    }
+                },
+                "name": {
                     "type": "string",
                     "example": "John"
                 },
-                "teacher_surname": {
+                "registration_date": {
+                    "type": "string",
+                    "example": "2022-09-09T10:10:10+09:00"
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/teacher.respSkill"
+                    }
+                },
+                "surname": {
                     "type": "string",
                     "example": "Smith"
                 },
-                "teacher_user_id": {
+                "teacher_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "user_id": {
                     "type": "integer",
                     "example": 1
                 }
             }
         },
-        "lesson.respTeacherLessons": {
+        "teacher.getTeachersResponse": {
             "type": "object",
             "properties": {
-                "category_id": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "category_name": {
+                "next_cursor": {
                     "type": "string",
-                    "example": "Programming"
+                    "example": "eyJzIjoicmF0aW5nIiwiZCI6dHJ1ZSwidiI6NC41LCJpZCI6MTJ9"
                 },
-                "datetime": {
+                "teachers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/teacher.getTeacherResponse"
+                    }
+                }
+            }
+        },
+        "teacher.profileEducation": {
+            "type": "object",
+            "properties": {
+                "degree": {
                     "type": "string",
-                    "example": "2025-02-01T09:00:00Z"
-                },
-                "lesson_id": {
-                    "type": "integer",
-                    "example": 1
+                    "example": "Master"
                 },
-                "state_id": {
+                "end_year": {
                     "type": "integer",
-                    "example": 1
-                },
-                "state_name": {
-                    "type": "string",
-                    "example": "pending"
-                },
-                "status": {
-                    "type": "string",
-                    "example": "verification"
+                    "example": 2015
                 },
-                "student_avatar": {
+                "field_of_study": {
                     "type": "string",
-                    "example": "uuid.png"
+                    "example": "Applied Mathematics"
                 },
-                "student_email": {
+                "institution": {
                     "type": "string",
-                    "example": "test@test.com"
+                    "example": "MIT"
                 },
-                "student_id": {
+                "start_year": {
                     "type": "integer",
-                    "example": 1
-                },
-                "student_name": {
+                    "example": 2010
+                }
+            }
+        },
+        "teacher.profileLanguage": {
+            "type": "object",
+            "properties": {
+                "language": {
                     "type": "string",
-                    "example": "John"
+                    "example": "English"
                 },
-                "student_surname": {
+                "level": {
                     "type": "string",
-                    "example": "Smith"
+                    "example": "C1"
                 }
             }
         },
-        "review.addReviewRequest": {
+        "teacher.recommendTeachersResponse": {
+            "type": "object",
+            "properties": {
+                "teachers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/teacher.respRecommendedTeacher"
+                    }
+                }
+            }
+        },
+        "teacher.respAnalyticsCategory": {
+            "description": "stats of one category respAnalyticsCategory.",
             "type": "object",
-            "required": [
-                "category_id",
-                "comment",
-                "rate",
-                "teacher_id"
-            ],
             "properties": {
+                "average_rate": {
+                    "type": "number",
+                    "example": 4.7
+                },
+                "cancellation_rate": {
+                    "type": "number",
+                    "example": 0.1
+                },
+                "cancelled_count": {
+                    "type": "integer",
+                    "example": 3
+                },
                 "category_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "comment": {
+                "category_name": {
                     "type": "string",
-                    "example": "some comment"
+                    "example": "Programming"
                 },
-                "rate": {
+                "earnings": {
+                    "type": "integer",
+                    "example": 12000
+                },
+                "finished_count": {
+                    "type": "integer",
+                    "example": 24
+                },
+                "lessons_count": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "no_show_count": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "no_show_rate": {
+                    "type": "number",
+                    "example": 0.07
+                },
+                "rejected_count": {
                     "type": "integer",
                     "example": 1
                 },
-                "teacher_id": {
+                "rejection_rate": {
+                    "type": "number",
+                    "example": 0.03
+                },
+                "reviews_count": {
                     "type": "integer",
-                    "example": 1
-                }
-            }
-        },
-        "review.getReviewResponse": {
-            "type": "object",
-            "properties": {
-                "reviews": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/review.respReview"
-                    }
+                    "example": 10
                 }
             }
         },
-        "review.respReview": {
+        "teacher.respAnalyticsPeriod": {
+            "description": "stats of one week or month respAnalyticsPeriod.",
             "type": "object",
             "properties": {
-                "category_id": {
+                "average_rate": {
+                    "type": "number",
+                    "example": 4.7
+                },
+                "cancellation_rate": {
+                    "type": "number",
+                    "example": 0.1
+                },
+                "cancelled_count": {
                     "type": "integer",
-                    "example": 1
+                    "example": 3
                 },
-                "comment": {
-                    "type": "string",
-                    "example": "This is a comment"
+                "earnings": {
+                    "type": "integer",
+                    "example": 12000
                 },
-                "rate": {
+                "finished_count": {
                     "type": "integer",
-                    "example": 5
+                    "example": 24
                 },
-                "review_id": {
+                "lessons_count": {
                     "type": "integer",
-                    "example": 1
+                    "example": 30
                 },
-                "skill_id": {
+                "no_show_count": {
                     "type": "integer",
-                    "example": 1
+                    "example": 2
                 },
-                "student_avatar": {
-                    "type": "string",
-                    "example": "uuid.png"
+                "no_show_rate": {
+                    "type": "number",
+                    "example": 0.07
                 },
-                "student_email": {
+                "period_start": {
                     "type": "string",
-                    "example": "qwerty@example.com"
+                    "example": "2025-01-06"
                 },
-                "student_id": {
+                "rejected_count": {
                     "type": "integer",
                     "example": 1
                 },
-                "student_name": {
-                    "type": "string",
-                    "example": "John"
-                },
-                "student_surname": {
-                    "type": "string",
-                    "example": "Smith"
+                "rejection_rate": {
+                    "type": "number",
+                    "example": 0.03
                 },
-                "teacher_id": {
+                "reviews_count": {
                     "type": "integer",
-                    "example": 1
+                    "example": 10
                 }
             }
         },
-        "schedule.addTimeRequest": {
+        "teacher.respAnalyticsStats": {
+            "description": "aggregated stats respAnalyticsStats, rates are from 0 to 1.",
             "type": "object",
-            "required": [
-                "datetime"
-            ],
             "properties": {
-                "datetime": {
-                    "type": "string",
-                    "example": "2025-02-01T00:00:00Z"
+                "average_rate": {
+                    "type": "number",
+                    "example": 4.7
+                },
+                "cancellation_rate": {
+                    "type": "number",
+                    "example": 0.1
+                },
+                "cancelled_count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "earnings": {
+                    "type": "integer",
+                    "example": 12000
+                },
+                "finished_count": {
+                    "type": "integer",
+                    "example": 24
+                },
+                "lessons_count": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "no_show_count": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "no_show_rate": {
+                    "type": "number",
+                    "example": 0.07
+                },
+                "rejected_count": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "rejection_rate": {
+                    "type": "number",
+                    "example": 0.03
+                },
+                "reviews_count": {
+                    "type": "integer",
+                    "example": 10
                 }
             }
         },
-        "schedule.getTimesResponse": {
+        "teacher.respCertificate": {
             "type": "object",
             "properties": {
-                "datetimes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schedule.respTimes"
-                    }
+                "certificate_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "is_verified": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "title": {
+                    "type": "string",
+                    "example": "IELTS 8.0"
                 }
             }
         },
-        "schedule.respTimes": {
+        "teacher.respEducation": {
             "type": "object",
             "properties": {
-                "datetime": {
+                "degree": {
                     "type": "string",
-                    "example": "0001-01-01T00:00:00Z"
+                    "example": "Master"
                 },
-                "is_available": {
-                    "type": "boolean",
-                    "example": true
+                "end_year": {
+                    "type": "integer",
+                    "example": 2015
                 },
-                "schedule_time_id": {
+                "field_of_study": {
+                    "type": "string",
+                    "example": "Applied Mathematics"
+                },
+                "institution": {
+                    "type": "string",
+                    "example": "MIT"
+                },
+                "start_year": {
                     "type": "integer",
-                    "example": 1
+                    "example": 2010
                 }
             }
         },
-        "teacher.addSkillRequest": {
+        "teacher.respLanguage": {
             "type": "object",
-            "required": [
-                "category_id"
-            ],
             "properties": {
-                "about": {
+                "language": {
                     "type": "string",
-                    "example": "I am Groot"
+                    "example": "English"
                 },
-                "category_id": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "video_card_link": {
+                "level": {
                     "type": "string",
-                    "example": "https://youtu.be/HIcSWuKMwOw?si=FtxN1QJU9ZWnXy85"
+                    "example": "C1"
                 }
             }
         },
-        "teacher.getTeacherResponse": {
+        "teacher.respRecommendedTeacher": {
             "type": "object",
             "properties": {
                 "avatar": {
                     "type": "string",
                     "example": "uuid.png"
                 },
-                "birthdate": {
-                    "type": "string",
-                    "example": "2002-09-09T10:10:10+09:00"
-                },
                 "common_rate": {
                     "type": "number",
-                    "example": 0
+                    "example": 4.5
                 },
                 "common_reviews_count": {
                     "type": "integer",
-                    "example": 0
-                },
-                "count_of_students": {
-                    "type": "integer",
-                    "example": 0
-                },
-                "email": {
-                    "type": "string",
-                    "example": "qwerty@example.com"
-                },
-                "finished_lessons": {
-                    "type": "integer",
-                    "example": 0
+                    "example": 10
                 },
                 "name": {
                     "type": "string",
                     "example": "John"
                 },
-                "registration_date": {
+                "reason": {
                     "type": "string",
-                    "example": "2022-09-09T10:10:10+09:00"
+                    "example": "because you studied Maths"
                 },
-                "skills": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/teacher.respSkill"
-                    }
+                "score": {
+                    "type": "number",
+                    "example": 0.72
                 },
                 "surname": {
                     "type": "string",
@@ -2377,14 +8446,50 @@ const docTemplate = `{
                 }
             }
         },
-        "teacher.getTeachersResponse": {
+        "teacher.respSearchTeacher": {
             "type": "object",
             "properties": {
-                "teachers": {
+                "avatar": {
+                    "type": "string",
+                    "example": "uuid.png"
+                },
+                "categories": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/teacher.getTeacherResponse"
+                        "type": "string"
                     }
+                },
+                "common_rate": {
+                    "type": "number",
+                    "example": 4.5
+                },
+                "common_reviews_count": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "headline": {
+                    "type": "string",
+                    "example": "\u003cb\u003eJohn\u003c/b\u003e Smith Programming..."
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "rank": {
+                    "type": "number",
+                    "example": 0.6
+                },
+                "surname": {
+                    "type": "string",
+                    "example": "Smith"
+                },
+                "teacher_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
@@ -2407,6 +8512,10 @@ const docTemplate = `{
                     "type": "boolean",
                     "example": false
                 },
+                "price": {
+                    "type": "integer",
+                    "example": 500
+                },
                 "rate": {
                     "type": "number",
                     "example": 5
@@ -2419,9 +8528,55 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 1
                 },
+                "video_card_duration": {
+                    "description": "seconds",
+                    "type": "integer",
+                    "example": 60
+                },
                 "video_card_link": {
                     "type": "string",
                     "example": "https://youtu.be/HIcSWuKMwOw?si=FtxN1QJU9ZWnXy85"
+                },
+                "video_card_url": {
+                    "description": "empty if video isn't uploaded, teacher's own route if skill isn't approved",
+                    "type": "string",
+                    "example": "/api/teachers/skills/1/video"
+                }
+            }
+        },
+        "teacher.searchTeachersResponse": {
+            "type": "object",
+            "properties": {
+                "teachers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/teacher.respSearchTeacher"
+                    }
+                }
+            }
+        },
+        "teacher.updateProfileRequest": {
+            "type": "object",
+            "properties": {
+                "bio": {
+                    "type": "string",
+                    "example": "long story about me..."
+                },
+                "education": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/teacher.profileEducation"
+                    }
+                },
+                "headline": {
+                    "type": "string",
+                    "example": "Math teacher with 10 years of experience"
+                },
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/teacher.profileLanguage"
+                    }
                 }
             }
         },
@@ -2430,16 +8585,59 @@ const docTemplate = `{
             "properties": {
                 "is_admin": {
                     "type": "boolean"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "complaints.view",
+                        "complaints.manage"
+                    ]
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "support"
+                    ]
                 }
             }
         },
         "user.authResponse": {
-            "description": "User registration authResponse.",
+            "description": "Tokens of new session authResponse: short-lived access token (Bearer) and one-time refresh token to get new ones.",
             "type": "object",
             "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "example": "q3Jx0nS8cT1uVw2yZ4bC6dE8fG0hI2jK4lM6nO8pQ0r"
+                },
+                "refresh_token_expires_at": {
+                    "type": "string",
+                    "example": "2025-01-31T10:00:00Z"
+                },
                 "token": {
                     "type": "string",
                     "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "token_expires_at": {
+                    "type": "string",
+                    "example": "2025-01-01T10:15:00Z"
+                }
+            }
+        },
+        "user.confirmEmailRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "q3Jx0nS8cT1uVw2yZ4bC6dE8fG0hI2jK4lM6nO8pQ0r"
                 }
             }
         },
@@ -2484,6 +8682,11 @@ const docTemplate = `{
                     "type": "string",
                     "example": "qwerty@example.com"
                 },
+                "email_verification_required": {
+                    "description": "set by admin, user must confirm his email again",
+                    "type": "boolean",
+                    "example": false
+                },
                 "finished_lessons": {
                     "type": "integer",
                     "example": 0
@@ -2535,6 +8738,34 @@ const docTemplate = `{
                 }
             }
         },
+        "user.logoutRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "all_sessions": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "refresh_token": {
+                    "type": "string",
+                    "example": "q3Jx0nS8cT1uVw2yZ4bC6dE8fG0hI2jK4lM6nO8pQ0r"
+                }
+            }
+        },
+        "user.refreshRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "example": "q3Jx0nS8cT1uVw2yZ4bC6dE8fG0hI2jK4lM6nO8pQ0r"
+                }
+            }
+        },
         "user.registrationRequest": {
             "description": "User registration registrationRequest.",
             "type": "object",
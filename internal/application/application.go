@@ -5,22 +5,34 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sync"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/config"
 	"github.com/LearnShareApp/learn-share-backend/internal/repository"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/analytics"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/audit"
 	"github.com/LearnShareApp/learn-share-backend/internal/service/category"
 	"github.com/LearnShareApp/learn-share-backend/internal/service/common"
 	"github.com/LearnShareApp/learn-share-backend/internal/service/complaint"
 	"github.com/LearnShareApp/learn-share-backend/internal/service/image"
 	"github.com/LearnShareApp/learn-share-backend/internal/service/lesson"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/moderation"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/notification"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/ranking"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/recommendation"
 	"github.com/LearnShareApp/learn-share-backend/internal/service/review"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/role"
 	"github.com/LearnShareApp/learn-share-backend/internal/service/schedule"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/session"
 	"github.com/LearnShareApp/learn-share-backend/internal/service/skill"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/suspension"
 	"github.com/LearnShareApp/learn-share-backend/internal/service/teacher"
 	"github.com/LearnShareApp/learn-share-backend/internal/service/user"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/useradmin"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest"
 	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
 	"github.com/LearnShareApp/learn-share-backend/pkg/livekit"
+	"github.com/LearnShareApp/learn-share-backend/pkg/mail"
 	"github.com/LearnShareApp/learn-share-backend/pkg/migrator"
 	"github.com/LearnShareApp/learn-share-backend/pkg/storage/db/postgres"
 	"github.com/LearnShareApp/learn-share-backend/pkg/storage/object/minio"
@@ -29,10 +41,19 @@ import (
 	"go.uber.org/zap"
 )
 
+// BackgroundJob is a long-running task started with application and stopped on shutdown.
+type BackgroundJob interface {
+	Run(ctx context.Context)
+}
+
 type Application struct {
 	db     *sqlx.DB
 	server *rest.Server
 	log    *zap.Logger
+
+	jobs       []BackgroundJob
+	jobsCancel context.CancelFunc
+	jobsWg     sync.WaitGroup
 }
 
 type Services struct {
@@ -47,6 +68,15 @@ type Services struct {
 	skill.SkillService
 	complaint.ComplaintService
 	common.CommonService
+	*recommendation.RecommendationService
+	analytics.AnalyticsService
+	notification.NotificationService
+	suspension.SuspensionService
+	moderation.ModerationService
+	audit.AuditService
+	role.RoleService
+	useradmin.UserAdminService
+	session.SessionService
 }
 
 func NewServices(
@@ -61,19 +91,39 @@ func NewServices(
 	skillService *skill.SkillService,
 	complaintService *complaint.ComplaintService,
 	commonService *common.CommonService,
+	recommendationService *recommendation.RecommendationService,
+	analyticsService *analytics.AnalyticsService,
+	notificationService *notification.NotificationService,
+	suspensionService *suspension.SuspensionService,
+	moderationService *moderation.ModerationService,
+	auditService *audit.AuditService,
+	roleService *role.RoleService,
+	userAdminService *useradmin.UserAdminService,
+	sessionService *session.SessionService,
 ) *Services {
 	return &Services{
-		JWTService:       *jwtService,
-		UserService:      *userService,
-		TeacherService:   *teacherService,
-		ScheduleService:  *scheduleService,
-		ReviewService:    *reviewService,
-		LessonService:    *lessonService,
-		ImageService:     *imageService,
-		CategoryService:  *categoryService,
-		SkillService:     *skillService,
-		ComplaintService: *complaintService,
-		CommonService:    *commonService,
+		JWTService:          *jwtService,
+		UserService:         *userService,
+		TeacherService:      *teacherService,
+		ScheduleService:     *scheduleService,
+		ReviewService:       *reviewService,
+		LessonService:       *lessonService,
+		ImageService:        *imageService,
+		CategoryService:     *categoryService,
+		SkillService:        *skillService,
+		ComplaintService:    *complaintService,
+		CommonService:       *commonService,
+		AnalyticsService:    *analyticsService,
+		NotificationService: *notificationService,
+		SuspensionService:   *suspensionService,
+		ModerationService:   *moderationService,
+		AuditService:        *auditService,
+		RoleService:         *roleService,
+		UserAdminService:    *userAdminService,
+		SessionService:      *sessionService,
+
+		// by pointer: service keeps shared recommendations snapshot
+		RecommendationService: recommendationService,
 	}
 }
 
@@ -111,20 +161,35 @@ func New(ctx context.Context, config *config.Config, log *zap.Logger) (*Applicat
 	/*----------------------------------------------------------*/
 
 	// services
-	jwtService := jwt.NewService(config.JwtSecretKey, jwt.WithIssuer("learn-share-backend"))
+	jwtService := jwt.NewService(config.JwtSecretKey, jwt.WithIssuer("learn-share-backend"), jwt.WithDuration(config.JwtAccessTTL))
 	liveKitService := livekit.NewService(config.LiveKit)
 	minioService := minio.NewService(minioClient, config.Minio.Bucket)
 	commonService := common.NewService(repo)
 
-	userService := user.NewService(repo, minioService)
-	teacherService := teacher.NewService(repo)
+	moderationService, err := moderation.NewService(repo, config.Moderation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create moderation service: %w", err)
+	}
+
+	userService := user.NewService(repo, minioService, moderationService)
+	teacherService := teacher.NewService(repo, minioService, moderationService)
 	scheduleService := schedule.NewService(repo)
-	reviewService := review.NewService(repo)
+	reviewService := review.NewService(repo, moderationService, config.Review)
 	lessonService := lesson.NewService(repo, liveKitService)
 	imageService := image.NewService(minioService)
 	categoryService := category.NewService(repo)
-	skillService := skill.NewService(repo)
-	complaintService := complaint.NewService(repo)
+	skillService := skill.NewService(repo, minioService, moderationService, log.Named("skill_service"))
+	complaintService := complaint.NewService(repo, minioService, moderationService)
+	recommendationService := recommendation.NewService(repo, config.Recommendation, log.Named("recommendation_service"))
+	analyticsService := analytics.NewService(repo, config.Analytics, log.Named("analytics_service"))
+	notificationService := notification.NewService(repo)
+	suspensionService := suspension.NewService(repo)
+	auditService := audit.NewService(repo)
+	roleService := role.NewService(repo)
+	mailSender := mail.NewSender(config.Mail)
+	userAdminService := useradmin.NewService(repo, minioService, mailSender, config.UserAdmin)
+	sessionService := session.NewService(repo, jwtService, config.Session)
+	rankingService := ranking.NewService(repo, config.Ranking, log.Named("ranking_service"))
 
 	services := NewServices(
 		jwtService,
@@ -138,6 +203,15 @@ func New(ctx context.Context, config *config.Config, log *zap.Logger) (*Applicat
 		skillService,
 		complaintService,
 		commonService,
+		recommendationService,
+		analyticsService,
+		notificationService,
+		suspensionService,
+		moderationService,
+		auditService,
+		roleService,
+		userAdminService,
+		sessionService,
 	)
 
 	restServer := rest.NewServer(services, config.Server, log)
@@ -146,11 +220,14 @@ func New(ctx context.Context, config *config.Config, log *zap.Logger) (*Applicat
 		db:     database,
 		server: restServer,
 		log:    log,
+		jobs:   []BackgroundJob{recommendationService, analyticsService, rankingService},
 	}, nil
 }
 
 // Run start application.
 func (app *Application) Run() error {
+	app.startBackgroundJobs()
+
 	err := app.server.Start()
 	if err != nil {
 		return fmt.Errorf("failed to start server: %w", err)
@@ -176,9 +253,37 @@ func (app *Application) Shutdown(ctx context.Context) error {
 		return err
 	}
 
+	app.stopBackgroundJobs()
+
 	if err := app.db.Close(); err != nil {
 		app.log.Error("failed to close database", zap.Error(err))
 	}
 
 	return nil
 }
+
+func (app *Application) startBackgroundJobs() {
+	ctx, cancel := context.WithCancel(context.Background())
+	app.jobsCancel = cancel
+
+	for _, job := range app.jobs {
+		app.jobsWg.Add(1)
+
+		go func() {
+			defer app.jobsWg.Done()
+
+			job.Run(ctx)
+		}()
+	}
+}
+
+func (app *Application) stopBackgroundJobs() {
+	if app.jobsCancel == nil {
+		return
+	}
+
+	app.jobsCancel()
+	app.jobsWg.Wait()
+
+	app.log.Info("background jobs stopped")
+}
@@ -4,9 +4,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/service/analytics"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/moderation"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/ranking"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/recommendation"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/review"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/session"
+	"github.com/LearnShareApp/learn-share-backend/internal/service/useradmin"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest"
 	"github.com/LearnShareApp/learn-share-backend/pkg/livekit"
+	"github.com/LearnShareApp/learn-share-backend/pkg/mail"
 	"github.com/LearnShareApp/learn-share-backend/pkg/migrator"
 	"github.com/LearnShareApp/learn-share-backend/pkg/storage/db/postgres"
 	"github.com/LearnShareApp/learn-share-backend/pkg/storage/object/minio"
@@ -20,13 +29,22 @@ const (
 )
 
 type Config struct {
-	DB           postgres.Config
-	Migrator     migrator.Config
-	Server       rest.Config
-	LiveKit      livekit.Config
-	Minio        minio.Config
-	IsInitDb     bool   `env:"IS_INIT_DB" env-required:"true"`
-	JwtSecretKey string `env:"SECRET_KEY" env-required:"true"`
+	DB             postgres.Config
+	Migrator       migrator.Config
+	Server         rest.Config
+	LiveKit        livekit.Config
+	Minio          minio.Config
+	Recommendation recommendation.Config
+	Analytics      analytics.Config
+	Review         review.Config
+	Ranking        ranking.Config
+	Moderation     moderation.Config
+	Session        session.Config
+	UserAdmin      useradmin.Config
+	Mail           mail.Config
+	IsInitDb       bool          `env:"IS_INIT_DB"     env-required:"true"`
+	JwtSecretKey   string        `env:"SECRET_KEY"     env-required:"true"`
+	JwtAccessTTL   time.Duration `env:"JWT_ACCESS_TTL" env-default:"15m"`
 }
 
 func LoadConfig(paths []string) (*Config, error) {
@@ -115,6 +133,10 @@ func (c *Config) LogConfig() (string, error) {
 		logConfig.Minio.SecretKey = maskedString
 	}
 
+	if logConfig.Mail.Password != "" {
+		logConfig.Mail.Password = maskedString
+	}
+
 	// Convert to JSON with indents for readability
 	jsonBytes, err := json.MarshalIndent(logConfig, "", "  ")
 	if err != nil {
@@ -0,0 +1,110 @@
+package entities
+
+import "time"
+
+type AnalyticsPeriod string
+
+const (
+	AnalyticsPeriodDay   AnalyticsPeriod = "day"
+	AnalyticsPeriodWeek  AnalyticsPeriod = "week"
+	AnalyticsPeriodMonth AnalyticsPeriod = "month"
+)
+
+// TeacherAnalyticsFilter is a date range (both days inclusive) and grouping period of teacher's dashboard.
+type TeacherAnalyticsFilter struct {
+	From   time.Time
+	To     time.Time
+	Period AnalyticsPeriod
+}
+
+// TeacherStatsBucket is aggregated teacher's stats for one period or one category.
+// Lessons are counted by day of their schedule time, reviews by day of creation.
+type TeacherStatsBucket struct {
+	PeriodStart  time.Time `db:"period_start"`
+	CategoryID   int       `db:"category_id"`
+	CategoryName string    `db:"category_name"`
+
+	LessonsCount   int   `db:"lessons_count"`
+	FinishedCount  int   `db:"finished_count"`
+	CancelledCount int   `db:"cancelled_count"` // cancelled before start
+	RejectedCount  int   `db:"rejected_count"`
+	NoShowCount    int   `db:"no_show_count"` // never started or cancelled after start
+	Earnings       int64 `db:"earnings"`      // sum of prices of finished lessons
+	ReviewsCount   int   `db:"reviews_count"`
+	RateSum        int   `db:"rate_sum"`
+
+	CancellationRate float64 `db:"-"`
+	RejectionRate    float64 `db:"-"`
+	NoShowRate       float64 `db:"-"`
+	AverageRate      float64 `db:"-"`
+}
+
+type TeacherAnalytics struct {
+	Filter     TeacherAnalyticsFilter
+	Total      TeacherStatsBucket
+	Periods    []TeacherStatsBucket
+	Categories []TeacherStatsBucket
+
+	StudentsCount       int // students with finished lessons in range
+	RepeatStudentsCount int // of them with more than one finished lesson
+	RepeatStudentRate   float64
+}
+
+// PlatformAnalyticsFilter is a date range (both days inclusive) and grouping period of platform dashboard.
+type PlatformAnalyticsFilter struct {
+	From   time.Time
+	To     time.Time
+	Period AnalyticsPeriod
+}
+
+// PlatformStatsBucket is aggregated platform's stats for one period or one category (without users stats).
+// Everything is counted by day (UTC) of event: registration, becoming a teacher, approval, lesson's state change.
+type PlatformStatsBucket struct {
+	PeriodStart  time.Time `db:"period_start"`
+	CategoryID   int       `db:"category_id"`
+	CategoryName string    `db:"category_name"`
+
+	Signups        int `db:"signups"`
+	NewTeachers    int `db:"new_teachers"`
+	SkillsApproved int `db:"skills_approved"`
+
+	LessonsBooked    int   `db:"lessons_booked"`
+	LessonsStarted   int   `db:"lessons_started"`
+	LessonsCompleted int   `db:"lessons_completed"` // finished by teacher
+	LessonsCancelled int   `db:"lessons_cancelled"`
+	LessonsRejected  int   `db:"lessons_rejected"`
+	GMV              int64 `db:"gmv"` // sum of prices of completed lessons
+
+	CancellationRate float64 `db:"-"` // of booked lessons
+	RejectionRate    float64 `db:"-"` // of booked lessons
+}
+
+// Add adds counters of other bucket to this one.
+func (b *PlatformStatsBucket) Add(other *PlatformStatsBucket) {
+	b.Signups += other.Signups
+	b.NewTeachers += other.NewTeachers
+	b.SkillsApproved += other.SkillsApproved
+	b.LessonsBooked += other.LessonsBooked
+	b.LessonsStarted += other.LessonsStarted
+	b.LessonsCompleted += other.LessonsCompleted
+	b.LessonsCancelled += other.LessonsCancelled
+	b.LessonsRejected += other.LessonsRejected
+	b.GMV += other.GMV
+}
+
+// SkillApprovalStat is current approval backlog and approvals of days range.
+type SkillApprovalStat struct {
+	PendingCount       int        `db:"pending_count"`
+	OldestPendingSince *time.Time `db:"oldest_pending_since"` // nil if there are no pending skills with known submission time
+
+	ApprovedCount      int           `db:"approved_count"`
+	MedianApprovalTime time.Duration `db:"-"` // of approvals with known submission time
+}
+
+type PlatformAnalytics struct {
+	Filter        PlatformAnalyticsFilter
+	Total         PlatformStatsBucket
+	Periods       []PlatformStatsBucket
+	TopCategories []PlatformStatsBucket
+	SkillApproval SkillApprovalStat
+}
@@ -0,0 +1,76 @@
+package entities
+
+import "time"
+
+// AuditAction is a kind of admin's mutation.
+type AuditAction string
+
+const (
+	AuditSkillApprove       AuditAction = "skill.approve"
+	AuditCertificateVerify  AuditAction = "certificate.verify"
+	AuditCategoryCreate     AuditAction = "category.create"
+	AuditCategoryUpdate     AuditAction = "category.update"
+	AuditCategoryArchive    AuditAction = "category.archive"
+	AuditCategoryRestore    AuditAction = "category.restore"
+	AuditCategoryReorder    AuditAction = "category.reorder"
+	AuditAgeExceptionGrant  AuditAction = "age_exception.grant"
+	AuditAgeExceptionRevoke AuditAction = "age_exception.revoke"
+	AuditRatingsRepair      AuditAction = "ratings.repair"
+	AuditReviewModerate     AuditAction = "review.moderate"
+	AuditComplaintAssign    AuditAction = "complaint.assign"
+	AuditComplaintAddNote   AuditAction = "complaint.add_note"
+	AuditComplaintResolve   AuditAction = "complaint.resolve"
+	AuditUserSuspend        AuditAction = "user.suspend"
+	AuditUserLiftSuspension AuditAction = "user.lift_suspension"
+	AuditModerationDecide   AuditAction = "moderation.decide"
+	AuditRoleAssign         AuditAction = "role.assign"
+	AuditRoleRevoke         AuditAction = "role.revoke"
+	AuditUserResetAvatar    AuditAction = "user.reset_avatar"
+	AuditUserRequireEmail   AuditAction = "user.require_email_verification"
+	AuditUserMerge          AuditAction = "user.merge" // entity is merged (deleted) user, after is target user
+)
+
+// AuditEntityType is a type of entity changed by admin, it defines what is snapshotted.
+type AuditEntityType string
+
+const (
+	AuditEntitySkill          AuditEntityType = "skill"
+	AuditEntityCertificate    AuditEntityType = "certificate"
+	AuditEntityCategory       AuditEntityType = "category"
+	AuditEntityCategoryOrder  AuditEntityType = "category_order" // id is parent category, nil for roots
+	AuditEntityAgeExceptions  AuditEntityType = "age_exceptions" // id is category
+	AuditEntityRatings        AuditEntityType = "ratings"        // without id
+	AuditEntityReview         AuditEntityType = "review"
+	AuditEntityReviewReply    AuditEntityType = "review_reply" // id is review
+	AuditEntityComplaint      AuditEntityType = "complaint"
+	AuditEntityUserSuspension AuditEntityType = "user_suspension" // id is user
+	AuditEntityModerationItem AuditEntityType = "moderation_item"
+	AuditEntityUserRoles      AuditEntityType = "user_roles" // id is user
+	AuditEntityUser           AuditEntityType = "user"
+)
+
+// AdminAuditEntry is a record of admin's mutation, it is never changed after creation.
+type AdminAuditEntry struct {
+	ID         int             `db:"audit_id"`
+	AdminID    int             `db:"admin_id"`
+	Action     AuditAction     `db:"action"`
+	EntityType AuditEntityType `db:"entity_type"`
+	EntityID   *int            `db:"entity_id"`
+	Before     []byte          `db:"before"` // JSON snapshot, nil if entity didn't exist
+	After      []byte          `db:"after"`  // JSON snapshot, nil if entity was deleted
+	RequestID  string          `db:"request_id"`
+	CreatedAt  time.Time       `db:"created_at"`
+}
+
+// AuditLogFilter describes filters and page of admin audit log.
+type AuditLogFilter struct {
+	AdminID    *int
+	Action     *AuditAction
+	EntityType *AuditEntityType
+	EntityID   *int
+	From       *time.Time
+	To         *time.Time
+
+	Limit  int
+	Cursor *int // id of the last entry of the previous page
+}
@@ -1,7 +1,12 @@
 package entities
 
 type Category struct {
-	ID     int    `db:"category_id"`
-	Name   string `db:"name"`
-	MinAge int    `db:"min_age"`
+	ID         int    `db:"category_id"`
+	Name       string `db:"name"`
+	MinAge     int    `db:"min_age"`
+	ParentID   *int   `db:"parent_id"` // nil for root categories
+	IsArchived bool   `db:"is_archived"`
+	Position   int    `db:"position"` // order among categories with the same parent
+
+	IsTooYoung bool `db:"-"` // current user is younger than min age and has no exception
 }
@@ -0,0 +1,11 @@
+package entities
+
+import "time"
+
+// CategoryAgeException allows user to study (or teach) category despite its min age.
+type CategoryAgeException struct {
+	UserID     int       `db:"user_id"`
+	CategoryID int       `db:"category_id"`
+	GrantedBy  *int      `db:"granted_by"` // admin's user id, nil if admin was deleted
+	CreatedAt  time.Time `db:"created_at"`
+}
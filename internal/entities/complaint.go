@@ -3,13 +3,73 @@ package entities
 import "time"
 
 type Complaint struct {
-	ID           int       `db:"complaint_id"`
-	ComplainerID int       `db:"complainer_id"`
-	ReportedID   int       `db:"reported_id"`
-	Reason       string    `db:"reason"`
-	Description  string    `db:"description"`
-	CreatedAt    time.Time `db:"created_at"`
-
-	Complainer *User `db:"-"`
-	Reported   *User `db:"-"`
+	ID                 int        `db:"complaint_id"`
+	ComplainerID       int        `db:"complainer_id"`
+	ReportedID         int        `db:"reported_id"`
+	LessonID           *int       `db:"lesson_id"`
+	Reason             string     `db:"reason"`
+	Description        string     `db:"description"`
+	CreatedAt          time.Time  `db:"created_at"`
+	StateMachineItemID int        `db:"state_machine_item_id"`
+	StateName          StateName  `db:"state_name"`
+	AssigneeID         *int       `db:"assignee_id"`
+	Resolution         *string    `db:"resolution"`
+	ResolvedBy         *int       `db:"resolved_by"`
+	ResolvedAt         *time.Time `db:"resolved_at"`
+
+	Complainer  *User                 `db:"-"`
+	Reported    *User                 `db:"-"`
+	Attachments []ComplaintAttachment `db:"-"`
+}
+
+// ComplaintAttachmentContentTypes are supported evidence files: content type -> extension.
+var ComplaintAttachmentContentTypes = map[string]string{
+	"application/pdf": "pdf",
+	"image/png":       "png",
+	"image/jpeg":      "jpg",
+	"image/webp":      "webp",
+}
+
+// ComplaintAttachment is an evidence file of complaint stored in object storage.
+type ComplaintAttachment struct {
+	ID          int       `db:"attachment_id"`
+	ComplaintID int       `db:"complaint_id"`
+	FileName    string    `db:"file_name"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// ComplaintDetails is a complaint with everything admin needs to decide on it.
+type ComplaintDetails struct {
+	Complaint *Complaint
+	Notes     []*ComplaintNote
+
+	// nil if complaint isn't about a lesson
+	Lesson        *Lesson
+	LessonHistory []StateTransitionLogEntry
+}
+
+// ComplaintNote is an internal admin's note about the complaint, it is never shown to complainer.
+type ComplaintNote struct {
+	ID          int       `db:"note_id"`
+	ComplaintID int       `db:"complaint_id"`
+	AuthorID    *int      `db:"author_id"`
+	Text        string    `db:"text"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// ComplaintListFilter describes filters and page of admin's complaint list.
+type ComplaintListFilter struct {
+	State      *StateName
+	AssigneeID *int
+
+	Limit  int
+	Cursor *int // id of the last complaint of the previous page
+}
+
+// ComplaintResolution is an admin's decision on the complaint.
+type ComplaintResolution struct {
+	ComplaintID int
+	State       StateName // Resolved or Dismissed
+	Resolution  string
+	AdminID     int
 }
@@ -0,0 +1,56 @@
+package entities
+
+import "time"
+
+// ContentKind is a kind of user-submitted text passing through moderation.
+type ContentKind string
+
+const (
+	ContentReview         ContentKind = "review"
+	ContentReviewReply    ContentKind = "review_reply"
+	ContentComplaint      ContentKind = "complaint"
+	ContentSkillAbout     ContentKind = "skill_about"
+	ContentTeacherProfile ContentKind = "teacher_profile"
+	ContentUserName       ContentKind = "user_name"
+	ContentCertificate    ContentKind = "certificate"
+	ContentReviewReport   ContentKind = "review_report"
+)
+
+// UserContent is a user-submitted text to moderate before saving.
+type UserContent struct {
+	Kind     ContentKind
+	AuthorID int  // zero if author is being registered, it's set with entity id
+	EntityID *int // nil if entity is being created, it's set when flagged text is saved with entity
+	Text     string
+}
+
+type ModerationDecision string
+
+const (
+	// ModerationApproved means flagged text turned out to be fine.
+	ModerationApproved ModerationDecision = "approved"
+	// ModerationViolation means flagged text violates rules, admin acts on it with other tools (hide, suspend).
+	ModerationViolation ModerationDecision = "violation"
+)
+
+// ModerationQueueItem is a flagged text waiting for admin's review.
+type ModerationQueueItem struct {
+	ID        int                 `db:"item_id"`
+	Kind      ContentKind         `db:"kind"`
+	AuthorID  *int                `db:"author_id"`
+	EntityID  *int                `db:"entity_id"`
+	Text      string              `db:"text"`
+	Reasons   []string            `db:"-"`
+	CreatedAt time.Time           `db:"created_at"`
+	Decision  *ModerationDecision `db:"decision"` // nil while pending
+	DecidedBy *int                `db:"decided_by"`
+	DecidedAt *time.Time          `db:"decided_at"`
+}
+
+// ModerationQueueFilter describes filters and page of admin's moderation queue.
+type ModerationQueueFilter struct {
+	Kind *ContentKind
+
+	Limit  int
+	Cursor *int // id of the last item of the previous page
+}
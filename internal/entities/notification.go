@@ -0,0 +1,33 @@
+package entities
+
+import "time"
+
+type NotificationType string
+
+const (
+	NotificationReviewReplied      NotificationType = "review_replied"
+	NotificationReviewReplyUpdated NotificationType = "review_reply_updated"
+
+	NotificationReviewHidden        NotificationType = "review_hidden"
+	NotificationReviewRestored      NotificationType = "review_restored"
+	NotificationReviewDeleted       NotificationType = "review_deleted"
+	NotificationReviewReplyHidden   NotificationType = "review_reply_hidden"
+	NotificationReviewReplyRestored NotificationType = "review_reply_restored"
+	NotificationReviewReplyDeleted  NotificationType = "review_reply_deleted"
+
+	NotificationComplaintResolved  NotificationType = "complaint_resolved"
+	NotificationComplaintDismissed NotificationType = "complaint_dismissed"
+
+	NotificationLessonCancelled NotificationType = "lesson_cancelled"
+	NotificationLessonRejected  NotificationType = "lesson_rejected"
+)
+
+type Notification struct {
+	ID        int              `db:"notification_id"`
+	UserID    int              `db:"user_id"`
+	Type      NotificationType `db:"type"`
+	EntityID  *int             `db:"entity_id"` // id of object notification is about (review, complaint or lesson)
+	Message   *string          `db:"message"`   // e.g. moderation reason
+	IsRead    bool             `db:"is_read"`
+	CreatedAt time.Time        `db:"created_at"`
+}
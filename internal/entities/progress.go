@@ -0,0 +1,56 @@
+package entities
+
+import "time"
+
+// StudentFinishedLesson is a finished lesson of student used for progress computing.
+type StudentFinishedLesson struct {
+	LessonID        int       `db:"lesson_id"`
+	TeacherID       int       `db:"teacher_id"`
+	CategoryID      int       `db:"category_id"`
+	CategoryName    string    `db:"category_name"`
+	Datetime        time.Time `db:"datetime"`
+	DurationMinutes int       `db:"duration_minutes"` // default duration if actual is unknown
+}
+
+type StudentProgress struct {
+	From time.Time // first day of first week of timeline
+	To   time.Time
+
+	Weeks []ProgressWeek
+
+	TotalLessons int
+	TotalMinutes int
+
+	CurrentStreakWeeks int // weeks in a row with finished lessons, current week may be still empty
+	LongestStreakWeeks int
+
+	Teachers   []ProgressTeacher
+	Milestones []ProgressMilestone
+}
+
+type ProgressWeek struct {
+	WeekStart  time.Time // monday (UTC)
+	Minutes    int
+	Categories []ProgressCategory
+}
+
+type ProgressCategory struct {
+	CategoryID   int
+	CategoryName string
+	LessonsCount int
+	Minutes      int
+}
+
+type ProgressTeacher struct {
+	Teacher      *User
+	LessonsCount int
+	Minutes      int
+	FirstLesson  time.Time
+	LastLesson   time.Time
+}
+
+type ProgressMilestone struct {
+	Code      string // e.g. lessons_10, hours_25, categories_3, streak_weeks_4
+	Title     string
+	ReachedAt time.Time
+}
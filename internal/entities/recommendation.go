@@ -0,0 +1,26 @@
+package entities
+
+// StudentActivity is one lesson of the student used as signal for recommendations.
+type StudentActivity struct {
+	StudentID  int  `db:"student_id"`
+	TeacherID  int  `db:"teacher_id"`
+	CategoryID int  `db:"category_id"`
+	IsFinished bool `db:"is_finished"`
+}
+
+// RecommendableTeacher is teacher with active skills, who can be recommended.
+type RecommendableTeacher struct {
+	TeacherID        int     `db:"teacher_id"`
+	UserID           int     `db:"user_id"`
+	Rate             float32 `db:"rate"`
+	ReviewsCount     int     `db:"reviews_count"`
+	CategoryIDs      []int   `db:"-"`
+	HasAvailableTime bool    `db:"has_available_time"`
+}
+
+// TeacherRecommendation is recommended teacher with score and human-readable reason.
+type TeacherRecommendation struct {
+	Teacher *User
+	Score   float64
+	Reason  string
+}
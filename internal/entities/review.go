@@ -1,13 +1,50 @@
 package entities
 
+import "time"
+
 type Review struct {
-	ID         int    `db:"review_id"`
-	TeacherID  int    `db:"teacher_id"`
-	StudentID  int    `db:"student_id"`
-	CategoryID int    `db:"category_id"`
-	SkillID    int    `db:"skill_id"`
-	Rate       int    `db:"rate"`
-	Comment    string `db:"comment"`
-
-	StudentData *User `db:"-"`
+	ID         int        `db:"review_id"`
+	TeacherID  int        `db:"teacher_id"`
+	StudentID  int        `db:"student_id"`
+	CategoryID int        `db:"category_id"`
+	SkillID    int        `db:"skill_id"`
+	LessonID   *int       `db:"lesson_id"` // nil for old reviews made before linking to lessons
+	Rate       int        `db:"rate"`
+	Comment    string     `db:"comment"`
+	CreatedAt  time.Time  `db:"created_at"`
+	UpdatedAt  *time.Time `db:"updated_at"` // nil if review wasn't edited
+
+	IsHidden         bool       `db:"is_hidden"` // hidden by moderator, not public and not counted in ratings
+	ModerationReason *string    `db:"moderation_reason"`
+	ModeratedAt      *time.Time `db:"moderated_at"`
+
+	LessonDatetime *time.Time `db:"lesson_datetime"` // time of reviewed lesson, filled on listing
+
+	StudentData *User        `db:"-"`
+	Reply       *ReviewReply `db:"-"` // nil if teacher didn't reply
+}
+
+// ReviewReply is a public teacher's answer to review, one per review.
+type ReviewReply struct {
+	ReviewID  int        `db:"review_id"`
+	TeacherID int        `db:"teacher_id"`
+	Comment   string     `db:"comment"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt *time.Time `db:"updated_at"` // nil if reply wasn't edited
+
+	IsHidden         bool       `db:"is_hidden"` // hidden by moderator, not public
+	ModerationReason *string    `db:"moderation_reason"`
+	ModeratedAt      *time.Time `db:"moderated_at"`
+}
+
+// RatingDrift is a difference between stored rating aggregates of skill or teacher and ones computed from reviews.
+type RatingDrift struct {
+	Entity      string  `db:"entity"` // "skill" or "teacher"
+	ID          int     `db:"id"`
+	StoredCount int     `db:"stored_count"`
+	ActualCount int     `db:"actual_count"`
+	StoredScore int     `db:"stored_score"`
+	ActualScore int     `db:"actual_score"`
+	StoredRate  float64 `db:"stored_rate"`
+	ActualRate  float64 `db:"actual_rate"`
 }
@@ -0,0 +1,45 @@
+package entities
+
+import "time"
+
+type ReviewSortField string
+
+const (
+	ReviewSortByNewest  ReviewSortField = "newest"
+	ReviewSortByHighest ReviewSortField = "highest"
+	ReviewSortByLowest  ReviewSortField = "lowest"
+)
+
+// ReviewListFilter describes filters, sorting and page of teacher's reviews.
+type ReviewListFilter struct {
+	TeacherID  int
+	CategoryID *int
+
+	SortBy ReviewSortField
+	Limit  int
+	Cursor *ReviewListCursor
+}
+
+// ReviewListCursor points to the last review of the previous page (keyset pagination).
+// Reviews with the same rate are always ordered from newest.
+type ReviewListCursor struct {
+	SortBy    ReviewSortField
+	Rate      int
+	CreatedAt time.Time
+	ReviewID  int
+}
+
+// ReviewSummary is an overview of teacher's visible reviews.
+type ReviewSummary struct {
+	ReviewsCount int
+	AverageRate  float64
+	Distribution map[int]int // rate (1-5) => count of reviews
+	Categories   []ReviewCategorySummary
+}
+
+type ReviewCategorySummary struct {
+	CategoryID   int     `db:"category_id"`
+	CategoryName string  `db:"category_name"`
+	ReviewsCount int     `db:"reviews_count"`
+	AverageRate  float64 `db:"average_rate"`
+}
@@ -0,0 +1,54 @@
+package entities
+
+import "time"
+
+// ReviewReportTarget is a reported part of review: review itself or teacher's reply to it.
+type ReviewReportTarget string
+
+const (
+	ReviewReportTargetReview ReviewReportTarget = "review"
+	ReviewReportTargetReply  ReviewReportTarget = "reply"
+)
+
+type ReviewModerationAction string
+
+const (
+	ReviewModerationHide    ReviewModerationAction = "hide"
+	ReviewModerationRestore ReviewModerationAction = "restore"
+	ReviewModerationDelete  ReviewModerationAction = "delete"
+)
+
+type ReviewReport struct {
+	ID         int                     `db:"report_id"`
+	ReviewID   int                     `db:"review_id"`
+	Target     ReviewReportTarget      `db:"target"`
+	ReporterID int                     `db:"reporter_id"`
+	Reason     string                  `db:"reason"`
+	Resolution *ReviewModerationAction `db:"resolution"` // nil while report is pending
+	ResolvedBy *int                    `db:"resolved_by"`
+	ResolvedAt *time.Time              `db:"resolved_at"`
+	CreatedAt  time.Time               `db:"created_at"`
+}
+
+// ReviewModeration is a moderator's decision about review or reply.
+type ReviewModeration struct {
+	ReviewID int
+	Target   ReviewReportTarget
+	Action   ReviewModerationAction
+	Reason   string
+	AdminID  int
+}
+
+// ReviewModerationItem is a reported review or reply with pending reports.
+type ReviewModerationItem struct {
+	ReviewID        int                `db:"review_id"`
+	Target          ReviewReportTarget `db:"target"`
+	TeacherID       int                `db:"teacher_id"`
+	StudentID       int                `db:"student_id"`
+	Rate            int                `db:"rate"`
+	Comment         string             `db:"comment"` // comment of review or reply
+	IsHidden        bool               `db:"is_hidden"`
+	ReportsCount    int                `db:"reports_count"`
+	FirstReportedAt time.Time          `db:"first_reported_at"`
+	Reasons         []string           `db:"-"`
+}
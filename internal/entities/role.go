@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"slices"
+	"time"
+)
+
+// Permission is a named right to do something in admin panel, it's required per admin route.
+type Permission string
+
+const (
+	PermissionSkillsView         Permission = "skills.view"
+	PermissionSkillsApprove      Permission = "skills.approve"
+	PermissionCertificatesView   Permission = "certificates.view"
+	PermissionCertificatesVerify Permission = "certificates.verify"
+	PermissionCategoriesManage   Permission = "categories.manage"
+	PermissionComplaintsView     Permission = "complaints.view"
+	PermissionComplaintsManage   Permission = "complaints.manage"
+	PermissionComplaintsResolve  Permission = "complaints.resolve"
+	PermissionReviewsModerate    Permission = "reviews.moderate"
+	PermissionRatingsRepair      Permission = "ratings.repair"
+	PermissionContentModerate    Permission = "content.moderate"
+	PermissionUsersSuspend       Permission = "users.suspend"
+	PermissionAuditView          Permission = "audit.view"
+	PermissionRolesManage        Permission = "roles.manage"
+	PermissionUsersView          Permission = "users.view"
+	PermissionUsersManage        Permission = "users.manage"
+	PermissionUsersMerge         Permission = "users.merge"
+	PermissionAnalyticsView      Permission = "analytics.view"
+)
+
+type RoleName string
+
+const (
+	RoleModerator  RoleName = "moderator"
+	RoleSupport    RoleName = "support"
+	RoleFinance    RoleName = "finance"
+	RoleSuperadmin RoleName = "superadmin"
+)
+
+// Role is a named set of permissions, permissions of roles are managed by migrations.
+type Role struct {
+	ID          int          `db:"role_id"`
+	Name        RoleName     `db:"name"`
+	Description string       `db:"description"`
+	Permissions []Permission `db:"-"`
+}
+
+// UserRole is a role assigned to user.
+type UserRole struct {
+	UserID    int       `db:"user_id"`
+	RoleID    int       `db:"role_id"`
+	GrantedBy *int      `db:"granted_by"`
+	GrantedAt time.Time `db:"granted_at"`
+}
+
+// UserAccess is roles of user and permissions given by them.
+type UserAccess struct {
+	Roles       []RoleName
+	Permissions []Permission
+}
+
+// IsAdmin reports whether user has any role (and so has access to admin panel).
+func (a *UserAccess) IsAdmin() bool {
+	return len(a.Roles) > 0
+}
+
+func (a *UserAccess) HasPermission(permission Permission) bool {
+	return slices.Contains(a.Permissions, permission)
+}
@@ -0,0 +1,9 @@
+package entities
+
+// TeacherSearchResult is one row of the teacher full-text search.
+type TeacherSearchResult struct {
+	User
+	Rank       float32  `db:"rank"`
+	Headline   string   `db:"headline"`
+	Categories []string `db:"-"`
+}
@@ -0,0 +1,36 @@
+package entities
+
+import "time"
+
+// UserSession is a login of user on one device, it lives while its refresh tokens are rotated and is ended by logout.
+type UserSession struct {
+	ID              int        `db:"session_id"`
+	UserID          int        `db:"user_id"`
+	CreatedAt       time.Time  `db:"created_at"`
+	LastRefreshedAt time.Time  `db:"last_refreshed_at"`
+	ExpiresAt       time.Time  `db:"expires_at"`
+	RevokedAt       *time.Time `db:"revoked_at"`
+}
+
+// RefreshToken is a one-time token of session exchanged for new access and refresh tokens, only its hash is stored.
+type RefreshToken struct {
+	ID        int        `db:"token_id"`
+	SessionID int        `db:"session_id"`
+	TokenHash string     `db:"token_hash"`
+	CreatedAt time.Time  `db:"created_at"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+
+	// session's fields
+	UserID           int        `db:"user_id"`
+	SessionRevokedAt *time.Time `db:"session_revoked_at"`
+}
+
+// AuthTokens are tokens given to user on login, registration or refresh.
+type AuthTokens struct {
+	SessionID        int
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
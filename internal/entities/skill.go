@@ -6,7 +6,10 @@ type Skill struct {
 	CategoryID         int     `db:"category_id"`
 	CategoryName       string  `db:"category_name"`
 	VideoCardLink      string  `db:"video_card_link"`
+	VideoCardFile      string  `db:"video_card_file"`     // name of uploaded video in object storage
+	VideoCardDuration  int     `db:"video_card_duration"` // seconds
 	About              string  `db:"about"`
+	Price              int     `db:"price"`
 	Rate               float32 `db:"rate"`
 	TotalRateScore     int     `db:"total_rate_score"`
 	ReviewsCount       int     `db:"reviews_count"`
@@ -12,8 +12,19 @@ const (
 	Finished   StateName = "finished"
 	Conflicted StateName = "conflicted"
 	Completed  StateName = "completed"
+
+	Open      StateName = "open"
+	InReview  StateName = "in_review"
+	Resolved  StateName = "resolved"
+	Dismissed StateName = "dismissed"
 )
 
+// FinishedLessonStates are states of the lesson after it was finished by teacher.
+var FinishedLessonStates = []StateName{Finished, Conflicted, Completed}
+
+// ClosedComplaintStates are final states of the complaint.
+var ClosedComplaintStates = []StateName{Resolved, Dismissed}
+
 type State struct {
 	ID   int       `db:"state_id"`
 	Name StateName `db:"name"`
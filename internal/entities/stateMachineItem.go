@@ -1,10 +1,13 @@
 package entities
 
+import "time"
+
 type StateMachineName string
 
 const (
-	LessonStateMachineName StateMachineName = "lesson"
-	SkillStateMachineName  StateMachineName = "skill"
+	LessonStateMachineName    StateMachineName = "lesson"
+	SkillStateMachineName     StateMachineName = "skill"
+	ComplaintStateMachineName StateMachineName = "complaint"
 )
 
 type StateMachineItem struct {
@@ -13,3 +16,10 @@ type StateMachineItem struct {
 	StateID        int    `db:"state_id"`
 	StateName      string `db:"state_name"`
 }
+
+// StateTransitionLogEntry is a logged change of state machine item's state.
+type StateTransitionLogEntry struct {
+	FromState *StateName `db:"from_state"` // nil for item creation
+	ToState   StateName  `db:"to_state"`
+	ChangedAt time.Time  `db:"changed_at"`
+}
@@ -10,4 +10,5 @@ type StudentStatistic struct {
 type TeacherStatistic struct {
 	CountOfFinishedLesson int `db:"count_of_finished_lesson"`
 	CountOfStudents       int `db:"count_of_students"`
+	CountOfFavorites      int `db:"count_of_favorites"`
 }
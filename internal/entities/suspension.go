@@ -0,0 +1,32 @@
+package entities
+
+import "time"
+
+// UserSuspension is an admin's restriction of user's access until date or forever (ban).
+type UserSuspension struct {
+	ID        int        `db:"suspension_id"`
+	UserID    int        `db:"user_id"`
+	AdminID   *int       `db:"admin_id"`
+	Reason    string     `db:"reason"`
+	Until     *time.Time `db:"until"` // nil for permanent ban
+	CreatedAt time.Time  `db:"created_at"`
+	LiftedAt  *time.Time `db:"lifted_at"`
+	LiftedBy  *int       `db:"lifted_by"`
+}
+
+func (s *UserSuspension) IsBan() bool {
+	return s.Until == nil
+}
+
+// SuspendedLesson is a future lesson of suspended teacher which has to be cancelled (or rejected if it is pending).
+type SuspendedLesson struct {
+	LessonID           int       `db:"lesson_id"`
+	StudentID          int       `db:"student_id"`
+	StateMachineItemID int       `db:"state_machine_item_id"`
+	StateID            int       `db:"state_id"`
+	StateName          StateName `db:"state_name"`
+
+	// filled by service: state to move lesson into and notification for student
+	NextStateID  int           `db:"-"`
+	Notification *Notification `db:"-"`
+}
@@ -6,6 +6,15 @@ type Teacher struct {
 	Rate           float32          `db:"rate"`
 	TotalRateScore int              `db:"total_rate_score"`
 	ReviewsCount   int              `db:"reviews_count"`
+	RankingScore   float64          `db:"ranking_score"` // Bayesian average of reviews rate, used for ranking only
+	MinPrice       int              `db:"min_price"`
+	IsFavorite     bool             `db:"is_favorite"` // for user, who requests data
+	Headline       string           `db:"headline"`
+	Bio            string           `db:"bio"`
 	Skills         []*Skill         `db:"-"`
 	TeacherStat    TeacherStatistic `db:"-"`
+
+	Languages    []TeacherLanguage    `db:"-"`
+	Education    []TeacherEducation   `db:"-"`
+	Certificates []TeacherCertificate `db:"-"`
 }
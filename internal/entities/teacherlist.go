@@ -0,0 +1,38 @@
+package entities
+
+type TeacherSortField string
+
+const (
+	SortByRanking         TeacherSortField = "ranking"
+	SortByRating          TeacherSortField = "rating"
+	SortByReviewsCount    TeacherSortField = "reviews"
+	SortByFinishedLessons TeacherSortField = "lessons"
+	SortByPrice           TeacherSortField = "price"
+	SortByNewest          TeacherSortField = "newest"
+)
+
+// TeacherListFilter describes filters, sorting and page of the teacher catalogue.
+type TeacherListFilter struct {
+	UserID       int // who requests the list (for "my teachers", favorites filters and is_favorite flag)
+	IsMyTeachers bool
+	IsFavorites  bool
+	Categories   []string
+
+	MinRating            float64
+	MinPrice             *int
+	MaxPrice             *int
+	AvailableWithinHours int
+
+	SortBy TeacherSortField
+	Desc   bool
+	Limit  int
+	Cursor *TeacherListCursor
+}
+
+// TeacherListCursor points to the last teacher of the previous page (keyset pagination).
+type TeacherListCursor struct {
+	SortBy    TeacherSortField
+	Desc      bool
+	Value     float64
+	TeacherID int
+}
@@ -0,0 +1,48 @@
+package entities
+
+import "time"
+
+// LanguageLevels are allowed proficiency levels of teacher's languages (CEFR + native).
+var LanguageLevels = []string{"A1", "A2", "B1", "B2", "C1", "C2", "native"}
+
+// TeacherProfile is teacher's self-description, which is updated at once.
+type TeacherProfile struct {
+	Headline  string
+	Bio       string
+	Languages []TeacherLanguage
+	Education []TeacherEducation
+}
+
+type TeacherLanguage struct {
+	ID        int    `db:"teacher_language_id"`
+	TeacherID int    `db:"teacher_id"`
+	Language  string `db:"language"`
+	Level     string `db:"level"`
+}
+
+type TeacherEducation struct {
+	ID           int    `db:"education_id"`
+	TeacherID    int    `db:"teacher_id"`
+	Institution  string `db:"institution"`
+	Degree       string `db:"degree"`
+	FieldOfStudy string `db:"field_of_study"`
+	StartYear    *int   `db:"start_year"`
+	EndYear      *int   `db:"end_year"`
+}
+
+// CertificateContentTypes are supported certificate files: content type -> extension.
+var CertificateContentTypes = map[string]string{
+	"application/pdf": "pdf",
+	"image/png":       "png",
+	"image/jpeg":      "jpg",
+}
+
+type TeacherCertificate struct {
+	ID         int        `db:"certificate_id"`
+	TeacherID  int        `db:"teacher_id"`
+	Title      string     `db:"title"`
+	FileName   string     `db:"file_name"`
+	IsVerified bool       `db:"is_verified"`
+	VerifiedAt *time.Time `db:"verified_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
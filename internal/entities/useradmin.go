@@ -0,0 +1,21 @@
+package entities
+
+// UserSearchFilter describes search query and page of admin's user search.
+type UserSearchFilter struct {
+	Query string // id, part of email, name or surname; empty query matches all users
+
+	Limit  int
+	Cursor *int // id of the last user of the previous page
+}
+
+// UserOverview is everything admin sees about the user.
+type UserOverview struct {
+	User               *User
+	Access             *UserAccess
+	StudentLessons     []*Lesson
+	TeacherLessons     []*Lesson // empty if user is not a teacher
+	Skills             []*Skill  // empty if user is not a teacher
+	ComplaintsFiled    []*Complaint
+	ComplaintsReceived []*Complaint
+	Suspensions        []*UserSuspension // including lifted and expired ones, newest first
+}
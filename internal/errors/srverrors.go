@@ -13,13 +13,30 @@ var (
 	ErrorTeacherExists   = errors.New("teacher already exists")
 	ErrorTeacherNotFound = errors.New("teacher not found")
 
+	ErrorFavoriteYourself = errors.New("you can not add yourself to favorites")
+
+	ErrorInvalidLanguageLevel       = errors.New("invalid language level")
+	ErrorDuplicateLanguage          = errors.New("language is duplicated")
+	ErrorCertificateNotFound        = errors.New("certificate not found")
+	ErrorCertificateAlreadyVerified = errors.New("certificate already has been verified")
+
 	ErrorSkillUnregistered    = errors.New("teacher has not this skill")
 	ErrorSkillRegistered      = errors.New("skill already registered")
 	ErrorSkillNotFound        = errors.New("skill not found")
 	ErrorSkillAlreadyApproved = errors.New("skill already has been approved")
 	ErrorSkillInactive        = errors.New("skill is inactive")
+	ErrorVideoCardNotFound    = errors.New("video card not found")
 
-	ErrorCategoryNotFound = errors.New("category not found")
+	ErrorCategoryNotFound        = errors.New("category not found")
+	ErrorCategoryExists          = errors.New("category with such name already exists")
+	ErrorCategoryArchived        = errors.New("category is archived")
+	ErrorCategoryParentNotFound  = errors.New("parent category not found")
+	ErrorCategoryParentArchived  = errors.New("parent category is archived")
+	ErrorCategoryCycle           = errors.New("category can not be moved into itself or its subcategory")
+	ErrorCategoryReorderMismatch = errors.New("categories must be exactly all subcategories of the parent")
+	ErrorStudentTooYoung         = errors.New("student is younger than minimum age of the category")
+	ErrorTeacherTooYoung         = errors.New("teacher is younger than minimum age of the category")
+	ErrorAgeExceptionNotFound    = errors.New("age exception not found")
 
 	ErrorScheduleTimeExists            = errors.New("schedule time already exists")
 	ErrorScheduleTimeNotFound          = errors.New("schedule time not found")
@@ -32,6 +49,7 @@ var (
 	ErrorNotRelatedUserToLesson    = errors.New("user no related to this lesson")
 	ErrorNotRelatedTeacherToLesson = errors.New("teacher no related to this lesson")
 	ErrorFinishedLessonNotFound    = errors.New("finished lesson not found")
+	ErrorLessonNotFinished         = errors.New("lesson is not finished")
 
 	ErrorUnavailableOperationState  = errors.New("unavailable operation for this state")
 	ErrorUnavailableStateTransition = errors.New("unavailable such state transition")
@@ -46,8 +64,63 @@ var (
 	ErrorIncorrectFileFormat = errors.New("incorrect file format")
 
 	ErrorReviewExists              = errors.New("review already exists")
+	ErrorReviewNotFound            = errors.New("review not found")
+	ErrorNotReviewAuthor           = errors.New("review belongs to another user")
+	ErrorReviewEditWindowExpired   = errors.New("review can not be changed anymore")
+	ErrorNotReviewedTeacher        = errors.New("review is about another teacher")
+	ErrorReviewReplyExists         = errors.New("review already has a reply")
+	ErrorReviewReplyNotFound       = errors.New("review reply not found")
+	ErrorReportOwnReview           = errors.New("you can not report your own review or reply")
+	ErrorReviewAlreadyReported     = errors.New("you have already reported it")
 	ErrorReportedUserNotFound      = errors.New("reported user is not found")
 	ErrorComplainerAndReportedSame = errors.New("complainer and reported are the same person")
+	ErrorComplaintNotFound         = errors.New("complaint not found")
+
+	ErrorComplaintAttachmentNotFound = errors.New("complaint attachment not found")
+	ErrorReportedNotRelatedToLesson  = errors.New("reported user is not the other participant of the lesson")
 
 	ErrorNotAdmin = errors.New("you are not an admin")
+
+	ErrorUserSuspended       = errors.New("user is suspended")
+	ErrorUserNotSuspended    = errors.New("user is not suspended")
+	ErrorTeacherSuspended    = errors.New("teacher is suspended")
+	ErrorSuspendAdmin        = errors.New("admin can not be suspended")
+	ErrorSuspensionUntilPast = errors.New("suspension end must be in the future")
+
+	ErrorNotificationNotFound = errors.New("notification not found")
+
+	ErrorInvalidCursor = errors.New("invalid cursor")
+
+	ErrorContentRejected           = errors.New("content is rejected by moderation")
+	ErrorModerationItemNotFound    = errors.New("moderation queue item not found")
+	ErrorModerationItemDecided     = errors.New("moderation queue item is already decided")
+	ErrorUnknownModerationDecision = errors.New("unknown moderation decision")
+
+	ErrorAuditExportTooLarge = errors.New("too many audit entries to export, narrow the filters")
+
+	ErrorRoleNotFound        = errors.New("role not found")
+	ErrorRoleAlreadyAssigned = errors.New("user already has this role")
+	ErrorRoleNotAssigned     = errors.New("user doesn't have this role")
+	ErrorLastSuperadmin      = errors.New("the last superadmin can not lose superadmin role")
+	ErrorPermissionDenied    = errors.New("permission denied")
+)
+
+var (
+	ErrorMergeSameUser      = errors.New("user can not be merged into himself")
+	ErrorMergeTeachers      = errors.New("both users are teachers, teacher accounts can not be merged")
+	ErrorMergeAdmin         = errors.New("merged user has admin roles, revoke them first")
+	ErrorMergeSuspendedUser = errors.New("suspended or banned users can not be merged")
+	ErrorMergeSharedLessons = errors.New("users have lessons with each other, they can not be merged")
+)
+
+var (
+	ErrorRefreshTokenInvalid = errors.New("refresh token is invalid")
+	ErrorRefreshTokenExpired = errors.New("refresh token is expired")
+	ErrorRefreshTokenReused  = errors.New("refresh token is already used, session is revoked")
+	ErrorSessionRevoked      = errors.New("session is revoked")
+)
+
+var (
+	ErrorEmailVerificationRequired     = errors.New("email verification is required, confirm email with the link from the letter")
+	ErrorEmailVerificationTokenInvalid = errors.New("email verification token is invalid or expired")
 )
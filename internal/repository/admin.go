@@ -10,11 +10,12 @@ import (
 	"github.com/Masterminds/squirrel"
 )
 
+// IsUserAdminByID reports whether user has any role (and so has access to admin panel).
 func (r *Repository) IsUserAdminByID(ctx context.Context, id int) (bool, error) {
 	query, args, err := r.sqlBuilder.
-		Select("is_admin").
-		From("users").
-		Where(squirrel.Eq{"user_id": id}).
+		Select("EXISTS (SELECT 1 FROM user_roles ur WHERE ur.user_id = u.user_id)").
+		From("users u").
+		Where(squirrel.Eq{"u.user_id": id}).
 		ToSql()
 	if err != nil {
 		return false, fmt.Errorf("failed to build query: %w", err)
@@ -0,0 +1,278 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+
+	"github.com/lib/pq"
+)
+
+// lessonDaySQL is day (UTC) of lesson's schedule time, lessons are bucketed by it.
+const lessonDaySQL = "(st.datetime AT TIME ZONE 'UTC')::date"
+
+// affectedTeacherDaysSQL selects (teacher_id, day) pairs of rollup which must be recomputed:
+// lessons changed state since $1, reviews created, edited or moderated since $1, days which lost reviews (deleted ones)
+// and planned lessons which became no-show since $1 ($2 is no-show delay in seconds).
+const affectedTeacherDaysSQL = `
+	SELECT l.teacher_id, ` + lessonDaySQL + ` AS day
+	FROM state_transitions_log tl
+	INNER JOIN lessons l ON l.state_machine_item_id = tl.item_id
+	INNER JOIN schedule_times st ON st.schedule_time_id = l.schedule_time_id
+	WHERE tl.changed_at > $1
+	UNION
+	SELECT r.teacher_id, (r.created_at AT TIME ZONE 'UTC')::date AS day
+	FROM reviews r
+	WHERE r.created_at > $1 OR r.updated_at > $1 OR r.moderated_at > $1
+	UNION
+	SELECT ds.teacher_id, ds.day
+	FROM teacher_daily_stats ds
+	WHERE ds.reviews_count > (
+		SELECT COUNT(*) FROM reviews r
+		WHERE r.teacher_id = ds.teacher_id
+		  AND r.category_id = ds.category_id
+		  AND NOT r.is_hidden
+		  AND r.created_at >= ds.day::timestamp AT TIME ZONE 'UTC'
+		  AND r.created_at < (ds.day + 1)::timestamp AT TIME ZONE 'UTC'
+	)
+	UNION
+	SELECT l.teacher_id, ` + lessonDaySQL + ` AS day
+	FROM schedule_times st
+	INNER JOIN lessons l ON l.schedule_time_id = st.schedule_time_id
+	WHERE st.datetime + make_interval(secs => $2) > $1
+	  AND st.datetime + make_interval(secs => $2) <= NOW()
+`
+
+// RefreshTeacherDailyStats recomputes teacher_daily_stats rollup for days affected by changes since `since`,
+// zero since rebuilds whole rollup.
+// Planned lesson becomes no-show after noShowAfter since its start.
+func (r *Repository) RefreshTeacherDailyStats(ctx context.Context, since time.Time, noShowAfter time.Duration) error {
+	// affected days are fixed once: deleting of outdated rows changes result of affectedTeacherDaysSQL
+	const createAffectedQuery = `
+	CREATE TEMP TABLE affected_teacher_days (teacher_id INTEGER NOT NULL, day DATE NOT NULL) ON COMMIT DROP
+	`
+
+	affectedQuery := `INSERT INTO affected_teacher_days (teacher_id, day) ` + affectedTeacherDaysSQL
+
+	deleteQuery := `
+	DELETE FROM teacher_daily_stats ds
+	USING affected_teacher_days a
+	WHERE ds.teacher_id = a.teacher_id AND ds.day = a.day
+	`
+
+	if since.IsZero() {
+		deleteQuery = `DELETE FROM teacher_daily_stats`
+	}
+
+	insertQuery := `
+	WITH lesson_stats AS (
+		SELECT
+			l.teacher_id,
+			l.category_id,
+			` + lessonDaySQL + ` AS day,
+			s.name = ANY($2) AS is_finished,
+			s.name = $3 AS is_cancelled,
+			s.name = $4 AS is_rejected,
+			s.name = $5 AND st.datetime + make_interval(secs => $1) <= NOW() AS is_not_started,
+			EXISTS (
+				SELECT 1 FROM state_transitions_log tl
+				WHERE tl.item_id = l.state_machine_item_id
+				  AND tl.to_state_id = smi.state_id
+				  AND tl.changed_at >= st.datetime
+			) AS is_changed_after_start,
+			l.price
+		FROM lessons l
+		INNER JOIN schedule_times st ON st.schedule_time_id = l.schedule_time_id
+		INNER JOIN state_machines_items smi ON smi.item_id = l.state_machine_item_id
+		INNER JOIN states s ON s.state_id = smi.state_id
+		WHERE (l.teacher_id, ` + lessonDaySQL + `) IN (SELECT teacher_id, day FROM affected_teacher_days)
+	),
+	stat_rows AS (
+		SELECT
+			teacher_id,
+			category_id,
+			day,
+			1 AS lessons_count,
+			is_finished::int AS finished_count,
+			(is_cancelled AND NOT is_changed_after_start)::int AS cancelled_count,
+			is_rejected::int AS rejected_count,
+			(is_not_started OR (is_cancelled AND is_changed_after_start))::int AS no_show_count,
+			CASE WHEN is_finished THEN price ELSE 0 END AS earnings,
+			0 AS reviews_count,
+			0 AS rate_sum
+		FROM lesson_stats
+		UNION ALL
+		SELECT
+			r.teacher_id,
+			r.category_id,
+			(r.created_at AT TIME ZONE 'UTC')::date,
+			0, 0, 0, 0, 0, 0,
+			1,
+			r.rate
+		FROM reviews r
+		WHERE (r.teacher_id, (r.created_at AT TIME ZONE 'UTC')::date) IN (SELECT teacher_id, day FROM affected_teacher_days)
+		  AND NOT r.is_hidden
+	)
+	INSERT INTO teacher_daily_stats (
+		teacher_id, category_id, day,
+		lessons_count, finished_count, cancelled_count, rejected_count, no_show_count,
+		earnings, reviews_count, rate_sum
+	)
+	SELECT
+		teacher_id, category_id, day,
+		SUM(lessons_count), SUM(finished_count), SUM(cancelled_count), SUM(rejected_count), SUM(no_show_count),
+		SUM(earnings), SUM(reviews_count), SUM(rate_sum)
+	FROM stat_rows
+	GROUP BY teacher_id, category_id, day
+	`
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, createAffectedQuery); err != nil {
+		return fmt.Errorf("failed to create affected teacher days table: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, affectedQuery, since, noShowAfter.Seconds()); err != nil {
+		return fmt.Errorf("failed to find affected teacher days: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, deleteQuery); err != nil {
+		return fmt.Errorf("failed to delete outdated teacher daily stats: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, insertQuery,
+		noShowAfter.Seconds(),
+		pq.Array(stateNamesToStrings(entities.FinishedLessonStates)),
+		entities.Cancelled,
+		entities.Rejected,
+		entities.Planned,
+	); err != nil {
+		return fmt.Errorf("failed to insert teacher daily stats: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+const statsSumsSQL = `
+	COALESCE(SUM(ds.lessons_count), 0) AS lessons_count,
+	COALESCE(SUM(ds.finished_count), 0) AS finished_count,
+	COALESCE(SUM(ds.cancelled_count), 0) AS cancelled_count,
+	COALESCE(SUM(ds.rejected_count), 0) AS rejected_count,
+	COALESCE(SUM(ds.no_show_count), 0) AS no_show_count,
+	COALESCE(SUM(ds.earnings), 0) AS earnings,
+	COALESCE(SUM(ds.reviews_count), 0) AS reviews_count,
+	COALESCE(SUM(ds.rate_sum), 0) AS rate_sum
+`
+
+// GetTeacherStatsTotal returns teacher's stats summed over days range.
+func (r *Repository) GetTeacherStatsTotal(ctx context.Context, teacherID int, from, to time.Time) (*entities.TeacherStatsBucket, error) {
+	query := `
+	SELECT ` + statsSumsSQL + `
+	FROM teacher_daily_stats ds
+	WHERE ds.teacher_id = $1 AND ds.day BETWEEN $2::date AND $3::date
+	`
+
+	var total entities.TeacherStatsBucket
+
+	if err := r.db.GetContext(ctx, &total, query, teacherID, from, to); err != nil {
+		return nil, fmt.Errorf("failed to get teacher stats total: %w", err)
+	}
+
+	return &total, nil
+}
+
+// GetTeacherStatsByPeriods returns teacher's stats for every week or month of days range (empty periods included).
+func (r *Repository) GetTeacherStatsByPeriods(ctx context.Context, teacherID int, from, to time.Time,
+	period entities.AnalyticsPeriod) ([]entities.TeacherStatsBucket, error) {
+	query := `
+	SELECT
+		p.period_start::date AS period_start,
+		` + statsSumsSQL + `
+	FROM generate_series(
+		date_trunc($4::text, $2::date::timestamp),
+		$3::date::timestamp,
+		('1 ' || $4::text)::interval
+	) AS p(period_start)
+	LEFT JOIN teacher_daily_stats ds
+		ON ds.teacher_id = $1
+		AND ds.day BETWEEN $2::date AND $3::date
+		AND date_trunc($4::text, ds.day::timestamp) = p.period_start
+	GROUP BY p.period_start
+	ORDER BY p.period_start
+	`
+
+	var periods []entities.TeacherStatsBucket
+
+	if err := r.db.SelectContext(ctx, &periods, query, teacherID, from, to, string(period)); err != nil {
+		return nil, fmt.Errorf("failed to get teacher stats by periods: %w", err)
+	}
+
+	return periods, nil
+}
+
+// GetTeacherStatsByCategories returns teacher's stats for every category summed over days range.
+func (r *Repository) GetTeacherStatsByCategories(ctx context.Context, teacherID int, from, to time.Time) ([]entities.TeacherStatsBucket, error) {
+	query := `
+	SELECT
+		c.category_id,
+		c.name AS category_name,
+		` + statsSumsSQL + `
+	FROM teacher_daily_stats ds
+	INNER JOIN categories c ON c.category_id = ds.category_id
+	WHERE ds.teacher_id = $1 AND ds.day BETWEEN $2::date AND $3::date
+	GROUP BY c.category_id, c.name
+	ORDER BY lessons_count DESC, c.category_id
+	`
+
+	var categories []entities.TeacherStatsBucket
+
+	if err := r.db.SelectContext(ctx, &categories, query, teacherID, from, to); err != nil {
+		return nil, fmt.Errorf("failed to get teacher stats by categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+// GetTeacherStudentsStat returns count of students with finished lessons in days range
+// and count of them with more than one such lesson.
+func (r *Repository) GetTeacherStudentsStat(ctx context.Context, teacherID int, from, to time.Time) (int, int, error) {
+	query := `
+	SELECT
+		COUNT(*) AS students_count,
+		COUNT(*) FILTER (WHERE lessons_count > 1) AS repeat_students_count
+	FROM (
+		SELECT l.student_id, COUNT(*) AS lessons_count
+		FROM lessons l
+		INNER JOIN schedule_times st ON st.schedule_time_id = l.schedule_time_id
+		INNER JOIN state_machines_items smi ON smi.item_id = l.state_machine_item_id
+		INNER JOIN states s ON s.state_id = smi.state_id
+		WHERE l.teacher_id = $1
+		  AND ` + lessonDaySQL + ` BETWEEN $2::date AND $3::date
+		  AND s.name = ANY($4)
+		GROUP BY l.student_id
+	) students
+	`
+
+	var stat struct {
+		StudentsCount       int `db:"students_count"`
+		RepeatStudentsCount int `db:"repeat_students_count"`
+	}
+
+	err := r.db.GetContext(ctx, &stat, query,
+		teacherID, from, to, pq.Array(stateNamesToStrings(entities.FinishedLessonStates)))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get teacher students stat: %w", err)
+	}
+
+	return stat.StudentsCount, stat.RepeatStudentsCount, nil
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	internalErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// insertAdminAuditEntry appends admin's mutation made in tx to audit log and sets id of entry,
+// so mutation is saved only together with its audit entry.
+func (r *Repository) insertAdminAuditEntry(ctx context.Context, tx *sqlx.Tx, entry *entities.AdminAuditEntry) error {
+	query, args, err := r.sqlBuilder.
+		Insert("admin_audit_log").
+		Columns("admin_id", "action", "entity_type", "entity_id", "before", "after", "request_id").
+		Values(entry.AdminID, entry.Action, entry.EntityType, entry.EntityID, jsonParam(entry.Before), jsonParam(entry.After), entry.RequestID).
+		Suffix("RETURNING audit_id").
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	if err = tx.GetContext(ctx, &entry.ID, query, args...); err != nil {
+		return fmt.Errorf("failed to insert admin audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// SetAdminAuditEntryAfter sets snapshot of entity after the mutation to audit entry,
+// returns internalErrs.ErrorSelectEmpty if there is no such entry.
+func (r *Repository) SetAdminAuditEntryAfter(ctx context.Context, id int, after []byte) error {
+	query, args, err := r.sqlBuilder.
+		Update("admin_audit_log").
+		Set("after", jsonParam(after)).
+		Where(squirrel.Eq{"audit_id": id}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update admin audit entry: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	return nil
+}
+
+// GetAdminAuditLog returns entries of audit log (newest first) and whether there are more entries after the page.
+func (r *Repository) GetAdminAuditLog(ctx context.Context, filter *entities.AuditLogFilter) ([]*entities.AdminAuditEntry, bool, error) {
+	builder := r.sqlBuilder.
+		Select(
+			"audit_id",
+			"admin_id",
+			"action",
+			"entity_type",
+			"entity_id",
+			"before",
+			"after",
+			"request_id",
+			"created_at",
+		).
+		From("admin_audit_log").
+		OrderBy("audit_id DESC").
+		Limit(uint64(filter.Limit + 1)) // one extra row to know if there is next page
+
+	if filter.AdminID != nil {
+		builder = builder.Where(squirrel.Eq{"admin_id": *filter.AdminID})
+	}
+
+	if filter.Action != nil {
+		builder = builder.Where(squirrel.Eq{"action": *filter.Action})
+	}
+
+	if filter.EntityType != nil {
+		builder = builder.Where(squirrel.Eq{"entity_type": *filter.EntityType})
+	}
+
+	if filter.EntityID != nil {
+		builder = builder.Where(squirrel.Eq{"entity_id": *filter.EntityID})
+	}
+
+	if filter.From != nil {
+		builder = builder.Where(squirrel.GtOrEq{"created_at": *filter.From})
+	}
+
+	if filter.To != nil {
+		builder = builder.Where(squirrel.Lt{"created_at": *filter.To})
+	}
+
+	if filter.Cursor != nil {
+		builder = builder.Where(squirrel.Lt{"audit_id": *filter.Cursor})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var entries []*entities.AdminAuditEntry
+
+	if err = r.db.SelectContext(ctx, &entries, query, args...); err != nil {
+		return nil, false, fmt.Errorf("failed to select admin audit log: %w", err)
+	}
+
+	hasMore := len(entries) > filter.Limit
+	if hasMore {
+		entries = entries[:filter.Limit]
+	}
+
+	return entries, hasMore, nil
+}
+
+// jsonParam passes JSON document as text (driver sends []byte as bytea), nil becomes NULL.
+func jsonParam(data []byte) any {
+	if data == nil {
+		return nil
+	}
+
+	return string(data)
+}
@@ -7,13 +7,18 @@ import (
 	"fmt"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	internalErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
 )
 
+var categoryColumns = []string{"category_id", "name", "min_age", "parent_id", "is_archived", "position"}
+
 func (r *Repository) GetCategories(ctx context.Context) ([]*entities.Category, error) {
-	query, args, err := r.sqlBuilder.Select("category_id", "name", "min_age").
+	query, args, err := r.sqlBuilder.Select(categoryColumns...).
 		From("categories").
+		OrderBy("parent_id NULLS FIRST", "position", "category_id").
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build query: %w", err)
@@ -55,3 +60,197 @@ func (r *Repository) IsCategoryExistsByID(ctx context.Context, id int) (bool, er
 
 	return exists, nil
 }
+
+func (r *Repository) GetCategoryByID(ctx context.Context, id int) (*entities.Category, error) {
+	query, args, err := r.sqlBuilder.
+		Select(categoryColumns...).
+		From("categories").
+		Where(squirrel.Eq{"category_id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var category entities.Category
+
+	if err = r.db.GetContext(ctx, &category, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internalErrs.ErrorSelectEmpty
+		}
+
+		return nil, fmt.Errorf("failed to find category: %w", err)
+	}
+
+	return &category, nil
+}
+
+// CreateCategory creates category as the last one among categories with the same parent.
+func (r *Repository) CreateCategory(ctx context.Context, category *entities.Category, auditEntry *entities.AdminAuditEntry) (int, error) {
+	const query = `
+	INSERT INTO categories (name, min_age, parent_id, position)
+	VALUES ($1, $2, $3, COALESCE((SELECT MAX(position) + 1 FROM categories WHERE parent_id IS NOT DISTINCT FROM $3), 0))
+	RETURNING category_id
+	`
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var id int
+
+	err = tx.QueryRowContext(ctx, query, category.Name, category.MinAge, category.ParentID).Scan(&id)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, internalErrs.ErrorNonUniqueData
+		}
+
+		return 0, fmt.Errorf("failed to insert category: %w", err)
+	}
+
+	auditEntry.EntityID = &id
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return id, nil
+}
+
+// UpdateCategory updates name, min_age and parent of category,
+// moved category becomes the last one among new siblings.
+func (r *Repository) UpdateCategory(ctx context.Context, category *entities.Category, auditEntry *entities.AdminAuditEntry) error {
+	const query = `
+	UPDATE categories
+	SET
+		name = $2,
+		min_age = $3,
+		position = CASE
+			WHEN parent_id IS NOT DISTINCT FROM $4 THEN position
+			ELSE COALESCE((SELECT MAX(position) + 1 FROM categories WHERE parent_id IS NOT DISTINCT FROM $4), 0)
+		END,
+		parent_id = $4
+	WHERE category_id = $1
+	`
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, category.ID, category.Name, category.MinAge, category.ParentID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return internalErrs.ErrorNonUniqueData
+		}
+
+		return fmt.Errorf("failed to update category: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// SetCategoryArchived archives (or restores) category with all its subcategories.
+// Skills and lessons of archived categories are kept.
+func (r *Repository) SetCategoryArchived(ctx context.Context, id int, isArchived bool, auditEntry *entities.AdminAuditEntry) error {
+	const query = `
+	WITH RECURSIVE subtree AS (
+		SELECT category_id FROM categories WHERE category_id = $1
+		UNION ALL
+		SELECT c.category_id FROM categories c INNER JOIN subtree st ON c.parent_id = st.category_id
+	)
+	UPDATE categories
+	SET is_archived = $2
+	WHERE category_id IN (SELECT category_id FROM subtree)
+	`
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, id, isArchived)
+	if err != nil {
+		return fmt.Errorf("failed to update is_archived of categories: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ReorderCategories sets positions of categories in order of ids.
+func (r *Repository) ReorderCategories(ctx context.Context, ids []int, auditEntry *entities.AdminAuditEntry) error {
+	const query = `
+	UPDATE categories c
+	SET position = o.position - 1
+	FROM unnest($1::INTEGER[]) WITH ORDINALITY AS o(category_id, position)
+	WHERE c.category_id = o.category_id
+	`
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, query, pq.Array(ids)); err != nil {
+		return fmt.Errorf("failed to reorder categories: %w", err)
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+
+	// error code 23505 mean unique_violation
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
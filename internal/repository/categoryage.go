@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	internalErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// userAgeCondition is true when user u is old enough for category c or has an exception for it.
+const userAgeCondition = `(
+	EXTRACT(YEAR FROM AGE(CURRENT_DATE, u.birthdate)) >= c.min_age
+	OR EXISTS (
+		SELECT 1 FROM category_age_exceptions e
+		WHERE e.user_id = u.user_id AND e.category_id = c.category_id
+	)
+)`
+
+// IsUserOldEnoughForCategory checks user's age against category's min age (age exceptions are taken into account).
+func (r *Repository) IsUserOldEnoughForCategory(ctx context.Context, userID, categoryID int) (bool, error) {
+	query := `
+	SELECT ` + userAgeCondition + `
+	FROM users u
+	CROSS JOIN categories c
+	WHERE u.user_id = $1 AND c.category_id = $2
+	`
+
+	var isOldEnough bool
+
+	if err := r.db.GetContext(ctx, &isOldEnough, query, userID, categoryID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, internalErrs.ErrorSelectEmpty
+		}
+
+		return false, fmt.Errorf("failed to check user age for category: %w", err)
+	}
+
+	return isOldEnough, nil
+}
+
+// GetTooYoungCategoryIDs returns ids of categories user is too young for (and has no exception).
+func (r *Repository) GetTooYoungCategoryIDs(ctx context.Context, userID int) ([]int, error) {
+	query := `
+	SELECT c.category_id
+	FROM users u
+	CROSS JOIN categories c
+	WHERE u.user_id = $1 AND NOT ` + userAgeCondition
+
+	var ids []int
+
+	if err := r.db.SelectContext(ctx, &ids, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to get too young categories: %w", err)
+	}
+
+	return ids, nil
+}
+
+// CreateCategoryAgeException grants exception, granting existing exception isn't error.
+func (r *Repository) CreateCategoryAgeException(ctx context.Context, exception *entities.CategoryAgeException,
+	auditEntry *entities.AdminAuditEntry) error {
+	query, args, err := r.sqlBuilder.
+		Insert("category_age_exceptions").
+		Columns("user_id", "category_id", "granted_by").
+		Values(exception.UserID, exception.CategoryID, exception.GrantedBy).
+		Suffix("ON CONFLICT (user_id, category_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert category age exception: %w", err)
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteCategoryAgeException(ctx context.Context, userID, categoryID int, auditEntry *entities.AdminAuditEntry) error {
+	query, args, err := r.sqlBuilder.
+		Delete("category_age_exceptions").
+		Where("user_id = ? AND category_id = ?", userID, categoryID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete category age exception: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetCategoryAgeExceptions(ctx context.Context, categoryID int) ([]entities.CategoryAgeException, error) {
+	query, args, err := r.sqlBuilder.
+		Select("user_id", "category_id", "granted_by", "created_at").
+		From("category_age_exceptions").
+		Where("category_id = ?", categoryID).
+		OrderBy("created_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var exceptions []entities.CategoryAgeException
+
+	if err = r.db.SelectContext(ctx, &exceptions, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get category age exceptions: %w", err)
+	}
+
+	return exceptions, nil
+}
@@ -2,51 +2,429 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
+	"github.com/Masterminds/squirrel"
+
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	internalErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
 )
 
-func (r *Repository) CreateComplaint(ctx context.Context, complaint *entities.Complaint) error {
+// CreateComplaint creates complaint with its attachments (already uploaded files) and returns its id,
+// flagged text (if not nil) is put into moderation queue with it.
+func (r *Repository) CreateComplaint(ctx context.Context, complaint *entities.Complaint,
+	moderationItem *entities.ModerationQueueItem) (int, error) {
+	stateMachine, err := r.getStateMachineByName(ctx, entities.ComplaintStateMachineName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get complaint's statemachine: %w", err)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	itemID, err := r.insertStateMachineItem(ctx, tx, *stateMachine)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create state machine item: %w", err)
+	}
+
 	query, args, err := r.sqlBuilder.
 		Insert("complaints").
-		Columns("complainer_id", "reported_id", "reason", "description").
-		Values(complaint.ComplainerID, complaint.ReportedID, complaint.Reason, complaint.Description).
+		Columns("complainer_id", "reported_id", "lesson_id", "reason", "description", "state_machine_item_id").
+		Values(complaint.ComplainerID, complaint.ReportedID, complaint.LessonID, complaint.Reason, complaint.Description, itemID).
+		Suffix("RETURNING complaint_id").
+		ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	var id int
+
+	if err = tx.GetContext(ctx, &id, query, args...); err != nil {
+		return 0, fmt.Errorf("failed to insert complaint: %w", err)
+	}
+
+	if len(complaint.Attachments) > 0 {
+		builder := r.sqlBuilder.
+			Insert("complaint_attachments").
+			Columns("complaint_id", "file_name")
+
+		for _, attachment := range complaint.Attachments {
+			builder = builder.Values(id, attachment.FileName)
+		}
+
+		query, args, err = builder.ToSql()
+		if err != nil {
+			return 0, fmt.Errorf("failed to build insert query: %w", err)
+		}
+
+		if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+			return 0, fmt.Errorf("failed to insert complaint attachments: %w", err)
+		}
+	}
+
+	if moderationItem != nil {
+		moderationItem.EntityID = &id
+
+		if err = r.insertModerationQueueItem(ctx, tx, moderationItem); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return id, nil
+}
+
+// GetComplaintsFiltered returns one page of complaints (newest first) with complainer's and reported's data.
+// Second returned value reports whether there are more complaints after this page.
+func (r *Repository) GetComplaintsFiltered(ctx context.Context, filter *entities.ComplaintListFilter) ([]*entities.Complaint, bool, error) {
+	builder := r.complaintSelectBuilder().
+		OrderBy("c.complaint_id DESC").
+		Limit(uint64(filter.Limit + 1)) // one extra row to know if there is next page
+
+	if filter.State != nil {
+		builder = builder.Where(squirrel.Eq{"s.name": *filter.State})
+	}
+
+	if filter.AssigneeID != nil {
+		builder = builder.Where(squirrel.Eq{"c.assignee_id": *filter.AssigneeID})
+	}
+
+	if filter.Cursor != nil {
+		builder = builder.Where(squirrel.Lt{"c.complaint_id": *filter.Cursor})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var rows []complaintRow
+
+	if err = r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, false, fmt.Errorf("failed to select complaints: %w", err)
+	}
+
+	hasMore := len(rows) > filter.Limit
+	if hasMore {
+		rows = rows[:filter.Limit]
+	}
+
+	complaints := make([]*entities.Complaint, 0, len(rows))
+	for i := range rows {
+		complaints = append(complaints, rows[i].toEntity())
+	}
+
+	return complaints, hasMore, nil
+}
+
+func (r *Repository) GetComplaintByID(ctx context.Context, id int) (*entities.Complaint, error) {
+	query, args, err := r.complaintSelectBuilder().
+		Where(squirrel.Eq{"c.complaint_id": id}).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var row complaintRow
+
+	if err = r.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internalErrs.ErrorSelectEmpty
+		}
+
+		return nil, fmt.Errorf("failed to get complaint: %w", err)
+	}
+
+	return row.toEntity(), nil
+}
+
+// AssignComplaint moves complaint's state machine item to the new state and sets admin as assignee.
+// Returns internalErrs.ErrorSelectEmpty if complaint's state was changed concurrently.
+func (r *Repository) AssignComplaint(ctx context.Context, complaintID int, item *entities.StateMachineItem, newStateID, adminID int,
+	auditEntry *entities.AdminAuditEntry) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err = r.moveStateMachineItem(ctx, tx, item, newStateID); err != nil {
+		return err
+	}
+
+	query, args, err := r.sqlBuilder.
+		Update("complaints").
+		Set("assignee_id", adminID).
+		Where(squirrel.Eq{"complaint_id": complaintID}).
 		ToSql()
 
 	if err != nil {
-		return fmt.Errorf("failed to build insert query: %w", err)
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to assign complaint: %w", err)
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
 	}
 
-	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
-		return fmt.Errorf("failed to insert complaint: %w", err)
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
 	}
 
 	return nil
 }
 
-func (r *Repository) GetAllComplaints(ctx context.Context) ([]*entities.Complaint, error) {
+// ResolveComplaint moves complaint's state machine item to the final state,
+// records the resolution and notifies complainer about it.
+// Returns internalErrs.ErrorSelectEmpty if complaint's state was changed concurrently.
+func (r *Repository) ResolveComplaint(ctx context.Context,
+	resolution *entities.ComplaintResolution,
+	item *entities.StateMachineItem,
+	newStateID int,
+	notification *entities.Notification,
+	auditEntry *entities.AdminAuditEntry) error {
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err = r.moveStateMachineItem(ctx, tx, item, newStateID); err != nil {
+		return err
+	}
+
+	query, args, err := r.sqlBuilder.
+		Update("complaints").
+		Set("resolution", resolution.Resolution).
+		Set("resolved_by", resolution.AdminID).
+		Set("resolved_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"complaint_id": resolution.ComplaintID}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to resolve complaint: %w", err)
+	}
+
+	if err = r.insertNotification(ctx, tx, notification); err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateComplaintNote(ctx context.Context, note *entities.ComplaintNote, auditEntry *entities.AdminAuditEntry) (int, error) {
+	query, args, err := r.sqlBuilder.
+		Insert("complaint_notes").
+		Columns("complaint_id", "author_id", "text").
+		Values(note.ComplaintID, note.AuthorID, note.Text).
+		Suffix("RETURNING note_id").
+		ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var id int
+
+	if err = tx.GetContext(ctx, &id, query, args...); err != nil {
+		return 0, fmt.Errorf("failed to insert complaint note: %w", err)
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *Repository) GetComplaintNotes(ctx context.Context, complaintID int) ([]*entities.ComplaintNote, error) {
 	query, args, err := r.sqlBuilder.
 		Select(
+			"note_id",
 			"complaint_id",
-			"complainer_id",
-			"reported_id",
-			"reason",
-			"description",
+			"author_id",
+			"text",
 			"created_at",
 		).
-		From("complaints").
+		From("complaint_notes").
+		Where(squirrel.Eq{"complaint_id": complaintID}).
+		OrderBy("created_at", "note_id").
 		ToSql()
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to build select query: %w", err)
+		return nil, fmt.Errorf("failed to build query: %w", err)
 	}
 
-	var complaints []*entities.Complaint
+	var notes []*entities.ComplaintNote
+
+	if err = r.db.SelectContext(ctx, &notes, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select complaint notes: %w", err)
+	}
+
+	return notes, nil
+}
+
+func (r *Repository) GetComplaintAttachments(ctx context.Context, complaintID int) ([]entities.ComplaintAttachment, error) {
+	query, args, err := r.sqlBuilder.
+		Select(
+			"attachment_id",
+			"complaint_id",
+			"file_name",
+			"created_at",
+		).
+		From("complaint_attachments").
+		Where(squirrel.Eq{"complaint_id": complaintID}).
+		OrderBy("attachment_id").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var attachments []entities.ComplaintAttachment
+
+	if err = r.db.SelectContext(ctx, &attachments, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select complaint attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+func (r *Repository) GetComplaintAttachmentByID(ctx context.Context, complaintID, attachmentID int) (*entities.ComplaintAttachment, error) {
+	query, args, err := r.sqlBuilder.
+		Select(
+			"attachment_id",
+			"complaint_id",
+			"file_name",
+			"created_at",
+		).
+		From("complaint_attachments").
+		Where(squirrel.Eq{
+			"attachment_id": attachmentID,
+			"complaint_id":  complaintID,
+		}).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var attachment entities.ComplaintAttachment
+
+	if err = r.db.GetContext(ctx, &attachment, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internalErrs.ErrorSelectEmpty
+		}
+
+		return nil, fmt.Errorf("failed to get complaint attachment: %w", err)
+	}
+
+	return &attachment, nil
+}
+
+func (r *Repository) complaintSelectBuilder() squirrel.SelectBuilder {
+	return r.sqlBuilder.
+		Select(
+			"c.complaint_id",
+			"c.complainer_id",
+			"c.reported_id",
+			"c.lesson_id",
+			"c.reason",
+			"c.description",
+			"c.created_at",
+			"c.state_machine_item_id",
+			"s.name AS state_name",
+			"c.assignee_id",
+			"c.resolution",
+			"c.resolved_by",
+			"c.resolved_at",
+
+			"cu.name AS complainer_name",
+			"cu.surname AS complainer_surname",
+			"cu.email AS complainer_email",
+			"cu.avatar AS complainer_avatar",
+
+			"ru.name AS reported_name",
+			"ru.surname AS reported_surname",
+			"ru.email AS reported_email",
+			"ru.avatar AS reported_avatar",
+		).
+		From("complaints c").
+		InnerJoin("state_machines_items i ON i.item_id = c.state_machine_item_id").
+		InnerJoin("states s ON s.state_id = i.state_id").
+		InnerJoin("users cu ON cu.user_id = c.complainer_id").
+		InnerJoin("users ru ON ru.user_id = c.reported_id")
+}
+
+// complaintRow is a temp struct for complaint joined with its users.
+type complaintRow struct {
+	entities.Complaint
+
+	ComplainerName    string `db:"complainer_name"`
+	ComplainerSurname string `db:"complainer_surname"`
+	ComplainerEmail   string `db:"complainer_email"`
+	ComplainerAvatar  string `db:"complainer_avatar"`
+
+	ReportedName    string `db:"reported_name"`
+	ReportedSurname string `db:"reported_surname"`
+	ReportedEmail   string `db:"reported_email"`
+	ReportedAvatar  string `db:"reported_avatar"`
+}
+
+func (row *complaintRow) toEntity() *entities.Complaint {
+	complaint := row.Complaint
+
+	complaint.Complainer = &entities.User{
+		ID:      row.ComplainerID,
+		Name:    row.ComplainerName,
+		Surname: row.ComplainerSurname,
+		Email:   row.ComplainerEmail,
+		Avatar:  row.ComplainerAvatar,
+	}
 
-	if err := r.db.SelectContext(ctx, &complaints, query, args...); err != nil {
-		return nil, fmt.Errorf("failed to select complaints: %w", err)
+	complaint.Reported = &entities.User{
+		ID:      row.ReportedID,
+		Name:    row.ReportedName,
+		Surname: row.ReportedSurname,
+		Email:   row.ReportedEmail,
+		Avatar:  row.ReportedAvatar,
 	}
 
-	return complaints, nil
+	return &complaint
 }
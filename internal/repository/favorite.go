@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+)
+
+// AddFavoriteTeacher adds teacher to user's favorites, adding already favorite teacher isn't error.
+func (r *Repository) AddFavoriteTeacher(ctx context.Context, userID, teacherID int) error {
+	query, args, err := r.sqlBuilder.
+		Insert("favorite_teachers").
+		Columns("user_id", "teacher_id").
+		Values(userID, teacherID).
+		Suffix("ON CONFLICT (user_id, teacher_id) DO NOTHING").
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert favorite teacher: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteFavoriteTeacher removes teacher from user's favorites, removing not favorite teacher isn't error.
+func (r *Repository) DeleteFavoriteTeacher(ctx context.Context, userID, teacherID int) error {
+	query, args, err := r.sqlBuilder.
+		Delete("favorite_teachers").
+		Where("user_id = ? AND teacher_id = ?", userID, teacherID).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to delete favorite teacher: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) IsFavoriteTeacher(ctx context.Context, userID, teacherID int) (bool, error) {
+	const query = `SELECT EXISTS (SELECT 1 FROM favorite_teachers WHERE user_id = $1 AND teacher_id = $2)`
+
+	var exists bool
+
+	if err := r.db.GetContext(ctx, &exists, query, userID, teacherID); err != nil {
+		return false, fmt.Errorf("failed to check favorite teacher: %w", err)
+	}
+
+	return exists, nil
+}
@@ -18,7 +18,8 @@ func (r *Repository) BookLesson(ctx context.Context,
 	scheduleTimeID,
 	studentID,
 	teacherID,
-	categoryID int) error {
+	categoryID,
+	price int) error {
 
 	stateMachine, err := r.getStateMachineByName(ctx, entities.LessonStateMachineName)
 	if err != nil {
@@ -50,13 +51,15 @@ func (r *Repository) BookLesson(ctx context.Context,
 			"teacher_id",
 			"category_id",
 			"schedule_time_id",
-			"state_machine_item_id").
+			"state_machine_item_id",
+			"price").
 		Values(
 			studentID,
 			teacherID,
 			categoryID,
 			scheduleTimeID,
-			itemID).
+			itemID,
+			price).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to build insert query: %w", err)
@@ -442,3 +445,35 @@ func (r *Repository) GetStudentLessonsByUserID(ctx context.Context, id int) ([]*
 
 	return lessons, nil
 }
+
+// GetStudentFinishedLessons returns finished lessons of student sorted by time,
+// lessons without known actual duration get defaultDuration.
+func (r *Repository) GetStudentFinishedLessons(ctx context.Context, studentID int,
+	defaultDuration time.Duration) ([]entities.StudentFinishedLesson, error) {
+	const query = `
+	SELECT
+		l.lesson_id,
+		l.teacher_id,
+		l.category_id,
+		c.name AS category_name,
+		st.datetime,
+		COALESCE(l.duration_minutes, $2) AS duration_minutes
+	FROM lessons l
+	INNER JOIN schedule_times st ON st.schedule_time_id = l.schedule_time_id
+	INNER JOIN categories c ON c.category_id = l.category_id
+	INNER JOIN state_machines_items smi ON smi.item_id = l.state_machine_item_id
+	INNER JOIN states s ON s.state_id = smi.state_id
+	WHERE l.student_id = $1 AND s.name = ANY($3)
+	ORDER BY st.datetime, l.lesson_id
+	`
+
+	var lessons []entities.StudentFinishedLesson
+
+	err := r.db.SelectContext(ctx, &lessons, query,
+		studentID, int(defaultDuration.Minutes()), pq.Array(stateNamesToStrings(entities.FinishedLessonStates)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get student finished lessons: %w", err)
+	}
+
+	return lessons, nil
+}
@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	internalErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// CreateModerationQueueItem puts flagged text into admin's moderation queue.
+func (r *Repository) CreateModerationQueueItem(ctx context.Context, item *entities.ModerationQueueItem) (int, error) {
+	query, args, err := r.moderationQueueInsertBuilder(item).
+		Suffix("RETURNING item_id").
+		ToSql()
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var id int
+
+	if err = r.db.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		return 0, fmt.Errorf("failed to insert moderation queue item: %w", err)
+	}
+
+	return id, nil
+}
+
+// insertModerationQueueItem puts flagged text of entity created in tx into moderation queue.
+func (r *Repository) insertModerationQueueItem(ctx context.Context, tx *sqlx.Tx, item *entities.ModerationQueueItem) error {
+	query, args, err := r.moderationQueueInsertBuilder(item).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert moderation queue item: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) moderationQueueInsertBuilder(item *entities.ModerationQueueItem) squirrel.InsertBuilder {
+	return r.sqlBuilder.
+		Insert("moderation_queue").
+		Columns("kind", "author_id", "entity_id", "text", "reasons").
+		Values(item.Kind, item.AuthorID, item.EntityID, item.Text, pq.Array(item.Reasons))
+}
+
+// GetModerationQueue returns pending items of moderation queue, oldest first,
+// and whether there are more items after the page.
+func (r *Repository) GetModerationQueue(ctx context.Context, filter *entities.ModerationQueueFilter) ([]*entities.ModerationQueueItem, bool, error) {
+	builder := r.moderationQueueSelectBuilder().
+		Where(squirrel.Eq{"decision": nil}).
+		OrderBy("item_id").
+		Limit(uint64(filter.Limit + 1)) // one extra row to know if there is next page
+
+	if filter.Kind != nil {
+		builder = builder.Where(squirrel.Eq{"kind": *filter.Kind})
+	}
+
+	if filter.Cursor != nil {
+		builder = builder.Where(squirrel.Gt{"item_id": *filter.Cursor})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var rows []moderationQueueRow
+
+	if err = r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, false, fmt.Errorf("failed to select moderation queue: %w", err)
+	}
+
+	hasMore := len(rows) > filter.Limit
+	if hasMore {
+		rows = rows[:filter.Limit]
+	}
+
+	items := make([]*entities.ModerationQueueItem, 0, len(rows))
+	for i := range rows {
+		items = append(items, rows[i].toEntity())
+	}
+
+	return items, hasMore, nil
+}
+
+// GetModerationQueueItemByID returns internalErrs.ErrorSelectEmpty if there is no such item.
+func (r *Repository) GetModerationQueueItemByID(ctx context.Context, id int) (*entities.ModerationQueueItem, error) {
+	query, args, err := r.moderationQueueSelectBuilder().
+		Where(squirrel.Eq{"item_id": id}).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var row moderationQueueRow
+
+	if err = r.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internalErrs.ErrorSelectEmpty
+		}
+
+		return nil, fmt.Errorf("failed to get moderation queue item: %w", err)
+	}
+
+	return row.toEntity(), nil
+}
+
+// DecideModerationQueueItem saves admin's decision on pending item,
+// returns internalErrs.ErrorSelectEmpty if item is already decided.
+func (r *Repository) DecideModerationQueueItem(ctx context.Context, id int, decision entities.ModerationDecision, adminID int,
+	auditEntry *entities.AdminAuditEntry) error {
+	query, args, err := r.sqlBuilder.
+		Update("moderation_queue").
+		Set("decision", decision).
+		Set("decided_by", adminID).
+		Set("decided_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{
+			"item_id":  id,
+			"decision": nil,
+		}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update moderation queue item: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if affected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) moderationQueueSelectBuilder() squirrel.SelectBuilder {
+	return r.sqlBuilder.
+		Select(
+			"item_id",
+			"kind",
+			"author_id",
+			"entity_id",
+			"text",
+			"reasons",
+			"created_at",
+			"decision",
+			"decided_by",
+			"decided_at",
+		).
+		From("moderation_queue")
+}
+
+// moderationQueueRow is a moderation_queue row, reasons array needs its own scanner.
+type moderationQueueRow struct {
+	entities.ModerationQueueItem
+	Reasons pq.StringArray `db:"reasons"`
+}
+
+func (row *moderationQueueRow) toEntity() *entities.ModerationQueueItem {
+	item := row.ModerationQueueItem
+	item.Reasons = row.Reasons
+
+	return &item
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	internalErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+func (r *Repository) insertNotification(ctx context.Context, tx *sqlx.Tx, notification *entities.Notification) error {
+	query, args, err := r.sqlBuilder.
+		Insert("notifications").
+		Columns("user_id", "type", "entity_id", "message").
+		Values(notification.UserID, notification.Type, notification.EntityID, notification.Message).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert notification: %w", err)
+	}
+
+	return nil
+}
+
+// GetNotificationsByUserID returns notifications of user from newest to oldest.
+func (r *Repository) GetNotificationsByUserID(ctx context.Context, userID int, unreadOnly bool) ([]*entities.Notification, error) {
+	builder := r.sqlBuilder.
+		Select(
+			"notification_id",
+			"user_id",
+			"type",
+			"entity_id",
+			"message",
+			"is_read",
+			"created_at",
+		).
+		From("notifications").
+		Where(squirrel.Eq{"user_id": userID}).
+		OrderBy("created_at DESC", "notification_id DESC")
+
+	if unreadOnly {
+		builder = builder.Where(squirrel.Eq{"is_read": false})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	notifications := make([]*entities.Notification, 0)
+
+	if err = r.db.SelectContext(ctx, &notifications, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// MarkNotificationRead marks user's notification as read.
+func (r *Repository) MarkNotificationRead(ctx context.Context, userID, notificationID int) error {
+	query, args, err := r.sqlBuilder.
+		Update("notifications").
+		Set("is_read", true).
+		Where(squirrel.Eq{"notification_id": notificationID, "user_id": userID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update notification: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	return nil
+}
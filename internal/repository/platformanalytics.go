@@ -0,0 +1,254 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+)
+
+// eventDaySQL is day (UTC) of event at %s, platform events are bucketed by it.
+const eventDaySQL = "(%s AT TIME ZONE 'UTC')::date"
+
+// RefreshPlatformDailyStats recomputes platform_daily_stats and category_daily_stats rollups from the day of `since`,
+// zero since rebuilds whole rollups. Events are appended at current time, so earlier days are not changed.
+func (r *Repository) RefreshPlatformDailyStats(ctx context.Context, since time.Time) error {
+	// NULL means all days
+	var fromDay *time.Time
+	if !since.IsZero() {
+		day := since.UTC().Truncate(24 * time.Hour)
+		fromDay = &day
+	}
+
+	const deletePlatformQuery = `DELETE FROM platform_daily_stats WHERE $1::date IS NULL OR day >= $1::date`
+
+	const deleteCategoryQuery = `DELETE FROM category_daily_stats WHERE $1::date IS NULL OR day >= $1::date`
+
+	insertPlatformQuery := `
+	WITH events AS (
+		SELECT ` + fmt.Sprintf(eventDaySQL, "u.registration_date") + ` AS day, 1 AS signups, 0 AS new_teachers, 0 AS skills_approved
+		FROM users u
+		WHERE u.registration_date IS NOT NULL
+		  AND ($1::date IS NULL OR u.registration_date >= $1::date::timestamp AT TIME ZONE 'UTC')
+		UNION ALL
+		SELECT ` + fmt.Sprintf(eventDaySQL, "t.created_at") + `, 0, 1, 0
+		FROM teachers t
+		WHERE t.created_at IS NOT NULL
+		  AND ($1::date IS NULL OR t.created_at >= $1::date::timestamp AT TIME ZONE 'UTC')
+		UNION ALL
+		SELECT ` + fmt.Sprintf(eventDaySQL, "sa.approved_at") + `, 0, 0, 1
+		FROM skill_approvals sa
+		WHERE $1::date IS NULL OR sa.approved_at >= $1::date::timestamp AT TIME ZONE 'UTC'
+	)
+	INSERT INTO platform_daily_stats (day, signups, new_teachers, skills_approved)
+	SELECT day, SUM(signups), SUM(new_teachers), SUM(skills_approved)
+	FROM events
+	GROUP BY day
+	`
+
+	// lesson is booked by creation of its state machine item, completed when it's finished by teacher
+	// (items logged before transitions log existed have the only row with their state at creation time)
+	insertCategoryQuery := `
+	WITH transitions AS (
+		SELECT
+			` + fmt.Sprintf(eventDaySQL, "tl.changed_at") + ` AS day,
+			l.category_id,
+			l.price,
+			tl.from_state_id IS NULL AS is_booked,
+			ts.name = $2 AS is_started,
+			ts.name = ANY($3) AND (fs.name IS NULL OR NOT fs.name = ANY($3)) AS is_completed,
+			ts.name = $4 AS is_cancelled,
+			ts.name = $5 AS is_rejected
+		FROM state_transitions_log tl
+		INNER JOIN lessons l ON l.state_machine_item_id = tl.item_id
+		INNER JOIN states ts ON ts.state_id = tl.to_state_id
+		LEFT JOIN states fs ON fs.state_id = tl.from_state_id
+		WHERE $1::date IS NULL OR tl.changed_at >= $1::date::timestamp AT TIME ZONE 'UTC'
+	)
+	INSERT INTO category_daily_stats (
+		day, category_id,
+		lessons_booked, lessons_started, lessons_completed, lessons_cancelled, lessons_rejected, gmv
+	)
+	SELECT
+		day,
+		category_id,
+		COUNT(*) FILTER (WHERE is_booked),
+		COUNT(*) FILTER (WHERE is_started),
+		COUNT(*) FILTER (WHERE is_completed),
+		COUNT(*) FILTER (WHERE is_cancelled),
+		COUNT(*) FILTER (WHERE is_rejected),
+		COALESCE(SUM(price) FILTER (WHERE is_completed), 0)
+	FROM transitions
+	GROUP BY day, category_id
+	`
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, deletePlatformQuery, fromDay); err != nil {
+		return fmt.Errorf("failed to delete outdated platform daily stats: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, deleteCategoryQuery, fromDay); err != nil {
+		return fmt.Errorf("failed to delete outdated category daily stats: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, insertPlatformQuery, fromDay); err != nil {
+		return fmt.Errorf("failed to insert platform daily stats: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, insertCategoryQuery,
+		fromDay,
+		entities.Ongoing,
+		pq.Array(stateNamesToStrings(entities.FinishedLessonStates)),
+		entities.Cancelled,
+		entities.Rejected,
+	); err != nil {
+		return fmt.Errorf("failed to insert category daily stats: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+const lessonsSumsSQL = `
+	COALESCE(SUM(cs.lessons_booked), 0) AS lessons_booked,
+	COALESCE(SUM(cs.lessons_started), 0) AS lessons_started,
+	COALESCE(SUM(cs.lessons_completed), 0) AS lessons_completed,
+	COALESCE(SUM(cs.lessons_cancelled), 0) AS lessons_cancelled,
+	COALESCE(SUM(cs.lessons_rejected), 0) AS lessons_rejected,
+	COALESCE(SUM(cs.gmv), 0) AS gmv
+`
+
+// GetPlatformStatsByPeriods returns platform's stats for every day, week or month of days range (empty periods included).
+func (r *Repository) GetPlatformStatsByPeriods(ctx context.Context, from, to time.Time,
+	period entities.AnalyticsPeriod) ([]entities.PlatformStatsBucket, error) {
+	query := `
+	WITH periods AS (
+		SELECT p.period_start
+		FROM generate_series(
+			date_trunc($3::text, $1::date::timestamp),
+			$2::date::timestamp,
+			('1 ' || $3::text)::interval
+		) AS p(period_start)
+	),
+	platform AS (
+		SELECT
+			date_trunc($3::text, ps.day::timestamp) AS period_start,
+			SUM(ps.signups) AS signups,
+			SUM(ps.new_teachers) AS new_teachers,
+			SUM(ps.skills_approved) AS skills_approved
+		FROM platform_daily_stats ps
+		WHERE ps.day BETWEEN $1::date AND $2::date
+		GROUP BY 1
+	),
+	lessons AS (
+		SELECT
+			date_trunc($3::text, cs.day::timestamp) AS period_start,
+			` + lessonsSumsSQL + `
+		FROM category_daily_stats cs
+		WHERE cs.day BETWEEN $1::date AND $2::date
+		GROUP BY 1
+	)
+	SELECT
+		p.period_start::date AS period_start,
+		COALESCE(pl.signups, 0) AS signups,
+		COALESCE(pl.new_teachers, 0) AS new_teachers,
+		COALESCE(pl.skills_approved, 0) AS skills_approved,
+		COALESCE(l.lessons_booked, 0) AS lessons_booked,
+		COALESCE(l.lessons_started, 0) AS lessons_started,
+		COALESCE(l.lessons_completed, 0) AS lessons_completed,
+		COALESCE(l.lessons_cancelled, 0) AS lessons_cancelled,
+		COALESCE(l.lessons_rejected, 0) AS lessons_rejected,
+		COALESCE(l.gmv, 0) AS gmv
+	FROM periods p
+	LEFT JOIN platform pl ON pl.period_start = p.period_start
+	LEFT JOIN lessons l ON l.period_start = p.period_start
+	ORDER BY p.period_start
+	`
+
+	var periods []entities.PlatformStatsBucket
+
+	if err := r.db.SelectContext(ctx, &periods, query, from, to, string(period)); err != nil {
+		return nil, fmt.Errorf("failed to get platform stats by periods: %w", err)
+	}
+
+	return periods, nil
+}
+
+// GetTopCategoriesStats returns lessons stats of categories with the most completed lessons in days range.
+func (r *Repository) GetTopCategoriesStats(ctx context.Context, from, to time.Time, limit int) ([]entities.PlatformStatsBucket, error) {
+	query := `
+	SELECT
+		c.category_id,
+		c.name AS category_name,
+		` + lessonsSumsSQL + `
+	FROM category_daily_stats cs
+	INNER JOIN categories c ON c.category_id = cs.category_id
+	WHERE cs.day BETWEEN $1::date AND $2::date
+	GROUP BY c.category_id, c.name
+	ORDER BY lessons_completed DESC, lessons_booked DESC, c.category_id
+	LIMIT $3
+	`
+
+	var categories []entities.PlatformStatsBucket
+
+	if err := r.db.SelectContext(ctx, &categories, query, from, to, limit); err != nil {
+		return nil, fmt.Errorf("failed to get top categories stats: %w", err)
+	}
+
+	return categories, nil
+}
+
+// GetSkillApprovalStat returns current backlog of not approved skills and approvals made in days range.
+// Skills submitted before submission time was tracked have no submitted_at and are skipped in times.
+func (r *Repository) GetSkillApprovalStat(ctx context.Context, from, to time.Time) (*entities.SkillApprovalStat, error) {
+	const backlogQuery = `
+	SELECT COUNT(*) AS pending_count, MIN(submitted_at) AS oldest_pending_since
+	FROM skills
+	WHERE NOT is_active
+	`
+
+	const approvalsQuery = `
+	SELECT
+		COUNT(*) AS approved_count,
+		percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM approved_at - submitted_at))
+			FILTER (WHERE submitted_at IS NOT NULL) AS median_seconds
+	FROM skill_approvals
+	WHERE approved_at >= $1::date::timestamp AT TIME ZONE 'UTC'
+	  AND approved_at < ($2::date + 1)::timestamp AT TIME ZONE 'UTC'
+	`
+
+	var stat entities.SkillApprovalStat
+
+	if err := r.db.GetContext(ctx, &stat, backlogQuery); err != nil {
+		return nil, fmt.Errorf("failed to get skill approval backlog: %w", err)
+	}
+
+	var approvals struct {
+		ApprovedCount int             `db:"approved_count"`
+		MedianSeconds sql.NullFloat64 `db:"median_seconds"`
+	}
+
+	if err := r.db.GetContext(ctx, &approvals, approvalsQuery, from, to); err != nil {
+		return nil, fmt.Errorf("failed to get skill approvals: %w", err)
+	}
+
+	stat.ApprovedCount = approvals.ApprovedCount
+
+	if approvals.MedianSeconds.Valid {
+		stat.MedianApprovalTime = time.Duration(approvals.MedianSeconds.Float64 * float64(time.Second))
+	}
+
+	return &stat, nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// rankingScoresSQL recomputes ranking scores of table (skills or teachers), %[1]s is table, %[2]s is its id column.
+// Score is a Bayesian average: visible reviews plus $2 virtual reviews with platform mean rate.
+// If $1 (half life in seconds) is positive, weight of review halves every half life since reviewed lesson
+// (or review creation for reviews without lesson), exponent is capped to avoid float underflow.
+const rankingScoresSQL = `
+	WITH weighted AS (
+		SELECT
+			r.teacher_id,
+			r.skill_id,
+			r.rate,
+			CASE
+				WHEN $1::float8 > 0 THEN POWER(0.5, LEAST(
+					GREATEST(EXTRACT(EPOCH FROM NOW() - COALESCE(st.datetime, r.created_at)), 0) / $1::float8, 60))
+				ELSE 1
+			END AS weight
+		FROM reviews r
+		LEFT JOIN lessons l ON l.lesson_id = r.lesson_id
+		LEFT JOIN schedule_times st ON st.schedule_time_id = l.schedule_time_id
+		WHERE NOT r.is_hidden
+	),
+	prior AS (
+		SELECT COALESCE(SUM(rate * weight) / NULLIF(SUM(weight), 0), 0) AS mean FROM weighted
+	),
+	scores AS (
+		SELECT
+			t.%[2]s AS id,
+			COALESCE(
+				(p.mean * $2::float8 + COALESCE(SUM(w.rate * w.weight), 0)) / NULLIF($2::float8 + COALESCE(SUM(w.weight), 0), 0),
+				0
+			) AS score
+		FROM %[1]s t
+		CROSS JOIN prior p
+		LEFT JOIN weighted w ON w.%[2]s = t.%[2]s
+		GROUP BY t.%[2]s, p.mean
+	)
+	UPDATE %[1]s t
+	SET ranking_score = s.score
+	FROM scores s
+	WHERE t.%[2]s = s.id AND t.ranking_score IS DISTINCT FROM s.score
+`
+
+// RefreshRankingScores recomputes ranking scores of all teachers and skills.
+// priorWeight is a count of virtual reviews with platform mean rate, zero halfLife disables time decay.
+func (r *Repository) RefreshRankingScores(ctx context.Context, priorWeight float64, halfLife time.Duration) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, target := range []struct{ table, idColumn string }{
+		{"teachers", "teacher_id"},
+		{"skills", "skill_id"},
+	} {
+		query := fmt.Sprintf(rankingScoresSQL, target.table, target.idColumn)
+
+		if _, err = tx.ExecContext(ctx, query, halfLife.Seconds(), priorWeight); err != nil {
+			return fmt.Errorf("failed to refresh %s ranking scores: %w", target.table, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+
+	"github.com/lib/pq"
+)
+
+// GetStudentsActivity returns all lessons as (student, teacher, category) with flag is lesson finished.
+func (r *Repository) GetStudentsActivity(ctx context.Context) ([]entities.StudentActivity, error) {
+	const query = `
+	SELECT
+		l.student_id,
+		l.teacher_id,
+		l.category_id,
+		st.name = ANY($1) AS is_finished
+	FROM lessons l
+	INNER JOIN state_machines_items smi ON smi.item_id = l.state_machine_item_id
+	INNER JOIN states st ON st.state_id = smi.state_id
+	`
+
+	var activity []entities.StudentActivity
+
+	err := r.db.SelectContext(ctx, &activity, query, pq.Array(stateNamesToStrings(entities.FinishedLessonStates)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to select students activity: %w", err)
+	}
+
+	return activity, nil
+}
+
+// GetAllReviewsShort returns all visible reviews without comments.
+func (r *Repository) GetAllReviewsShort(ctx context.Context) ([]entities.Review, error) {
+	const query = `
+	SELECT review_id, teacher_id, student_id, category_id, skill_id, rate
+	FROM reviews
+	WHERE NOT is_hidden
+	`
+
+	var reviews []entities.Review
+
+	if err := r.db.SelectContext(ctx, &reviews, query); err != nil {
+		return nil, fmt.Errorf("failed to select reviews: %w", err)
+	}
+
+	return reviews, nil
+}
+
+// GetRecommendableTeachers returns not suspended teachers with at least one active skill
+// and flag whether they have available schedule time within availableWithin.
+func (r *Repository) GetRecommendableTeachers(ctx context.Context, availableWithin time.Duration) ([]entities.RecommendableTeacher, error) {
+	const query = `
+	SELECT
+		t.teacher_id,
+		t.user_id,
+		t.rate,
+		t.reviews_count,
+		ARRAY(
+			SELECT s.category_id FROM skills s WHERE s.teacher_id = t.teacher_id AND s.is_active
+		) AS category_ids,
+		EXISTS (
+			SELECT 1
+			FROM schedule_times sch
+			WHERE sch.teacher_id = t.teacher_id
+			  AND sch.is_available
+			  AND sch.datetime BETWEEN NOW() AND NOW() + make_interval(secs => $1)
+		) AS has_available_time
+	FROM teachers t
+	WHERE EXISTS (SELECT 1 FROM skills s WHERE s.teacher_id = t.teacher_id AND s.is_active)
+	  AND ` + notSuspendedTeacherCondition
+
+	type result struct {
+		entities.RecommendableTeacher
+		CategoryIDs pq.Int64Array `db:"category_ids"`
+	}
+
+	var rows []result
+
+	if err := r.db.SelectContext(ctx, &rows, query, availableWithin.Seconds()); err != nil {
+		return nil, fmt.Errorf("failed to select recommendable teachers: %w", err)
+	}
+
+	teachers := make([]entities.RecommendableTeacher, 0, len(rows))
+	for _, row := range rows {
+		teacher := row.RecommendableTeacher
+		teacher.CategoryIDs = make([]int, 0, len(row.CategoryIDs))
+
+		for _, id := range row.CategoryIDs {
+			teacher.CategoryIDs = append(teacher.CategoryIDs, int(id))
+		}
+
+		teachers = append(teachers, teacher)
+	}
+
+	return teachers, nil
+}
@@ -5,24 +5,35 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
 	internalErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/lib/pq"
 )
 
-func (r *Repository) CreateReview(ctx context.Context, review *entities.Review) error {
+// CreateReview creates review and sets its id, flagged comment (if not nil) is put into moderation queue with it.
+func (r *Repository) CreateReview(ctx context.Context, review *entities.Review, moderationItem *entities.ModerationQueueItem) error {
 	const query = `
-	INSERT INTO reviews (teacher_id, student_id, category_id, skill_id, rate, comment)
-	VALUES ($1, $2, $3, $4, $5, $6)
+	INSERT INTO reviews (teacher_id, student_id, category_id, skill_id, lesson_id, rate, comment)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	RETURNING review_id
 	`
 
-	if _, err := r.db.ExecContext(ctx, query,
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err = tx.GetContext(ctx, &review.ID, query,
 		review.TeacherID,
 		review.StudentID,
 		review.CategoryID,
 		review.SkillID,
+		review.LessonID,
 		review.Rate,
 		review.Comment); err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
@@ -35,66 +46,388 @@ func (r *Repository) CreateReview(ctx context.Context, review *entities.Review)
 		return fmt.Errorf("failed to insert review: %w", err)
 	}
 
+	if moderationItem != nil {
+		moderationItem.EntityID = &review.ID
+
+		if err = r.insertModerationQueueItem(ctx, tx, moderationItem); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
 	return nil
 }
 
-func (r *Repository) GetReviewsByTeacherId(ctx context.Context, id int) ([]*entities.Review, error) {
-	const query = `
-    SELECT
-		r.review_id,
-		r.teacher_id,
-		r.student_id,
-		r.category_id,
-		r.skill_id,
-		r.rate,
-		r.comment,
-		
-		u.user_id,
-		u.email,
-		u.name,
-		u.surname,
-		u.avatar
-	FROM reviews r
-    INNER JOIN users u ON r.student_id = u.user_id
-    WHERE r.teacher_id = $1`
+// GetReviewsFiltered returns one page of teacher's visible reviews matching the filter.
+// Pagination is keyset-based: (rate, created_at, review_id) of the last row of the previous page in filter.Cursor.
+// Second returned value reports whether there are more reviews after this page.
+func (r *Repository) GetReviewsFiltered(ctx context.Context, filter *entities.ReviewListFilter) ([]*entities.Review, bool, error) {
+	builder := r.sqlBuilder.
+		Select(
+			"r.review_id",
+			"r.teacher_id",
+			"r.student_id",
+			"r.category_id",
+			"r.skill_id",
+			"r.lesson_id",
+			"r.rate",
+			"r.comment",
+			"r.created_at",
+			"r.updated_at",
+			"st.datetime AS lesson_datetime",
+
+			"u.user_id",
+			"u.email",
+			"u.name",
+			"u.surname",
+			"u.avatar",
+
+			"rr.comment AS reply_comment",
+			"rr.created_at AS reply_created_at",
+			"rr.updated_at AS reply_updated_at",
+		).
+		From("reviews r").
+		InnerJoin("users u ON r.student_id = u.user_id").
+		LeftJoin("review_replies rr ON rr.review_id = r.review_id AND NOT rr.is_hidden").
+		LeftJoin("lessons l ON l.lesson_id = r.lesson_id").
+		LeftJoin("schedule_times st ON st.schedule_time_id = l.schedule_time_id").
+		Where(squirrel.Eq{"r.teacher_id": filter.TeacherID}).
+		Where("NOT r.is_hidden").
+		Limit(uint64(filter.Limit + 1)) // one extra row to know if there is next page
+
+	if filter.CategoryID != nil {
+		builder = builder.Where(squirrel.Eq{"r.category_id": *filter.CategoryID})
+	}
+
+	// reviews with the same rate are ordered from newest
+	switch filter.SortBy {
+	case entities.ReviewSortByHighest:
+		builder = builder.OrderBy("r.rate DESC", "r.created_at DESC", "r.review_id DESC")
+	case entities.ReviewSortByLowest:
+		builder = builder.OrderBy("r.rate ASC", "r.created_at DESC", "r.review_id DESC")
+	default:
+		builder = builder.OrderBy("r.created_at DESC", "r.review_id DESC")
+	}
+
+	if cursor := filter.Cursor; cursor != nil {
+		newerCondition := squirrel.Expr("(r.created_at, r.review_id) < (?, ?)", cursor.CreatedAt, cursor.ReviewID)
+
+		switch filter.SortBy {
+		case entities.ReviewSortByHighest:
+			builder = builder.Where(squirrel.Or{
+				squirrel.Lt{"r.rate": cursor.Rate},
+				squirrel.And{squirrel.Eq{"r.rate": cursor.Rate}, newerCondition},
+			})
+		case entities.ReviewSortByLowest:
+			builder = builder.Where(squirrel.Or{
+				squirrel.Gt{"r.rate": cursor.Rate},
+				squirrel.And{squirrel.Eq{"r.rate": cursor.Rate}, newerCondition},
+			})
+		default:
+			builder = builder.Where(newerCondition)
+		}
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to build query: %w", err)
+	}
 
 	// temp struct for executed data
 	type result struct {
 		entities.Review
 		entities.User
+
+		ReplyComment   *string    `db:"reply_comment"`
+		ReplyCreatedAt *time.Time `db:"reply_created_at"`
+		ReplyUpdatedAt *time.Time `db:"reply_updated_at"`
 	}
 
 	var rows []result
 
-	err := r.db.SelectContext(ctx, &rows, query, id)
+	if err = r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, false, fmt.Errorf("failed to extract reviews: %w", err)
+	}
+
+	hasMore := len(rows) > filter.Limit
+	if hasMore {
+		rows = rows[:filter.Limit]
+	}
+
+	reviews := make([]*entities.Review, 0, len(rows))
+
+	for _, row := range rows {
+		review := &row.Review
+		review.StudentData = &row.User
+
+		if row.ReplyComment != nil {
+			review.Reply = &entities.ReviewReply{
+				ReviewID:  row.Review.ID,
+				TeacherID: row.Review.TeacherID,
+				Comment:   *row.ReplyComment,
+				CreatedAt: *row.ReplyCreatedAt,
+				UpdatedAt: row.ReplyUpdatedAt,
+			}
+		}
+
+		reviews = append(reviews, review)
+	}
+
+	return reviews, hasMore, nil
+}
+
+// GetTeacherReviewSummary returns count of teacher's visible reviews by rate and by category.
+func (r *Repository) GetTeacherReviewSummary(ctx context.Context, teacherID int) (*entities.ReviewSummary, error) {
+	const distributionQuery = `
+	SELECT rate, COUNT(*) AS reviews_count
+	FROM reviews
+	WHERE teacher_id = $1 AND NOT is_hidden
+	GROUP BY rate
+	`
+
+	const categoriesQuery = `
+	SELECT
+		r.category_id,
+		c.name AS category_name,
+		COUNT(*) AS reviews_count,
+		AVG(r.rate)::float AS average_rate
+	FROM reviews r
+	INNER JOIN categories c ON c.category_id = r.category_id
+	WHERE r.teacher_id = $1 AND NOT r.is_hidden
+	GROUP BY r.category_id, c.name
+	ORDER BY reviews_count DESC, r.category_id
+	`
+
+	var rates []struct {
+		Rate         int `db:"rate"`
+		ReviewsCount int `db:"reviews_count"`
+	}
+
+	if err := r.db.SelectContext(ctx, &rates, distributionQuery, teacherID); err != nil {
+		return nil, fmt.Errorf("failed to select rates distribution: %w", err)
+	}
+
+	summary := &entities.ReviewSummary{
+		Distribution: make(map[int]int, len(rates)),
+		Categories:   make([]entities.ReviewCategorySummary, 0),
+	}
+
+	rateSum := 0
+
+	for _, rate := range rates {
+		summary.Distribution[rate.Rate] = rate.ReviewsCount
+		summary.ReviewsCount += rate.ReviewsCount
+		rateSum += rate.Rate * rate.ReviewsCount
+	}
+
+	if summary.ReviewsCount > 0 {
+		summary.AverageRate = float64(rateSum) / float64(summary.ReviewsCount)
+	}
+
+	if err := r.db.SelectContext(ctx, &summary.Categories, categoriesQuery, teacherID); err != nil {
+		return nil, fmt.Errorf("failed to select categories summary: %w", err)
+	}
+
+	return summary, nil
+}
+
+// GetTeacherWeightedRate returns average rate of teacher's visible reviews where weight of review halves
+// every halfLife since reviewed lesson (or review creation for reviews without lesson).
+func (r *Repository) GetTeacherWeightedRate(ctx context.Context, teacherID int, halfLife time.Duration) (float64, error) {
+	// exponent is capped to avoid float underflow on very old reviews
+	const query = `
+	SELECT COALESCE(SUM(w.rate * w.weight) / NULLIF(SUM(w.weight), 0), 0)
+	FROM (
+		SELECT
+			r.rate,
+			POWER(0.5, LEAST(EXTRACT(EPOCH FROM NOW() - COALESCE(st.datetime, r.created_at)) / $2, 60)) AS weight
+		FROM reviews r
+		LEFT JOIN lessons l ON l.lesson_id = r.lesson_id
+		LEFT JOIN schedule_times st ON st.schedule_time_id = l.schedule_time_id
+		WHERE r.teacher_id = $1 AND NOT r.is_hidden
+	) w
+	`
+
+	var rate float64
+
+	if err := r.db.GetContext(ctx, &rate, query, teacherID, halfLife.Seconds()); err != nil {
+		return 0, fmt.Errorf("failed to compute weighted rate: %w", err)
+	}
+
+	return rate, nil
+}
+
+func (r *Repository) GetReviewByID(ctx context.Context, id int) (*entities.Review, error) {
+	query, args, err := r.sqlBuilder.
+		Select(
+			"review_id",
+			"teacher_id",
+			"student_id",
+			"category_id",
+			"skill_id",
+			"lesson_id",
+			"rate",
+			"comment",
+			"created_at",
+			"updated_at",
+			"is_hidden",
+			"moderation_reason",
+			"moderated_at",
+		).
+		From("reviews").
+		Where(squirrel.Eq{"review_id": id}).
+		ToSql()
 	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var review entities.Review
+
+	if err = r.db.GetContext(ctx, &review, query, args...); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			// not an error
-			return make([]*entities.Review, 0), nil
+			return nil, internalErrs.ErrorSelectEmpty
 		}
 
-		return nil, fmt.Errorf("failed to extract reviews: %w", err)
+		return nil, fmt.Errorf("failed to find review: %w", err)
 	}
 
-	// Map for grope results
-	reviewsMap := make(map[int]*entities.Review)
+	return &review, nil
+}
 
-	for _, row := range rows {
-		_, exists := reviewsMap[row.Review.ID]
-		if !exists {
-			review := &row.Review
-			if review.StudentData == nil {
-				review.StudentData = &row.User
-			}
+// UpdateReview changes rate and comment of review, ratings of skill and teacher are recomputed by trigger.
+func (r *Repository) UpdateReview(ctx context.Context, id, rate int, comment string) error {
+	query, args, err := r.sqlBuilder.
+		Update("reviews").
+		Set("rate", rate).
+		Set("comment", comment).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"review_id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update review: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	return nil
+}
+
+// DeleteReviewByID deletes review, ratings of skill and teacher are recomputed by trigger.
+func (r *Repository) DeleteReviewByID(ctx context.Context, id int) error {
+	query, args, err := r.sqlBuilder.
+		Delete("reviews").
+		Where(squirrel.Eq{"review_id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete review: %w", err)
+	}
 
-			reviewsMap[row.Review.ID] = review
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	return nil
+}
+
+// repairRatingsSQL fixes rating aggregates of table (skills or teachers) which differ from computed by visible reviews
+// and returns their old and new values. %[1]s is table, %[2]s is its id column, %[3]s is entity name.
+const repairRatingsSQL = `
+	WITH actual AS (
+		SELECT
+			t.%[2]s AS id,
+			COUNT(r.review_id) AS reviews_count,
+			COALESCE(SUM(r.rate), 0) AS total_rate_score
+		FROM %[1]s t
+		LEFT JOIN reviews r ON r.%[2]s = t.%[2]s AND NOT r.is_hidden
+		GROUP BY t.%[2]s
+	),
+	drift AS (
+		SELECT
+			a.id,
+			t.reviews_count AS stored_count,
+			a.reviews_count AS actual_count,
+			t.total_rate_score AS stored_score,
+			a.total_rate_score AS actual_score,
+			t.rate AS stored_rate,
+			CASE WHEN a.reviews_count > 0 THEN a.total_rate_score::float / a.reviews_count ELSE 0 END AS actual_rate
+		FROM actual a
+		INNER JOIN %[1]s t ON t.%[2]s = a.id
+	)
+	UPDATE %[1]s t
+	SET
+		reviews_count = d.actual_count,
+		total_rate_score = d.actual_score,
+		rate = d.actual_rate
+	FROM drift d
+	WHERE t.%[2]s = d.id
+	  AND (d.stored_count <> d.actual_count
+		OR d.stored_score <> d.actual_score
+		OR ABS(d.stored_rate - d.actual_rate) > 1e-6)
+	RETURNING '%[3]s' AS entity, d.id, d.stored_count, d.actual_count, d.stored_score, d.actual_score, d.stored_rate, d.actual_rate
+`
+
+// RepairRatingAggregates recomputes rating aggregates of all skills and teachers from reviews
+// and returns found drifts (reviews are locked against changes while repairing).
+func (r *Repository) RepairRatingAggregates(ctx context.Context, auditEntry *entities.AdminAuditEntry) ([]entities.RatingDrift, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, `LOCK TABLE reviews IN SHARE MODE`); err != nil {
+		return nil, fmt.Errorf("failed to lock reviews: %w", err)
+	}
+
+	drifts := make([]entities.RatingDrift, 0)
+
+	for _, target := range []struct{ table, idColumn, entity string }{
+		{"skills", "skill_id", "skill"},
+		{"teachers", "teacher_id", "teacher"},
+	} {
+		var tableDrifts []entities.RatingDrift
+
+		query := fmt.Sprintf(repairRatingsSQL, target.table, target.idColumn, target.entity)
+
+		if err = tx.SelectContext(ctx, &tableDrifts, query); err != nil {
+			return nil, fmt.Errorf("failed to repair %s ratings: %w", target.entity, err)
 		}
+
+		drifts = append(drifts, tableDrifts...)
 	}
 
-	reviews := make([]*entities.Review, 0, len(reviewsMap))
-	for _, review := range reviewsMap {
-		reviews = append(reviews, review)
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return reviews, nil
+	return drifts, nil
 }
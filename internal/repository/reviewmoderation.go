@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	internalErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+// CreateReviewReport creates review report, flagged reason (if not nil) is put into moderation queue with it.
+func (r *Repository) CreateReviewReport(ctx context.Context, report *entities.ReviewReport,
+	moderationItem *entities.ModerationQueueItem) error {
+	query, args, err := r.sqlBuilder.
+		Insert("review_reports").
+		Columns("review_id", "target", "reporter_id", "reason").
+		Values(report.ReviewID, report.Target, report.ReporterID, report.Reason).
+		Suffix("RETURNING report_id").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var id int
+
+	if err = tx.GetContext(ctx, &id, query, args...); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			// error code 23505 mean unique_violation
+			if pqErr.Code == "23505" {
+				return internalErrs.ErrorNonUniqueData
+			}
+		}
+
+		return fmt.Errorf("failed to insert review report: %w", err)
+	}
+
+	if moderationItem != nil {
+		moderationItem.EntityID = &id
+
+		if err = r.insertModerationQueueItem(ctx, tx, moderationItem); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetReviewModerationQueue returns reviews and replies with pending reports, most reported first.
+func (r *Repository) GetReviewModerationQueue(ctx context.Context) ([]*entities.ReviewModerationItem, error) {
+	const query = `
+	SELECT
+		rp.review_id,
+		rp.target,
+		r.teacher_id,
+		r.student_id,
+		r.rate,
+		CASE WHEN rp.target = 'reply' THEN COALESCE(rr.comment, '') ELSE r.comment END AS comment,
+		CASE WHEN rp.target = 'reply' THEN COALESCE(rr.is_hidden, FALSE) ELSE r.is_hidden END AS is_hidden,
+		COUNT(*) AS reports_count,
+		MIN(rp.created_at) AS first_reported_at,
+		ARRAY_AGG(rp.reason ORDER BY rp.created_at) AS reasons
+	FROM review_reports rp
+	INNER JOIN reviews r ON r.review_id = rp.review_id
+	LEFT JOIN review_replies rr ON rr.review_id = rp.review_id
+	WHERE rp.resolution IS NULL
+	GROUP BY rp.review_id, rp.target, r.review_id, rr.review_id
+	ORDER BY reports_count DESC, first_reported_at
+	`
+
+	// temp struct for executed data
+	type result struct {
+		entities.ReviewModerationItem
+		Reasons pq.StringArray `db:"reasons"`
+	}
+
+	var rows []result
+
+	if err := r.db.SelectContext(ctx, &rows, query); err != nil {
+		return nil, fmt.Errorf("failed to select moderation queue: %w", err)
+	}
+
+	items := make([]*entities.ReviewModerationItem, 0, len(rows))
+
+	for _, row := range rows {
+		item := row.ReviewModerationItem
+		item.Reasons = row.Reasons
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+// ModerateReview applies moderation action to review or reply, resolves its pending reports
+// and notifies author in one transaction (notification can be nil).
+func (r *Repository) ModerateReview(ctx context.Context, moderation *entities.ReviewModeration, notification *entities.Notification,
+	auditEntry *entities.AdminAuditEntry) error {
+	table := "reviews"
+	if moderation.Target == entities.ReviewReportTargetReply {
+		table = "review_replies"
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// resolve reports first: they are removed with deleted review
+	query, args, err := r.sqlBuilder.
+		Update("review_reports").
+		Set("resolution", moderation.Action).
+		Set("resolved_by", moderation.AdminID).
+		Set("resolved_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{
+			"review_id":  moderation.ReviewID,
+			"target":     moderation.Target,
+			"resolution": nil,
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to resolve review reports: %w", err)
+	}
+
+	if moderation.Action == entities.ReviewModerationDelete {
+		query, args, err = r.sqlBuilder.
+			Delete(table).
+			Where(squirrel.Eq{"review_id": moderation.ReviewID}).
+			ToSql()
+	} else {
+		query, args, err = r.sqlBuilder.
+			Update(table).
+			Set("is_hidden", moderation.Action == entities.ReviewModerationHide).
+			Set("moderation_reason", moderation.Reason).
+			Set("moderated_at", squirrel.Expr("NOW()")).
+			Where(squirrel.Eq{"review_id": moderation.ReviewID}).
+			ToSql()
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to moderate %s: %w", moderation.Target, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	if notification != nil {
+		if err = r.insertNotification(ctx, tx, notification); err != nil {
+			return fmt.Errorf("failed to create notification: %w", err)
+		}
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
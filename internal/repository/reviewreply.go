@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	internalErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+func (r *Repository) GetReviewReply(ctx context.Context, reviewID int) (*entities.ReviewReply, error) {
+	query, args, err := r.sqlBuilder.
+		Select(
+			"review_id",
+			"teacher_id",
+			"comment",
+			"created_at",
+			"updated_at",
+			"is_hidden",
+			"moderation_reason",
+			"moderated_at",
+		).
+		From("review_replies").
+		Where(squirrel.Eq{"review_id": reviewID}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var reply entities.ReviewReply
+
+	if err = r.db.GetContext(ctx, &reply, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internalErrs.ErrorSelectEmpty
+		}
+
+		return nil, fmt.Errorf("failed to find review reply: %w", err)
+	}
+
+	return &reply, nil
+}
+
+// CreateReviewReply inserts reply and notification for review author in one transaction.
+func (r *Repository) CreateReviewReply(ctx context.Context, reply *entities.ReviewReply, notification *entities.Notification) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := r.sqlBuilder.
+		Insert("review_replies").
+		Columns("review_id", "teacher_id", "comment").
+		Values(reply.ReviewID, reply.TeacherID, reply.Comment).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			// error code 23505 mean unique_violation
+			if pqErr.Code == "23505" {
+				return internalErrs.ErrorNonUniqueData
+			}
+		}
+
+		return fmt.Errorf("failed to insert review reply: %w", err)
+	}
+
+	if err = r.insertNotification(ctx, tx, notification); err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateReviewReply changes comment of reply and inserts notification for review author in one transaction.
+func (r *Repository) UpdateReviewReply(ctx context.Context, reviewID int, comment string, notification *entities.Notification) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := r.sqlBuilder.
+		Update("review_replies").
+		Set("comment", comment).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"review_id": reviewID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update review reply: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	if err = r.insertNotification(ctx, tx, notification); err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,260 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	internalErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// GetRoles returns all roles with their permissions.
+func (r *Repository) GetRoles(ctx context.Context) ([]*entities.Role, error) {
+	query, args, err := r.sqlBuilder.
+		Select("role_id", "name", "description").
+		From("roles").
+		OrderBy("role_id").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var roles []*entities.Role
+
+	if err = r.db.SelectContext(ctx, &roles, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select roles: %w", err)
+	}
+
+	query, args, err = r.sqlBuilder.
+		Select("rp.role_id", "p.name").
+		From("role_permissions rp").
+		Join("permissions p ON p.permission_id = rp.permission_id").
+		OrderBy("p.permission_id").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var rolePermissions []struct {
+		RoleID     int                 `db:"role_id"`
+		Permission entities.Permission `db:"name"`
+	}
+
+	if err = r.db.SelectContext(ctx, &rolePermissions, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select role permissions: %w", err)
+	}
+
+	rolesByID := make(map[int]*entities.Role, len(roles))
+	for _, role := range roles {
+		role.Permissions = make([]entities.Permission, 0)
+		rolesByID[role.ID] = role
+	}
+
+	for _, rolePermission := range rolePermissions {
+		if role, ok := rolesByID[rolePermission.RoleID]; ok {
+			role.Permissions = append(role.Permissions, rolePermission.Permission)
+		}
+	}
+
+	return roles, nil
+}
+
+// GetRoleByName returns internalErrs.ErrorSelectEmpty if there is no such role.
+func (r *Repository) GetRoleByName(ctx context.Context, name entities.RoleName) (*entities.Role, error) {
+	query, args, err := r.sqlBuilder.
+		Select("role_id", "name", "description").
+		From("roles").
+		Where(squirrel.Eq{"name": name}).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var role entities.Role
+
+	if err = r.db.GetContext(ctx, &role, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internalErrs.ErrorSelectEmpty
+		}
+
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	return &role, nil
+}
+
+// GetUserAccess returns roles of user and distinct permissions given by them.
+func (r *Repository) GetUserAccess(ctx context.Context, userID int) (*entities.UserAccess, error) {
+	query, args, err := r.sqlBuilder.
+		Select("ro.name").
+		From("user_roles ur").
+		Join("roles ro ON ro.role_id = ur.role_id").
+		Where(squirrel.Eq{"ur.user_id": userID}).
+		OrderBy("ro.role_id").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	access := &entities.UserAccess{
+		Roles:       make([]entities.RoleName, 0),
+		Permissions: make([]entities.Permission, 0),
+	}
+
+	if err = r.db.SelectContext(ctx, &access.Roles, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select user roles: %w", err)
+	}
+
+	query, args, err = r.sqlBuilder.
+		Select("DISTINCT p.permission_id", "p.name").
+		From("user_roles ur").
+		Join("role_permissions rp ON rp.role_id = ur.role_id").
+		Join("permissions p ON p.permission_id = rp.permission_id").
+		Where(squirrel.Eq{"ur.user_id": userID}).
+		OrderBy("p.permission_id").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var permissions []struct {
+		ID   int                 `db:"permission_id"`
+		Name entities.Permission `db:"name"`
+	}
+
+	if err = r.db.SelectContext(ctx, &permissions, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select user permissions: %w", err)
+	}
+
+	for _, permission := range permissions {
+		access.Permissions = append(access.Permissions, permission.Name)
+	}
+
+	return access, nil
+}
+
+// AssignUserRole gives role to user, returns internalErrs.ErrorNonUniqueData if user already has it.
+func (r *Repository) AssignUserRole(ctx context.Context, userRole *entities.UserRole, auditEntry *entities.AdminAuditEntry) error {
+	query, args, err := r.sqlBuilder.
+		Insert("user_roles").
+		Columns("user_id", "role_id", "granted_by").
+		Values(userRole.UserID, userRole.RoleID, userRole.GrantedBy).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		if isUniqueViolation(err) {
+			return internalErrs.ErrorNonUniqueData
+		}
+
+		return fmt.Errorf("failed to insert user role: %w", err)
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeUserRole takes role from user, returns internalErrs.ErrorSelectEmpty if user doesn't have it.
+// Superadmin role can't be taken from the last superadmin (internalErrs.ErrorLastSuperadmin).
+func (r *Repository) RevokeUserRole(ctx context.Context, userID int, role *entities.Role, auditEntry *entities.AdminAuditEntry) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if role.Name == entities.RoleSuperadmin {
+		if err = r.lockLastSuperadmin(ctx, tx, role.ID); err != nil {
+			return err
+		}
+	}
+
+	query, args, err := r.sqlBuilder.
+		Delete("user_roles").
+		Where(squirrel.Eq{
+			"user_id": userID,
+			"role_id": role.ID,
+		}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete user role: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// lockLastSuperadmin locks holders of superadmin role (so concurrent revokes wait)
+// and returns internalErrs.ErrorLastSuperadmin if there is only one of them.
+func (r *Repository) lockLastSuperadmin(ctx context.Context, tx *sqlx.Tx, roleID int) error {
+	query, args, err := r.sqlBuilder.
+		Select("user_id").
+		From("user_roles").
+		Where(squirrel.Eq{"role_id": roleID}).
+		Suffix("FOR UPDATE").
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var holders []int
+
+	if err = tx.SelectContext(ctx, &holders, query, args...); err != nil {
+		return fmt.Errorf("failed to lock superadmins: %w", err)
+	}
+
+	if len(holders) <= 1 {
+		return internalErrs.ErrorLastSuperadmin
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+
+	"github.com/lib/pq"
+)
+
+// SearchTeachers finds teachers by tsquery string (already sanitized) over names, categories and skills texts.
+// Results are ordered by rank, headline contains highlighted fragments of the matched document.
+func (r *Repository) SearchTeachers(ctx context.Context, tsQuery string, limit int) ([]entities.TeacherSearchResult, error) {
+	// headline is calculated in outer query, so ts_headline works only with rows of the page
+	const query = `
+	WITH q AS (
+		SELECT to_tsquery('simple', $1) || to_tsquery('english', $1) AS query
+	),
+	ranked AS (
+		SELECT
+			t.teacher_id,
+			t.user_id,
+			t.rate,
+			t.reviews_count,
+			t.search_document,
+			ts_rank(t.search_vector, q.query) AS rank
+		FROM teachers t
+		CROSS JOIN q
+		WHERE t.search_vector @@ q.query
+		  AND EXISTS (SELECT 1 FROM skills s WHERE s.teacher_id = t.teacher_id AND s.is_active)
+		  AND ` + notSuspendedTeacherCondition + `
+		ORDER BY rank DESC, t.teacher_id
+		LIMIT $2
+	)
+	SELECT
+		u.user_id,
+		u.email,
+		u.name,
+		u.surname,
+		u.avatar,
+		r.teacher_id,
+		r.rate,
+		r.reviews_count,
+		r.rank,
+		ts_headline('english', r.search_document, q.query,
+			'StartSel=<b>, StopSel=</b>, MaxWords=20, MinWords=5, MaxFragments=2') AS headline,
+		ARRAY(
+			SELECT c.name
+			FROM skills s
+			INNER JOIN categories c ON c.category_id = s.category_id
+			WHERE s.teacher_id = r.teacher_id AND s.is_active
+			ORDER BY c.name
+		) AS categories
+	FROM ranked r
+	CROSS JOIN q
+	INNER JOIN users u ON u.user_id = r.user_id
+	ORDER BY r.rank DESC, r.teacher_id
+	`
+
+	type result struct {
+		entities.User
+		TeacherID    int            `db:"teacher_id"`
+		Rate         float32        `db:"rate"`
+		ReviewsCount int            `db:"reviews_count"`
+		Rank         float32        `db:"rank"`
+		Headline     string         `db:"headline"`
+		Categories   pq.StringArray `db:"categories"`
+	}
+
+	var rows []result
+
+	if err := r.db.SelectContext(ctx, &rows, query, tsQuery, limit); err != nil {
+		return nil, fmt.Errorf("failed to search teachers: %w", err)
+	}
+
+	results := make([]entities.TeacherSearchResult, 0, len(rows))
+	for _, row := range rows {
+		user := row.User
+		user.IsTeacher = true
+		user.TeacherData = &entities.Teacher{
+			ID:           row.TeacherID,
+			UserID:       row.User.ID,
+			Rate:         row.Rate,
+			ReviewsCount: row.ReviewsCount,
+		}
+
+		results = append(results, entities.TeacherSearchResult{
+			User:       user,
+			Rank:       row.Rank,
+			Headline:   row.Headline,
+			Categories: row.Categories,
+		})
+	}
+
+	return results, nil
+}
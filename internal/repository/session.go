@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	internalErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// CreateSession creates session of user with its first refresh token and returns session id.
+// Revoked and expired sessions of user are deleted by the way.
+func (r *Repository) CreateSession(ctx context.Context, session *entities.UserSession, token *entities.RefreshToken) (int, error) {
+	const deleteDeadQuery = `
+	DELETE FROM user_sessions
+	WHERE user_id = $1 AND (revoked_at IS NOT NULL OR expires_at <= NOW())
+	`
+
+	const insertSessionQuery = `
+	INSERT INTO user_sessions (user_id, expires_at)
+	VALUES ($1, $2)
+	RETURNING session_id
+	`
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, deleteDeadQuery, session.UserID); err != nil {
+		return 0, fmt.Errorf("failed to delete dead sessions: %w", err)
+	}
+
+	var sessionID int
+
+	if err = tx.GetContext(ctx, &sessionID, insertSessionQuery, session.UserID, session.ExpiresAt); err != nil {
+		return 0, fmt.Errorf("failed to insert session: %w", err)
+	}
+
+	token.SessionID = sessionID
+
+	if err = insertRefreshToken(ctx, tx, token); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return sessionID, nil
+}
+
+// GetRefreshTokenByHash returns refresh token (with user and revocation of its session),
+// returns internalErrs.ErrorSelectEmpty if there is no such token.
+func (r *Repository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error) {
+	query, args, err := r.sqlBuilder.
+		Select(
+			"rt.token_id",
+			"rt.session_id",
+			"rt.token_hash",
+			"rt.created_at",
+			"rt.expires_at",
+			"rt.used_at",
+			"s.user_id",
+			"s.revoked_at AS session_revoked_at",
+		).
+		From("refresh_tokens rt").
+		InnerJoin("user_sessions s ON s.session_id = rt.session_id").
+		Where("rt.token_hash = ?", tokenHash).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var token entities.RefreshToken
+
+	if err = r.db.GetContext(ctx, &token, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internalErrs.ErrorSelectEmpty
+		}
+
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+
+	return &token, nil
+}
+
+// RotateRefreshToken marks used token as used and adds the next token of its session, expired tokens of session are deleted.
+// Returns internalErrs.ErrorSelectEmpty if used token is already used (concurrently) or session is revoked.
+func (r *Repository) RotateRefreshToken(ctx context.Context, usedTokenID int, token *entities.RefreshToken) error {
+	const markUsedQuery = `UPDATE refresh_tokens SET used_at = NOW() WHERE token_id = $1 AND used_at IS NULL`
+
+	const updateSessionQuery = `
+	UPDATE user_sessions
+	SET last_refreshed_at = NOW(), expires_at = $2
+	WHERE session_id = $1 AND revoked_at IS NULL
+	`
+
+	const deleteExpiredQuery = `DELETE FROM refresh_tokens WHERE session_id = $1 AND expires_at <= NOW()`
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err = execExpectingRows(ctx, tx, markUsedQuery, usedTokenID); err != nil {
+		return fmt.Errorf("failed to mark refresh token used: %w", err)
+	}
+
+	if err = execExpectingRows(ctx, tx, updateSessionQuery, token.SessionID, token.ExpiresAt); err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, deleteExpiredQuery, token.SessionID); err != nil {
+		return fmt.Errorf("failed to delete expired refresh tokens: %w", err)
+	}
+
+	if err = insertRefreshToken(ctx, tx, token); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeSession ends session, its access and refresh tokens stop working. Revoking revoked session does nothing.
+func (r *Repository) RevokeSession(ctx context.Context, sessionID int) error {
+	const query = `UPDATE user_sessions SET revoked_at = NOW() WHERE session_id = $1 AND revoked_at IS NULL`
+
+	if _, err := r.db.ExecContext(ctx, query, sessionID); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeUserSessions ends all sessions of user.
+func (r *Repository) RevokeUserSessions(ctx context.Context, userID int) error {
+	const query = `UPDATE user_sessions SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL`
+
+	if _, err := r.db.ExecContext(ctx, query, userID); err != nil {
+		return fmt.Errorf("failed to revoke user sessions: %w", err)
+	}
+
+	return nil
+}
+
+// IsSessionActive checks that session of user is neither revoked nor expired.
+func (r *Repository) IsSessionActive(ctx context.Context, sessionID, userID int) (bool, error) {
+	const query = `
+	SELECT EXISTS (
+		SELECT 1
+		FROM user_sessions
+		WHERE session_id = $1 AND user_id = $2 AND revoked_at IS NULL AND expires_at > NOW()
+	)
+	`
+
+	var active bool
+
+	if err := r.db.GetContext(ctx, &active, query, sessionID, userID); err != nil {
+		return false, fmt.Errorf("failed to check session: %w", err)
+	}
+
+	return active, nil
+}
+
+// execExpectingRows executes query and returns internalErrs.ErrorSelectEmpty if it affected no rows.
+func execExpectingRows(ctx context.Context, tx *sqlx.Tx, query string, args ...any) error {
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	return nil
+}
+
+func insertRefreshToken(ctx context.Context, tx *sqlx.Tx, token *entities.RefreshToken) error {
+	const query = `
+	INSERT INTO refresh_tokens (session_id, token_hash, expires_at)
+	VALUES ($1, $2, $3)
+	`
+
+	if _, err := tx.ExecContext(ctx, query, token.SessionID, token.TokenHash, token.ExpiresAt); err != nil {
+		return fmt.Errorf("failed to insert refresh token: %w", err)
+	}
+
+	return nil
+}
@@ -13,18 +13,26 @@ import (
 	"github.com/Masterminds/squirrel"
 )
 
-func (r *Repository) CreateSkill(ctx context.Context, skill *entities.Skill) error {
+// CreateSkill creates skill and sets its id, flagged about (if not nil) is put into moderation queue with it.
+func (r *Repository) CreateSkill(ctx context.Context, skill *entities.Skill, moderationItem *entities.ModerationQueueItem) error {
 	query, args, err := r.sqlBuilder.
 		Insert("skills").
-		Columns("teacher_id", "category_id", "video_card_link", "about").
-		Values(skill.TeacherID, skill.CategoryID, skill.VideoCardLink, skill.About).
+		Columns("teacher_id", "category_id", "video_card_link", "about", "price").
+		Values(skill.TeacherID, skill.CategoryID, skill.VideoCardLink, skill.About, skill.Price).
+		Suffix("RETURNING skill_id").
 		ToSql()
 
 	if err != nil {
 		return fmt.Errorf("failed to build query: %w", err)
 	}
 
-	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err = tx.GetContext(ctx, &skill.ID, query, args...); err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			// Код ошибки 23505 означает unique_violation
 			if pqErr.Code == "23505" {
@@ -35,6 +43,18 @@ func (r *Repository) CreateSkill(ctx context.Context, skill *entities.Skill) err
 		return fmt.Errorf("failed to insert skill: %w", err)
 	}
 
+	if moderationItem != nil {
+		moderationItem.EntityID = &skill.ID
+
+		if err = r.insertModerationQueueItem(ctx, tx, moderationItem); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
 	return nil
 }
 
@@ -70,7 +90,10 @@ func (r *Repository) GetSkillByTeacherIDAndCategoryID(ctx context.Context, teach
 			"teacher_id",
 			"category_id",
 			"video_card_link",
+			"video_card_file",
+			"video_card_duration",
 			"about",
+			"price",
 			"rate",
 			"total_rate_score",
 			"reviews_count",
@@ -108,7 +131,10 @@ func (r *Repository) GetSkillByID(ctx context.Context, id int) (*entities.Skill,
 			"teacher_id",
 			"category_id",
 			"video_card_link",
+			"video_card_file",
+			"video_card_duration",
 			"about",
+			"price",
 			"rate",
 			"total_rate_score",
 			"reviews_count",
@@ -143,7 +169,10 @@ func (r *Repository) GetAllSkills(ctx context.Context) ([]entities.Skill, error)
 			"teacher_id",
 			"category_id",
 			"video_card_link",
+			"video_card_file",
+			"video_card_duration",
 			"about",
+			"price",
 			"rate",
 			"total_rate_score",
 			"reviews_count",
@@ -176,7 +205,10 @@ func (r *Repository) GetUnactiveSkills(ctx context.Context) ([]entities.Skill, e
 			"teacher_id",
 			"category_id",
 			"video_card_link",
+			"video_card_file",
+			"video_card_duration",
 			"about",
+			"price",
 			"rate",
 			"total_rate_score",
 			"reviews_count",
@@ -210,7 +242,10 @@ func (r *Repository) GetSkillsByTeacherID(ctx context.Context, teacherID int) ([
 			"s.teacher_id",
 			"s.category_id",
 			"s.video_card_link",
+			"s.video_card_file",
+			"s.video_card_duration",
 			"s.about",
+			"s.price",
 			"s.rate",
 			"s.total_rate_score",
 			"s.reviews_count",
@@ -220,6 +255,7 @@ func (r *Repository) GetSkillsByTeacherID(ctx context.Context, teacherID int) ([
 		From("skills s").
 		InnerJoin("categories c ON s.category_id = c.category_id").
 		Where(squirrel.Eq{"s.teacher_id": teacherID}).
+		OrderBy("s.ranking_score DESC", "c.name").
 		ToSql()
 
 	if err != nil {
@@ -239,7 +275,7 @@ func (r *Repository) GetSkillsByTeacherID(ctx context.Context, teacherID int) ([
 	return skills, nil
 }
 
-func (r *Repository) ActivateSkillByID(ctx context.Context, id int) error {
+func (r *Repository) ActivateSkillByID(ctx context.Context, id int, auditEntry *entities.AdminAuditEntry) error {
 	query, args, err := r.sqlBuilder.
 		Update("skills").
 		Set("is_active", true).
@@ -250,7 +286,13 @@ func (r *Repository) ActivateSkillByID(ctx context.Context, id int) error {
 		return fmt.Errorf("failed to build query: %w", err)
 	}
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to update is_active field for skill: %w", err)
 	}
@@ -265,5 +307,44 @@ func (r *Repository) ActivateSkillByID(ctx context.Context, id int) error {
 		return internalErrs.ErrorSelectEmpty
 	}
 
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateSkillVideoCard sets uploaded video card of skill, changed skill must be approved again.
+func (r *Repository) UpdateSkillVideoCard(ctx context.Context, id int, fileName string, duration int) error {
+	query, args, err := r.sqlBuilder.
+		Update("skills").
+		Set("video_card_file", fileName).
+		Set("video_card_duration", duration).
+		Set("is_active", false).
+		Where(squirrel.Eq{"skill_id": id}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update video card of skill: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
 	return nil
 }
@@ -201,3 +201,64 @@ func (r *Repository) insertStateMachineItem(ctx context.Context, tx *sqlx.Tx, ma
 
 	return itemID, nil
 }
+
+// moveStateMachineItem changes item's state only if it is still in the state item was read in,
+// otherwise returns internalErrs.ErrorSelectEmpty.
+func (r *Repository) moveStateMachineItem(ctx context.Context, tx *sqlx.Tx, item *entities.StateMachineItem, newStateID int) error {
+	query, args, err := r.sqlBuilder.
+		Update("state_machines_items").
+		Set("state_id", newStateID).
+		Where(squirrel.Eq{
+			"item_id":  item.ID,
+			"state_id": item.StateID,
+		}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update state machine item: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	return nil
+}
+
+// GetStateTransitionHistory returns logged state changes of state machine item from the oldest.
+func (r *Repository) GetStateTransitionHistory(ctx context.Context, itemID int) ([]entities.StateTransitionLogEntry, error) {
+	query, args, err := r.sqlBuilder.
+		Select(
+			"fs.name AS from_state",
+			"ts.name AS to_state",
+			"tl.changed_at",
+		).
+		From("state_transitions_log tl").
+		LeftJoin("states fs ON fs.state_id = tl.from_state_id").
+		InnerJoin("states ts ON ts.state_id = tl.to_state_id").
+		Where(squirrel.Eq{"tl.item_id": itemID}).
+		OrderBy("tl.changed_at", "tl.log_id").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var history []entities.StateTransitionLogEntry
+
+	if err = r.db.SelectContext(ctx, &history, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select state transitions: %w", err)
+	}
+
+	return history, nil
+}
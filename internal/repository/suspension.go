@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	internalErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// notSuspendedTeacherCondition filters out teachers (alias t) whose users are suspended or banned now.
+const notSuspendedTeacherCondition = `NOT EXISTS (
+	SELECT 1
+	FROM user_suspensions us
+	WHERE us.user_id = t.user_id
+	  AND us.lifted_at IS NULL
+	  AND (us.until IS NULL OR us.until > NOW())
+)`
+
+// GetActiveSuspension returns current suspension (or ban) of user,
+// returns internalErrs.ErrorSelectEmpty if user is not suspended.
+func (r *Repository) GetActiveSuspension(ctx context.Context, userID int) (*entities.UserSuspension, error) {
+	query, args, err := r.sqlBuilder.
+		Select(
+			"suspension_id",
+			"user_id",
+			"admin_id",
+			"reason",
+			"until",
+			"created_at",
+			"lifted_at",
+			"lifted_by",
+		).
+		From("user_suspensions").
+		Where(squirrel.Eq{
+			"user_id":   userID,
+			"lifted_at": nil,
+		}).
+		Where("(until IS NULL OR until > NOW())").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var suspension entities.UserSuspension
+
+	if err = r.db.GetContext(ctx, &suspension, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internalErrs.ErrorSelectEmpty
+		}
+
+		return nil, fmt.Errorf("failed to get user suspension: %w", err)
+	}
+
+	return &suspension, nil
+}
+
+func (r *Repository) IsUserSuspended(ctx context.Context, userID int) (bool, error) {
+	const query = `
+	SELECT EXISTS (
+		SELECT 1
+		FROM user_suspensions
+		WHERE user_id = $1 AND lifted_at IS NULL AND (until IS NULL OR until > NOW())
+	)
+	`
+
+	var suspended bool
+
+	if err := r.db.GetContext(ctx, &suspended, query, userID); err != nil {
+		return false, fmt.Errorf("failed to check user suspension: %w", err)
+	}
+
+	return suspended, nil
+}
+
+// GetFutureLessonsOfTeacherUser returns not started lessons of teacher (by his user id) in given states.
+func (r *Repository) GetFutureLessonsOfTeacherUser(ctx context.Context, userID int, states []entities.StateName) ([]entities.SuspendedLesson, error) {
+	const query = `
+	SELECT
+		l.lesson_id,
+		l.student_id,
+		l.state_machine_item_id,
+		smi.state_id,
+		st.name AS state_name
+	FROM lessons l
+	INNER JOIN teachers t ON t.teacher_id = l.teacher_id
+	INNER JOIN schedule_times sch ON sch.schedule_time_id = l.schedule_time_id
+	INNER JOIN state_machines_items smi ON smi.item_id = l.state_machine_item_id
+	INNER JOIN states st ON st.state_id = smi.state_id
+	WHERE t.user_id = $1 AND sch.datetime > NOW() AND st.name = ANY($2)
+	`
+
+	var lessons []entities.SuspendedLesson
+
+	if err := r.db.SelectContext(ctx, &lessons, query, userID, pq.Array(stateNamesToStrings(states))); err != nil {
+		return nil, fmt.Errorf("failed to select future lessons of teacher: %w", err)
+	}
+
+	return lessons, nil
+}
+
+// SuspendUser replaces current suspension of user with the new one, moves lessons of suspended teacher
+// into their next states and notifies students. Lessons which states were changed meanwhile are skipped.
+func (r *Repository) SuspendUser(ctx context.Context, suspension *entities.UserSuspension, lessons []entities.SuspendedLesson,
+	auditEntry *entities.AdminAuditEntry) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// expired suspension is lifted at its end
+	if err = r.liftSuspension(ctx, tx, suspension.UserID, suspension.AdminID); err != nil {
+		return err
+	}
+
+	query, args, err := r.sqlBuilder.
+		Insert("user_suspensions").
+		Columns("user_id", "admin_id", "reason", "until").
+		Values(suspension.UserID, suspension.AdminID, suspension.Reason, suspension.Until).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert user suspension: %w", err)
+	}
+
+	for _, lesson := range lessons {
+		item := &entities.StateMachineItem{
+			ID:      lesson.StateMachineItemID,
+			StateID: lesson.StateID,
+		}
+
+		if err = r.moveStateMachineItem(ctx, tx, item, lesson.NextStateID); err != nil {
+			if errors.Is(err, internalErrs.ErrorSelectEmpty) {
+				continue
+			}
+
+			return fmt.Errorf("failed to change state of lesson %d: %w", lesson.LessonID, err)
+		}
+
+		if err = r.insertNotification(ctx, tx, lesson.Notification); err != nil {
+			return fmt.Errorf("failed to create notification: %w", err)
+		}
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// LiftSuspension lifts current suspension (or ban) of user,
+// returns internalErrs.ErrorSelectEmpty if user is not suspended.
+func (r *Repository) LiftSuspension(ctx context.Context, userID, adminID int, auditEntry *entities.AdminAuditEntry) error {
+	query, args, err := r.sqlBuilder.
+		Update("user_suspensions").
+		Set("lifted_at", squirrel.Expr("NOW()")).
+		Set("lifted_by", adminID).
+		Where(squirrel.Eq{
+			"user_id":   userID,
+			"lifted_at": nil,
+		}).
+		Where("(until IS NULL OR until > NOW())").
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to lift user suspension: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) liftSuspension(ctx context.Context, tx *sqlx.Tx, userID int, adminID *int) error {
+	query, args, err := r.sqlBuilder.
+		Update("user_suspensions").
+		Set("lifted_at", squirrel.Expr("LEAST(until, NOW())")).
+		Set("lifted_by", adminID).
+		Where(squirrel.Eq{
+			"user_id":   userID,
+			"lifted_at": nil,
+		}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to lift previous user suspension: %w", err)
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/Masterminds/squirrel"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 func (r *Repository) IsTeacherExistsByUserID(ctx context.Context, id int) (bool, error) {
@@ -110,7 +111,9 @@ func (r *Repository) GetTeacherByUserID(ctx context.Context, id int) (*entities.
 		    teacher_id, 
 		    user_id,
 		    rate,
-		    reviews_count
+		    reviews_count,
+		    headline,
+		    bio
 		FROM teachers 
 		WHERE user_id = $1`
 
@@ -160,7 +163,9 @@ func (r *Repository) GetTeacherByID(ctx context.Context, id int) (*entities.Teac
     		teacher_id, 
     		user_id,
     		rate,
-		    reviews_count
+		    reviews_count,
+		    headline,
+		    bio
 		FROM teachers WHERE teacher_id = $1`
 
 	var teacher entities.Teacher
@@ -178,20 +183,23 @@ func (r *Repository) GetTeacherByID(ctx context.Context, id int) (*entities.Teac
 }
 
 func (r *Repository) GetShortStatTeacherByID(ctx context.Context, teacherId int) (*entities.TeacherStatistic, error) {
+	// lessons are counted by the same states as teachers.finished_lessons_count of catalogue
 	const query = `
     SELECT 
-        COUNT(DISTINCT l.lesson_id) FILTER (WHERE st.name = $1) as count_of_finished_lesson,
-		COUNT(DISTINCT l.student_id) FILTER (WHERE st.name = $1) as count_of_students
+        COUNT(DISTINCT l.lesson_id) FILTER (WHERE st.name = ANY($1)) as count_of_finished_lesson,
+		COUNT(DISTINCT l.student_id) FILTER (WHERE st.name = ANY($1)) as count_of_students,
+		(SELECT COUNT(*) FROM favorite_teachers f WHERE f.teacher_id = $2) as count_of_favorites
     FROM lessons l
-    INNER JOIN statuses st ON st.status_id = l.status_id
+    INNER JOIN state_machines_items smi ON smi.item_id = l.state_machine_item_id
+    INNER JOIN states st ON st.state_id = smi.state_id
     WHERE l.teacher_id = $2
     `
 
 	var stat entities.TeacherStatistic
 
 	err := r.db.GetContext(ctx, &stat, query,
-		entities.FinishedStatusName, // $1
-		teacherId,                   // $2
+		pq.Array(stateNamesToStrings(entities.FinishedLessonStates)), // $1
+		teacherId, // $2
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -257,19 +265,83 @@ func (r *Repository) GetShortTeacherDatasByIDs(ctx context.Context, teacherIDs m
 	return users, nil
 }
 
-func (r *Repository) GetAllTeachersDataFiltered(ctx context.Context, userId int, isUsersTeachers bool, category string, isFilteredByCategory bool) ([]entities.User, error) {
-	// Base query
-	baseQuery := `
-	WITH teacher_stats AS (
-		SELECT
-			l.teacher_id,
-			COUNT(DISTINCT l.lesson_id) FILTER (WHERE st.name = :finished_status_name) as count_of_finished_lesson,
-			COUNT(DISTINCT l.student_id) FILTER (WHERE st.name = :finished_status_name) as count_of_students
-		FROM lessons l
-		LEFT JOIN statuses st ON l.status_id = st.status_id
-		GROUP BY l.teacher_id
-	)
+// GetAllTeachersDataFiltered returns one page of teachers (with their skills) matching the filter.
+// Pagination is keyset-based: (sort value, teacher_id) of the last row of the previous page in filter.Cursor.
+// Second returned value reports whether there are more teachers after this page.
+func (r *Repository) GetAllTeachersDataFiltered(ctx context.Context, filter *entities.TeacherListFilter) ([]entities.User, bool, error) {
+	namedParams := map[string]interface{}{
+		"finished_states": pq.Array(stateNamesToStrings(entities.FinishedLessonStates)),
+		"limit":           filter.Limit + 1, // one extra row to know if there is next page
+		"user_id":         filter.UserID,
+	}
+
+	skillConditions := buildSkillConditions(filter, namedParams)
+
+	// skills of suspended teachers are hidden from catalogue
+	conditions := []string{notSuspendedTeacherCondition}
+
+	if filter.IsMyTeachers {
+		conditions = append(conditions, `EXISTS (
+			SELECT 1
+			FROM lessons l
+			INNER JOIN state_machines_items smi ON smi.item_id = l.state_machine_item_id
+			INNER JOIN states st ON st.state_id = smi.state_id
+			WHERE l.teacher_id = t.teacher_id
+			  AND l.student_id = :user_id
+			  AND st.name = ANY(:finished_states)
+		)`)
+	}
+
+	if filter.IsFavorites {
+		conditions = append(conditions, `EXISTS (
+			SELECT 1 FROM favorite_teachers f WHERE f.teacher_id = t.teacher_id AND f.user_id = :user_id
+		)`)
+	}
+
+	if filter.MinRating > 0 {
+		conditions = append(conditions, "t.rate >= :min_rating")
+		namedParams["min_rating"] = filter.MinRating
+	}
+
+	if filter.AvailableWithinHours > 0 {
+		conditions = append(conditions, `EXISTS (
+			SELECT 1
+			FROM schedule_times sch
+			WHERE sch.teacher_id = t.teacher_id
+			  AND sch.is_available
+			  AND sch.datetime BETWEEN NOW() AND NOW() + make_interval(hours => :available_within_hours)
+		)`)
+		namedParams["available_within_hours"] = filter.AvailableWithinHours
+	}
+
+	// teachers of chosen categories are ranked by their best matched skill
+	rankingExpr := "t.ranking_score"
+	if len(filter.Categories) > 0 {
+		rankingExpr = "ms.ranking_score"
+	}
 
+	sortExpr := teacherSortExpression(filter.SortBy, rankingExpr)
+
+	direction, comparison := "ASC", ">"
+	if filter.Desc {
+		direction, comparison = "DESC", "<"
+	}
+
+	if filter.Cursor != nil {
+		conditions = append(conditions,
+			fmt.Sprintf("(%s, t.teacher_id) %s (:cursor_value, :cursor_teacher_id)", sortExpr, comparison))
+		namedParams["cursor_teacher_id"] = filter.Cursor.TeacherID
+
+		if filter.SortBy == entities.SortByRating || filter.SortBy == entities.SortByRanking {
+			namedParams["cursor_value"] = filter.Cursor.Value
+		} else {
+			namedParams["cursor_value"] = int64(filter.Cursor.Value)
+		}
+	}
+
+	// min_price and ranking_score are calculated only by skills which match the filter,
+	// teacher without such skills has NULL min_price and is skipped
+	query := `
 	SELECT
 		u.user_id,
 		u.email,
@@ -281,116 +353,229 @@ func (r *Repository) GetAllTeachersDataFiltered(ctx context.Context, userId int,
 		t.teacher_id,
 		t.rate,
 		t.reviews_count,
+		` + rankingExpr + ` AS ranking_score,
+		t.finished_lessons_count,
+		ms.min_price,
+		EXISTS (
+			SELECT 1 FROM favorite_teachers f WHERE f.teacher_id = t.teacher_id AND f.user_id = :user_id
+		) AS is_favorite
+	FROM teachers t
+	INNER JOIN users u ON u.user_id = t.user_id
+	CROSS JOIN LATERAL (
+		SELECT MIN(s.price) AS min_price, MAX(s.ranking_score) AS ranking_score
+		FROM skills s
+		INNER JOIN categories c ON c.category_id = s.category_id
+		WHERE s.teacher_id = t.teacher_id AND ` + strings.Join(skillConditions, " AND ") + `
+	) ms
+	WHERE ms.min_price IS NOT NULL AND ` + strings.Join(conditions, " AND ")
+
+	query += fmt.Sprintf(" ORDER BY %s %s, t.teacher_id %s LIMIT :limit", sortExpr, direction, direction)
+
+	namedQuery, args, err := sqlx.Named(query, namedParams)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to build named query: %w", err)
+	}
+
+	// converting into $1, $2, ... PostgreSQL format
+	query = r.db.Rebind(namedQuery)
+
+	type result struct {
+		entities.User
+		TeacherID            int     `db:"teacher_id"`
+		Rate                 float32 `db:"rate"`
+		ReviewsCount         int     `db:"reviews_count"`
+		RankingScore         float64 `db:"ranking_score"`
+		FinishedLessonsCount int     `db:"finished_lessons_count"`
+		MinPrice             int     `db:"min_price"`
+		IsFavorite           bool    `db:"is_favorite"`
+	}
+
+	var rows []result
+
+	if err = r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, false, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	hasMore := len(rows) > filter.Limit
+	if hasMore {
+		rows = rows[:filter.Limit]
+	}
+
+	users := make([]entities.User, 0, len(rows))
+	teacherIDs := make([]int, 0, len(rows))
+
+	for _, row := range rows {
+		user := row.User
+		user.IsTeacher = true
+		user.TeacherData = &entities.Teacher{
+			ID:           row.TeacherID,
+			UserID:       row.User.ID,
+			Rate:         row.Rate,
+			ReviewsCount: row.ReviewsCount,
+			RankingScore: row.RankingScore,
+			MinPrice:     row.MinPrice,
+			IsFavorite:   row.IsFavorite,
+			Skills:       make([]*entities.Skill, 0),
+			TeacherStat: entities.TeacherStatistic{
+				CountOfFinishedLesson: row.FinishedLessonsCount,
+			},
+		}
+
+		users = append(users, user)
+		teacherIDs = append(teacherIDs, row.TeacherID)
+	}
+
+	if len(users) == 0 {
+		return users, hasMore, nil
+	}
+
+	if err = r.fillTeachersPageData(ctx, users, teacherIDs, skillConditions, namedParams); err != nil {
+		return nil, false, err
+	}
+
+	return users, hasMore, nil
+}
+
+// fillTeachersPageData adds to teachers of the page their matched skills and count of students.
+func (r *Repository) fillTeachersPageData(
+	ctx context.Context,
+	users []entities.User,
+	teacherIDs []int,
+	skillConditions []string,
+	namedParams map[string]interface{}) error {
+	byTeacherID := make(map[int]*entities.Teacher, len(users))
+	for i := range users {
+		byTeacherID[users[i].TeacherData.ID] = users[i].TeacherData
+	}
+
+	namedParams["teacher_ids"] = pq.Array(teacherIDs)
+
+	skillsQuery := `
+	SELECT
 		s.skill_id,
+		s.teacher_id,
 		s.category_id,
 		s.video_card_link,
+		s.video_card_file,
+		s.video_card_duration,
 		s.about,
+		s.price,
 		s.rate,
 		s.total_rate_score,
 		s.reviews_count,
 		s.is_active,
-		c.name as category_name,
-		COALESCE(ts.count_of_finished_lesson, 0) as count_of_finished_lesson,
-		COALESCE(ts.count_of_students, 0) as count_of_students
-	FROM users u
-	INNER JOIN teachers t ON u.user_id = t.user_id
-	INNER JOIN skills s ON t.teacher_id = s.teacher_id
-	INNER JOIN categories c ON s.category_id = c.category_id
-	LEFT JOIN teacher_stats ts ON t.teacher_id = ts.teacher_id
-	LEFT JOIN lessons l ON t.teacher_id = l.teacher_id
-	LEFT JOIN statuses st ON l.status_id = st.status_id
-	WHERE s.is_active
+		c.name as category_name
+	FROM skills s
+	INNER JOIN categories c ON c.category_id = s.category_id
+	WHERE s.teacher_id = ANY(:teacher_ids) AND ` + strings.Join(skillConditions, " AND ") + `
+	ORDER BY s.teacher_id, s.ranking_score DESC, c.name
 	`
 
-	// named params for query
-	namedParams := make(map[string]interface{})
-	namedParams["finished_status_name"] = entities.FinishedStatusName
-
-	var conditions []string
-
-	if isUsersTeachers {
-		conditions = append(conditions, "st.name = :finished_status_name")
-		conditions = append(conditions, "l.student_id = :user_id")
-		namedParams["user_id"] = userId
-	}
-
-	if isFilteredByCategory {
-		conditions = append(conditions, "c.name = :category")
-		namedParams["category"] = category
+	namedQuery, args, err := sqlx.Named(skillsQuery, namedParams)
+	if err != nil {
+		return fmt.Errorf("failed to build named query: %w", err)
 	}
 
-	query := baseQuery
-	if len(conditions) > 0 {
-		query += " AND " + strings.Join(conditions, " AND ")
+	var skills []*entities.Skill
+	if err = r.db.SelectContext(ctx, &skills, r.db.Rebind(namedQuery), args...); err != nil {
+		return fmt.Errorf("failed to select skills of teachers: %w", err)
 	}
 
-	// prepare named query
-	namedQuery, args, err := sqlx.Named(query, namedParams)
-	if err != nil {
-		return nil, fmt.Errorf("failed to build named query: %w", err)
+	for _, skill := range skills {
+		if teacher, ok := byTeacherID[skill.TeacherID]; ok {
+			teacher.Skills = append(teacher.Skills, skill)
+		}
 	}
 
-	// converting into $1, $2, ... PostgreSQL format
-	query = r.db.Rebind(namedQuery)
+	const studentsQuery = `
+	SELECT
+		l.teacher_id,
+		COUNT(DISTINCT l.student_id) AS count_of_students
+	FROM lessons l
+	INNER JOIN state_machines_items smi ON smi.item_id = l.state_machine_item_id
+	INNER JOIN states st ON st.state_id = smi.state_id
+	WHERE l.teacher_id = ANY($1) AND st.name = ANY($2)
+	GROUP BY l.teacher_id
+	`
 
-	type result struct {
-		entities.User
-		entities.Teacher
-		entities.Skill
-		entities.TeacherStatistic
+	var counts []struct {
+		TeacherID       int `db:"teacher_id"`
+		CountOfStudents int `db:"count_of_students"`
 	}
 
-	var rows []result
-
-	err = r.db.SelectContext(ctx, &rows, query, args...)
+	err = r.db.SelectContext(ctx, &counts, studentsQuery,
+		pq.Array(teacherIDs),
+		pq.Array(stateNamesToStrings(entities.FinishedLessonStates)))
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return []entities.User{}, nil
-		}
-
-		return nil, fmt.Errorf("failed to execute query: %w", err)
+		return fmt.Errorf("failed to count students of teachers: %w", err)
 	}
 
-	// Мапа для группировки результатов
-	usersMap := make(map[int]*entities.User)
-
-	// Обработка результатов
-	for _, row := range rows {
-		user, exists := usersMap[row.User.ID]
-		if !exists {
-			user = &row.User
-			usersMap[row.User.ID] = user
+	for _, count := range counts {
+		if teacher, ok := byTeacherID[count.TeacherID]; ok {
+			teacher.TeacherStat.CountOfStudents = count.CountOfStudents
 		}
+	}
 
-		if user.TeacherData == nil {
-			user.IsTeacher = true
-			user.TeacherData = &entities.Teacher{
-				ID:          row.Teacher.ID,
-				UserID:      row.Teacher.UserID,
-				Skills:      make([]*entities.Skill, 0),
-				TeacherStat: row.TeacherStatistic,
-			}
-		}
+	return nil
+}
 
-		if !hasSkill(user.TeacherData.Skills, row.Skill.ID) {
-			user.TeacherData.Skills = append(user.TeacherData.Skills, &row.Skill)
-		}
+// buildSkillConditions returns conditions for skills (alias s) and categories (alias c) tables.
+func buildSkillConditions(filter *entities.TeacherListFilter, namedParams map[string]interface{}) []string {
+	conditions := []string{"s.is_active"}
+
+	// category filter matches its subcategories too, archived categories can't be used as filter
+	if len(filter.Categories) > 0 {
+		conditions = append(conditions, `c.category_id IN (
+			WITH RECURSIVE filter_categories AS (
+				SELECT category_id FROM categories WHERE name = ANY(:categories) AND NOT is_archived
+				UNION ALL
+				SELECT ch.category_id
+				FROM categories ch
+				INNER JOIN filter_categories fc ON ch.parent_id = fc.category_id
+				WHERE NOT ch.is_archived
+			)
+			SELECT category_id FROM filter_categories
+		)`)
+		namedParams["categories"] = pq.Array(filter.Categories)
 	}
 
-	users := make([]entities.User, 0, len(usersMap))
-	for _, user := range usersMap {
-		users = append(users, *user)
+	if filter.MinPrice != nil {
+		conditions = append(conditions, "s.price >= :min_price")
+		namedParams["min_price"] = *filter.MinPrice
 	}
 
-	return users, nil
+	if filter.MaxPrice != nil {
+		conditions = append(conditions, "s.price <= :max_price")
+		namedParams["max_price"] = *filter.MaxPrice
+	}
+
+	return conditions
 }
 
-func hasSkill(skills []*entities.Skill, skillId int) bool {
-	for _, skill := range skills {
-		if skill.ID == skillId {
-			return true
-		}
+func teacherSortExpression(sortBy entities.TeacherSortField, rankingExpr string) string {
+	switch sortBy {
+	case entities.SortByReviewsCount:
+		return "t.reviews_count"
+	case entities.SortByFinishedLessons:
+		return "t.finished_lessons_count"
+	case entities.SortByPrice:
+		return "ms.min_price"
+	case entities.SortByNewest:
+		return "t.teacher_id"
+	case entities.SortByRating:
+		// rate is read as float32 (entities.Teacher.Rate), so cursor keeps it as real too:
+		// comparing double precision rate with rounded cursor would skip or repeat teachers
+		return "t.rate::real"
+	default:
+		return rankingExpr
+	}
+}
+
+func stateNamesToStrings(states []entities.StateName) []string {
+	names := make([]string, 0, len(states))
+	for _, state := range states {
+		names = append(names, string(state))
 	}
 
-	return false
+	return names
 }
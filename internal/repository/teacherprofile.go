@@ -0,0 +1,268 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	internalErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// UpdateTeacherProfile replaces headline, bio, languages and education of teacher.
+func (r *Repository) UpdateTeacherProfile(ctx context.Context, teacherID int, profile *entities.TeacherProfile) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := r.sqlBuilder.
+		Update("teachers").
+		Set("headline", profile.Headline).
+		Set("bio", profile.Bio).
+		Where(squirrel.Eq{"teacher_id": teacherID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to update teacher: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM teacher_languages WHERE teacher_id = $1`, teacherID); err != nil {
+		return fmt.Errorf("failed to delete teacher languages: %w", err)
+	}
+
+	if len(profile.Languages) > 0 {
+		insert := r.sqlBuilder.
+			Insert("teacher_languages").
+			Columns("teacher_id", "language", "level")
+
+		for _, language := range profile.Languages {
+			insert = insert.Values(teacherID, language.Language, language.Level)
+		}
+
+		if query, args, err = insert.ToSql(); err != nil {
+			return fmt.Errorf("failed to build insert query: %w", err)
+		}
+
+		if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to insert teacher languages: %w", err)
+		}
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM teacher_educations WHERE teacher_id = $1`, teacherID); err != nil {
+		return fmt.Errorf("failed to delete teacher education: %w", err)
+	}
+
+	if len(profile.Education) > 0 {
+		insert := r.sqlBuilder.
+			Insert("teacher_educations").
+			Columns("teacher_id", "institution", "degree", "field_of_study", "start_year", "end_year")
+
+		for _, education := range profile.Education {
+			insert = insert.Values(teacherID,
+				education.Institution,
+				education.Degree,
+				education.FieldOfStudy,
+				education.StartYear,
+				education.EndYear)
+		}
+
+		if query, args, err = insert.ToSql(); err != nil {
+			return fmt.Errorf("failed to build insert query: %w", err)
+		}
+
+		if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to insert teacher education: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetTeacherLanguages(ctx context.Context, teacherID int) ([]entities.TeacherLanguage, error) {
+	const query = `
+	SELECT teacher_language_id, teacher_id, language, level
+	FROM teacher_languages
+	WHERE teacher_id = $1
+	ORDER BY teacher_language_id
+	`
+
+	languages := make([]entities.TeacherLanguage, 0)
+
+	if err := r.db.SelectContext(ctx, &languages, query, teacherID); err != nil {
+		return nil, fmt.Errorf("failed to select teacher languages: %w", err)
+	}
+
+	return languages, nil
+}
+
+func (r *Repository) GetTeacherEducations(ctx context.Context, teacherID int) ([]entities.TeacherEducation, error) {
+	const query = `
+	SELECT education_id, teacher_id, institution, degree, field_of_study, start_year, end_year
+	FROM teacher_educations
+	WHERE teacher_id = $1
+	ORDER BY start_year DESC NULLS LAST, education_id
+	`
+
+	education := make([]entities.TeacherEducation, 0)
+
+	if err := r.db.SelectContext(ctx, &education, query, teacherID); err != nil {
+		return nil, fmt.Errorf("failed to select teacher education: %w", err)
+	}
+
+	return education, nil
+}
+
+const certificateColumns = "certificate_id, teacher_id, title, file_name, is_verified, verified_at, created_at"
+
+func (r *Repository) GetTeacherCertificates(ctx context.Context, teacherID int) ([]entities.TeacherCertificate, error) {
+	query := `SELECT ` + certificateColumns + ` FROM teacher_certificates WHERE teacher_id = $1 ORDER BY certificate_id`
+
+	certificates := make([]entities.TeacherCertificate, 0)
+
+	if err := r.db.SelectContext(ctx, &certificates, query, teacherID); err != nil {
+		return nil, fmt.Errorf("failed to select teacher certificates: %w", err)
+	}
+
+	return certificates, nil
+}
+
+func (r *Repository) GetUnverifiedCertificates(ctx context.Context) ([]entities.TeacherCertificate, error) {
+	query := `SELECT ` + certificateColumns + ` FROM teacher_certificates WHERE NOT is_verified ORDER BY created_at`
+
+	certificates := make([]entities.TeacherCertificate, 0)
+
+	if err := r.db.SelectContext(ctx, &certificates, query); err != nil {
+		return nil, fmt.Errorf("failed to select unverified certificates: %w", err)
+	}
+
+	return certificates, nil
+}
+
+func (r *Repository) GetCertificateByID(ctx context.Context, id int) (*entities.TeacherCertificate, error) {
+	query := `SELECT ` + certificateColumns + ` FROM teacher_certificates WHERE certificate_id = $1`
+
+	var certificate entities.TeacherCertificate
+
+	err := r.db.GetContext(ctx, &certificate, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, internalErrs.ErrorSelectEmpty
+		}
+
+		return nil, fmt.Errorf("failed to find certificate: %w", err)
+	}
+
+	return &certificate, nil
+}
+
+// CreateCertificate creates certificate and returns its id, flagged title (if not nil) is put into moderation queue with it.
+func (r *Repository) CreateCertificate(ctx context.Context, certificate *entities.TeacherCertificate,
+	moderationItem *entities.ModerationQueueItem) (int, error) {
+	query, args, err := r.sqlBuilder.
+		Insert("teacher_certificates").
+		Columns("teacher_id", "title", "file_name").
+		Values(certificate.TeacherID, certificate.Title, certificate.FileName).
+		Suffix("RETURNING certificate_id").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var id int
+
+	if err = tx.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		return 0, fmt.Errorf("failed to insert certificate: %w", err)
+	}
+
+	if moderationItem != nil {
+		moderationItem.EntityID = &id
+
+		if err = r.insertModerationQueueItem(ctx, tx, moderationItem); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *Repository) DeleteCertificateByID(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM teacher_certificates WHERE certificate_id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete certificate: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	return nil
+}
+
+func (r *Repository) VerifyCertificateByID(ctx context.Context, id int, auditEntry *entities.AdminAuditEntry) error {
+	query, args, err := r.sqlBuilder.
+		Update("teacher_certificates").
+		Set("is_verified", true).
+		Set("verified_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"certificate_id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to verify certificate: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
@@ -36,23 +36,44 @@ func (r *Repository) IsUserExistsByID(ctx context.Context, id int) (bool, error)
 	return exists, nil
 }
 
-func (r *Repository) CreateUser(ctx context.Context, user *entities.User) (int, error) {
+// CreateUser creates user and returns its id, flagged name (if not nil) is put into moderation queue
+// with the user as its author.
+func (r *Repository) CreateUser(ctx context.Context, user *entities.User, moderationItem *entities.ModerationQueueItem) (int, error) {
 	const query = `
 	INSERT INTO users (email, password, name, surname, birthdate, avatar) 
 	VALUES ($1, $2, $3, $4, $5, $6)
 	RETURNING user_id
 	`
 
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
 	var userID int
-	if err := r.db.QueryRowContext(ctx, query, user.Email, user.Password, user.Name, user.Surname, user.Birthdate, user.Avatar).Scan(&userID); err != nil {
+	if err = tx.QueryRowContext(ctx, query, user.Email, user.Password, user.Name, user.Surname, user.Birthdate, user.Avatar).Scan(&userID); err != nil {
 		return 0, err
 	}
 
+	if moderationItem != nil {
+		moderationItem.AuthorID = &userID
+		moderationItem.EntityID = &userID
+
+		if err = r.insertModerationQueueItem(ctx, tx, moderationItem); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("error committing transaction: %w", err)
+	}
+
 	return userID, nil
 }
 
 func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*entities.User, error) {
-	const query = `SELECT user_id, email, password, name, surname, birthdate FROM public.users WHERE email = $1`
+	const query = `SELECT user_id, email, password, name, surname, birthdate, email_verification_required FROM public.users WHERE email = $1`
 
 	var user entities.User
 	err := r.db.GetContext(ctx, &user, query, email)
@@ -69,7 +90,11 @@ func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*entitie
 }
 
 func (r *Repository) GetUserByID(ctx context.Context, id int) (*entities.User, error) {
-	const query = `SELECT user_id, email, password, name, surname, registration_date, birthdate, avatar FROM public.users WHERE user_id = $1`
+	const query = `
+	SELECT user_id, email, password, name, surname, registration_date, birthdate, avatar, email_verification_required
+	FROM public.users
+	WHERE user_id = $1
+	`
 
 	var user entities.User
 
@@ -0,0 +1,367 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	internalErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// SearchUsers returns one page of users (newest first) whose id equals query or whose email, name or surname contain it.
+// Second returned value reports whether there are more users after this page.
+func (r *Repository) SearchUsers(ctx context.Context, filter *entities.UserSearchFilter) ([]*entities.User, bool, error) {
+	builder := r.sqlBuilder.
+		Select(
+			"user_id",
+			"email",
+			"name",
+			"surname",
+			"registration_date",
+			"birthdate",
+			"avatar",
+			"email_verification_required",
+		).
+		From("users").
+		OrderBy("user_id DESC").
+		Limit(uint64(filter.Limit + 1)) // one extra row to know if there is next page
+
+	if query := strings.TrimSpace(filter.Query); query != "" {
+		pattern := "%" + escapeLikePattern(query) + "%"
+
+		condition := squirrel.Or{
+			squirrel.ILike{"email": pattern},
+			squirrel.ILike{"name": pattern},
+			squirrel.ILike{"surname": pattern},
+			squirrel.ILike{"name || ' ' || surname": pattern},
+		}
+
+		if id, err := strconv.Atoi(query); err == nil {
+			condition = append(condition, squirrel.Eq{"user_id": id})
+		}
+
+		builder = builder.Where(condition)
+	}
+
+	if filter.Cursor != nil {
+		builder = builder.Where(squirrel.Lt{"user_id": *filter.Cursor})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var users []*entities.User
+
+	if err = r.db.SelectContext(ctx, &users, query, args...); err != nil {
+		return nil, false, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	hasMore := len(users) > filter.Limit
+	if hasMore {
+		users = users[:filter.Limit]
+	}
+
+	return users, hasMore, nil
+}
+
+// GetUserComplaints returns complaints filed by user or against him (newest first).
+func (r *Repository) GetUserComplaints(ctx context.Context, userID int) ([]*entities.Complaint, error) {
+	query, args, err := r.complaintSelectBuilder().
+		Where(squirrel.Or{
+			squirrel.Eq{"c.complainer_id": userID},
+			squirrel.Eq{"c.reported_id": userID},
+		}).
+		OrderBy("c.complaint_id DESC").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var rows []complaintRow
+
+	if err = r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select user complaints: %w", err)
+	}
+
+	complaints := make([]*entities.Complaint, 0, len(rows))
+	for i := range rows {
+		complaints = append(complaints, rows[i].toEntity())
+	}
+
+	return complaints, nil
+}
+
+// GetUserSuspensions returns all suspensions and bans of user including lifted and expired ones (newest first).
+func (r *Repository) GetUserSuspensions(ctx context.Context, userID int) ([]*entities.UserSuspension, error) {
+	query, args, err := r.sqlBuilder.
+		Select(
+			"suspension_id",
+			"user_id",
+			"admin_id",
+			"reason",
+			"until",
+			"created_at",
+			"lifted_at",
+			"lifted_by",
+		).
+		From("user_suspensions").
+		Where(squirrel.Eq{"user_id": userID}).
+		OrderBy("suspension_id DESC").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var suspensions []*entities.UserSuspension
+
+	if err = r.db.SelectContext(ctx, &suspensions, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to select user suspensions: %w", err)
+	}
+
+	return suspensions, nil
+}
+
+// ResetUserAvatar sets default avatar to user and returns the old one,
+// returns internalErrs.ErrorSelectEmpty if user doesn't exist.
+func (r *Repository) ResetUserAvatar(ctx context.Context, userID int, auditEntry *entities.AdminAuditEntry) (string, error) {
+	const query = `
+	UPDATE users u
+	SET avatar = ''
+	FROM (SELECT user_id, avatar FROM users WHERE user_id = $1 FOR UPDATE) old
+	WHERE u.user_id = old.user_id
+	RETURNING old.avatar
+	`
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var oldAvatar string
+
+	if err = tx.GetContext(ctx, &oldAvatar, query, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", internalErrs.ErrorSelectEmpty
+		}
+
+		return "", fmt.Errorf("failed to reset user avatar: %w", err)
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return oldAvatar, nil
+}
+
+// RequireEmailVerification makes user confirm his email again with token (its hash is stored)
+// and revokes his sessions, returns internalErrs.ErrorSelectEmpty if user doesn't exist.
+func (r *Repository) RequireEmailVerification(ctx context.Context, userID int, tokenHash string, expiresAt time.Time,
+	auditEntry *entities.AdminAuditEntry) error {
+	query, args, err := r.sqlBuilder.
+		Update("users").
+		Set("email_verification_required", true).
+		Set("email_verification_token_hash", tokenHash).
+		Set("email_verification_expires_at", expiresAt).
+		Where(squirrel.Eq{"user_id": userID}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to require email verification: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	if _, err = tx.ExecContext(ctx,
+		`UPDATE user_sessions SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL`, userID); err != nil {
+		return fmt.Errorf("failed to revoke user sessions: %w", err)
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ConfirmEmailVerification clears required email verification of user with not expired token,
+// returns internalErrs.ErrorSelectEmpty if there is no such token.
+func (r *Repository) ConfirmEmailVerification(ctx context.Context, tokenHash string) error {
+	const query = `
+	UPDATE users
+	SET email_verification_required = FALSE, email_verification_token_hash = NULL, email_verification_expires_at = NULL
+	WHERE email_verification_token_hash = $1 AND email_verification_expires_at > NOW()
+	`
+
+	result, err := r.db.ExecContext(ctx, query, tokenHash)
+	if err != nil {
+		return fmt.Errorf("failed to confirm email verification: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	return nil
+}
+
+// mergedUserColumns are columns referencing users which are simply moved from source to target user on merge.
+var mergedUserColumns = []struct{ table, column string }{
+	{"teachers", "user_id"},
+	{"lessons", "student_id"},
+	{"reviews", "student_id"}, // review is unique by lesson only, lessons of source are moved too
+	{"complaints", "complainer_id"},
+	{"complaints", "reported_id"},
+	{"complaints", "assignee_id"},
+	{"complaints", "resolved_by"},
+	{"complaint_notes", "author_id"},
+	{"notifications", "user_id"},
+	{"review_reports", "resolved_by"},
+	{"moderation_queue", "author_id"},
+	{"moderation_queue", "decided_by"},
+	{"user_suspensions", "user_id"},
+	{"user_suspensions", "admin_id"},
+	{"user_suspensions", "lifted_by"},
+	{"category_age_exceptions", "granted_by"},
+	{"user_roles", "granted_by"},
+}
+
+// mergedUniqueUserRows are rows of source user which are moved to target user unless target has the same row,
+// duplicates are deleted with source user. %[1]s is table, %[2]s is user column, %[3]s is condition of duplicate.
+var mergedUniqueUserRows = []struct{ table, column, duplicate string }{
+	{"review_reports", "reporter_id", "d.review_id = t.review_id AND d.target = t.target"},
+	{"favorite_teachers", "user_id", "d.teacher_id = t.teacher_id"},
+	{"category_age_exceptions", "user_id", "d.category_id = t.category_id"},
+}
+
+const moveUniqueUserRowsSQL = `
+	UPDATE %[1]s t
+	SET %[2]s = $1
+	WHERE t.%[2]s = $2
+	  AND NOT EXISTS (SELECT 1 FROM %[1]s d WHERE d.%[2]s = $1 AND %[3]s)
+`
+
+// IsLessonBetweenUsersExists checks whether one of users has a lesson with the other one as a teacher.
+func (r *Repository) IsLessonBetweenUsersExists(ctx context.Context, firstID, secondID int) (bool, error) {
+	const query = `
+	SELECT EXISTS (
+		SELECT 1
+		FROM lessons l
+		JOIN teachers t ON t.teacher_id = l.teacher_id
+		WHERE (l.student_id = $1 AND t.user_id = $2) OR (l.student_id = $2 AND t.user_id = $1)
+	)
+	`
+
+	var exists bool
+
+	if err := r.db.GetContext(ctx, &exists, query, firstID, secondID); err != nil {
+		return false, fmt.Errorf("failed to check lessons between users: %w", err)
+	}
+
+	return exists, nil
+}
+
+// MergeUsers moves everything of source user to target user and deletes source user.
+// Source user's expired suspensions are lifted at their end before moving.
+// Returns internalErrs.ErrorSelectEmpty if any of users doesn't exist.
+func (r *Repository) MergeUsers(ctx context.Context, targetID, sourceID int, auditEntry *entities.AdminAuditEntry) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var lockedIDs []int
+
+	if err = tx.SelectContext(ctx, &lockedIDs,
+		`SELECT user_id FROM users WHERE user_id IN ($1, $2) FOR UPDATE`, targetID, sourceID); err != nil {
+		return fmt.Errorf("failed to lock users: %w", err)
+	}
+
+	if len(lockedIDs) != 2 {
+		return internalErrs.ErrorSelectEmpty
+	}
+
+	// target keeps at most one not lifted suspension
+	if err = r.liftSuspension(ctx, tx, sourceID, nil); err != nil {
+		return err
+	}
+
+	for _, row := range mergedUniqueUserRows {
+		query := fmt.Sprintf(moveUniqueUserRowsSQL, row.table, row.column, row.duplicate)
+
+		if _, err = tx.ExecContext(ctx, query, targetID, sourceID); err != nil {
+			return fmt.Errorf("failed to move %s.%s: %w", row.table, row.column, err)
+		}
+	}
+
+	for _, column := range mergedUserColumns {
+		query := fmt.Sprintf(`UPDATE %[1]s SET %[2]s = $1 WHERE %[2]s = $2`, column.table, column.column)
+
+		if _, err = tx.ExecContext(ctx, query, targetID, sourceID); err != nil {
+			return fmt.Errorf("failed to move %s.%s: %w", column.table, column.column, err)
+		}
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM users WHERE user_id = $1`, sourceID); err != nil {
+		return fmt.Errorf("failed to delete merged user: %w", err)
+	}
+
+	if err = r.insertAdminAuditEntry(ctx, tx, auditEntry); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// escapeLikePattern escapes wildcards of LIKE pattern.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,79 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// GetTeacherAnalytics returns dashboard of teacher (by user id) for filter's days range.
+// Data is as fresh as the last rollups refresh.
+func (s *AnalyticsService) GetTeacherAnalytics(ctx context.Context, userID int,
+	filter entities.TeacherAnalyticsFilter) (*entities.TeacherAnalytics, error) {
+	teacher, err := s.repo.GetTeacherByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, serviceErrs.ErrorUserIsNotTeacher
+		}
+
+		return nil, fmt.Errorf("failed to get teacher by user id: %w", err)
+	}
+
+	total, err := s.repo.GetTeacherStatsTotal(ctx, teacher.ID, filter.From, filter.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teacher stats total: %w", err)
+	}
+
+	periods, err := s.repo.GetTeacherStatsByPeriods(ctx, teacher.ID, filter.From, filter.To, filter.Period)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teacher stats by periods: %w", err)
+	}
+
+	categories, err := s.repo.GetTeacherStatsByCategories(ctx, teacher.ID, filter.From, filter.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teacher stats by categories: %w", err)
+	}
+
+	studentsCount, repeatStudentsCount, err := s.repo.GetTeacherStudentsStat(ctx, teacher.ID, filter.From, filter.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teacher students stat: %w", err)
+	}
+
+	fillRates(total)
+
+	for i := range periods {
+		fillRates(&periods[i])
+	}
+
+	for i := range categories {
+		fillRates(&categories[i])
+	}
+
+	return &entities.TeacherAnalytics{
+		Filter:              filter,
+		Total:               *total,
+		Periods:             periods,
+		Categories:          categories,
+		StudentsCount:       studentsCount,
+		RepeatStudentsCount: repeatStudentsCount,
+		RepeatStudentRate:   ratio(repeatStudentsCount, studentsCount),
+	}, nil
+}
+
+func fillRates(bucket *entities.TeacherStatsBucket) {
+	bucket.CancellationRate = ratio(bucket.CancelledCount, bucket.LessonsCount)
+	bucket.RejectionRate = ratio(bucket.RejectedCount, bucket.LessonsCount)
+	bucket.NoShowRate = ratio(bucket.NoShowCount, bucket.LessonsCount)
+	bucket.AverageRate = ratio(bucket.RateSum, bucket.ReviewsCount)
+}
+
+func ratio(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return float64(part) / float64(total)
+}
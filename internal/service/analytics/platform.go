@@ -0,0 +1,56 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+)
+
+const topCategoriesLimit = 10
+
+// GetPlatformAnalytics returns platform dashboard for filter's days range.
+// Data is as fresh as the last rollups refresh, skill approval backlog is current.
+func (s *AnalyticsService) GetPlatformAnalytics(ctx context.Context,
+	filter entities.PlatformAnalyticsFilter) (*entities.PlatformAnalytics, error) {
+	periods, err := s.repo.GetPlatformStatsByPeriods(ctx, filter.From, filter.To, filter.Period)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get platform stats by periods: %w", err)
+	}
+
+	categories, err := s.repo.GetTopCategoriesStats(ctx, filter.From, filter.To, topCategoriesLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top categories stats: %w", err)
+	}
+
+	approval, err := s.repo.GetSkillApprovalStat(ctx, filter.From, filter.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skill approval stat: %w", err)
+	}
+
+	var total entities.PlatformStatsBucket
+
+	for i := range periods {
+		total.Add(&periods[i])
+		fillPlatformRates(&periods[i])
+	}
+
+	fillPlatformRates(&total)
+
+	for i := range categories {
+		fillPlatformRates(&categories[i])
+	}
+
+	return &entities.PlatformAnalytics{
+		Filter:        filter,
+		Total:         total,
+		Periods:       periods,
+		TopCategories: categories,
+		SkillApproval: *approval,
+	}, nil
+}
+
+func fillPlatformRates(bucket *entities.PlatformStatsBucket) {
+	bucket.CancellationRate = ratio(bucket.LessonsCancelled, bucket.LessonsBooked)
+	bucket.RejectionRate = ratio(bucket.LessonsRejected, bucket.LessonsBooked)
+}
@@ -0,0 +1,96 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultRefreshInterval = 5 * time.Minute
+	defaultNoShowAfter     = time.Hour
+
+	// changes made during refresh are caught by the next one, margin covers clock difference with database
+	refreshOverlap = time.Minute
+)
+
+type Repository interface {
+	GetTeacherByUserID(ctx context.Context, id int) (*entities.Teacher, error)
+
+	RefreshTeacherDailyStats(ctx context.Context, since time.Time, noShowAfter time.Duration) error
+	GetTeacherStatsTotal(ctx context.Context, teacherID int, from, to time.Time) (*entities.TeacherStatsBucket, error)
+	GetTeacherStatsByPeriods(ctx context.Context, teacherID int, from, to time.Time,
+		period entities.AnalyticsPeriod) ([]entities.TeacherStatsBucket, error)
+	GetTeacherStatsByCategories(ctx context.Context, teacherID int, from, to time.Time) ([]entities.TeacherStatsBucket, error)
+	GetTeacherStudentsStat(ctx context.Context, teacherID int, from, to time.Time) (int, int, error)
+
+	RefreshPlatformDailyStats(ctx context.Context, since time.Time) error
+	GetPlatformStatsByPeriods(ctx context.Context, from, to time.Time,
+		period entities.AnalyticsPeriod) ([]entities.PlatformStatsBucket, error)
+	GetTopCategoriesStats(ctx context.Context, from, to time.Time, limit int) ([]entities.PlatformStatsBucket, error)
+	GetSkillApprovalStat(ctx context.Context, from, to time.Time) (*entities.SkillApprovalStat, error)
+}
+
+// Config contains settings of analytics rollups refreshing.
+type Config struct {
+	RefreshInterval time.Duration `env:"ANALYTICS_REFRESH_INTERVAL" env-default:"5m"`
+	NoShowAfter     time.Duration `env:"ANALYTICS_NO_SHOW_AFTER"    env-default:"1h"`
+}
+
+// AnalyticsService builds teachers' and platform dashboards from rollup tables
+// which are maintained in background (see Run).
+type AnalyticsService struct {
+	repo   Repository
+	config Config
+	log    *zap.Logger
+}
+
+func NewService(repo Repository, config Config, log *zap.Logger) *AnalyticsService {
+	if config.RefreshInterval <= 0 {
+		config.RefreshInterval = defaultRefreshInterval
+	}
+
+	if config.NoShowAfter <= 0 {
+		config.NoShowAfter = defaultNoShowAfter
+	}
+
+	return &AnalyticsService{
+		repo:   repo,
+		config: config,
+		log:    log,
+	}
+}
+
+// Run refreshes rollups every RefreshInterval until ctx is done.
+// First refresh rebuilds rollups completely, next ones recompute only days changed since previous refresh.
+func (s *AnalyticsService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.RefreshInterval)
+	defer ticker.Stop()
+
+	var teacherSince, platformSince time.Time
+
+	for {
+		startedAt := time.Now()
+
+		if err := s.repo.RefreshTeacherDailyStats(ctx, teacherSince, s.config.NoShowAfter); err != nil {
+			s.log.Error("failed to refresh teacher daily stats", zap.Error(err))
+		} else {
+			teacherSince = startedAt.Add(-refreshOverlap)
+		}
+
+		if err := s.repo.RefreshPlatformDailyStats(ctx, platformSince); err != nil {
+			s.log.Error("failed to refresh platform daily stats", zap.Error(err))
+		} else {
+			platformSince = startedAt.Add(-refreshOverlap)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
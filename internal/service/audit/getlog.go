@@ -0,0 +1,96 @@
+package audit
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+const (
+	DefaultAuditLogLimit = 50
+	MaxAuditLogLimit     = 200
+	// MaxAuditExportEntries limits export, bigger exports should be split by time range.
+	MaxAuditExportEntries = 10000
+)
+
+// GetAuditLog returns one page of audit log (newest first) and cursor of the next page
+// (empty cursor means that it was the last page).
+func (s *AuditService) GetAuditLog(ctx context.Context, filter *entities.AuditLogFilter, cursor string) ([]*entities.AdminAuditEntry, string, error) {
+	if filter.Limit <= 0 || filter.Limit > MaxAuditLogLimit {
+		filter.Limit = DefaultAuditLogLimit
+	}
+
+	if cursor != "" {
+		lastID, err := decodeAuditLogCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		filter.Cursor = &lastID
+	}
+
+	entries, hasMore, err := s.repo.GetAdminAuditLog(ctx, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get audit log: %w", err)
+	}
+
+	if !hasMore || len(entries) == 0 {
+		return entries, "", nil
+	}
+
+	nextCursor, err := encodeAuditLogCursor(entries[len(entries)-1].ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return entries, nextCursor, nil
+}
+
+// ExportAuditLog returns all entries of audit log matching filter (newest first),
+// returns serviceErrs.ErrorAuditExportTooLarge if there are more than MaxAuditExportEntries of them.
+func (s *AuditService) ExportAuditLog(ctx context.Context, filter *entities.AuditLogFilter) ([]*entities.AdminAuditEntry, error) {
+	filter.Limit = MaxAuditExportEntries
+	filter.Cursor = nil
+
+	entries, hasMore, err := s.repo.GetAdminAuditLog(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit log: %w", err)
+	}
+
+	if hasMore {
+		return nil, serviceErrs.ErrorAuditExportTooLarge
+	}
+
+	return entries, nil
+}
+
+type auditLogCursorPayload struct {
+	AuditID int `json:"id"`
+}
+
+func encodeAuditLogCursor(lastID int) (string, error) {
+	data, err := json.Marshal(auditLogCursorPayload{AuditID: lastID})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeAuditLogCursor(cursor string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, serviceErrs.ErrorInvalidCursor
+	}
+
+	var payload auditLogCursorPayload
+	if err = json.Unmarshal(data, &payload); err != nil || payload.AuditID <= 0 {
+		return 0, serviceErrs.ErrorInvalidCursor
+	}
+
+	return payload.AuditID, nil
+}
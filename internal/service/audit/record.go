@@ -0,0 +1,125 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// CompleteAdminAction sets snapshot after admin's mutation to its audit entry,
+// entry itself is saved by the mutation in the same transaction.
+func (s *AuditService) CompleteAdminAction(ctx context.Context, entry *entities.AdminAuditEntry, after []byte) error {
+	if err := s.repo.SetAdminAuditEntryAfter(ctx, entry.ID, after); err != nil {
+		return fmt.Errorf("failed to set snapshot after admin action: %w", err)
+	}
+
+	entry.After = after
+
+	return nil
+}
+
+// SnapshotAuditEntity returns current state of entity as JSON for audit log,
+// nil if entity doesn't exist or its type has no snapshot.
+func (s *AuditService) SnapshotAuditEntity(ctx context.Context, entityType entities.AuditEntityType, entityID *int) ([]byte, error) {
+	state, err := s.getAuditEntity(ctx, entityType, entityID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get %s for audit snapshot: %w", entityType, err)
+	}
+
+	if state == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal audit snapshot: %w", err)
+	}
+
+	return data, nil
+}
+
+// getAuditEntity returns state of entity to snapshot, nil if there is nothing to snapshot.
+func (s *AuditService) getAuditEntity(ctx context.Context, entityType entities.AuditEntityType, entityID *int) (any, error) {
+	// category order of root categories has no id
+	if entityType == entities.AuditEntityCategoryOrder {
+		return s.getCategoryOrder(ctx, entityID)
+	}
+
+	if entityID == nil {
+		return nil, nil
+	}
+
+	id := *entityID
+
+	switch entityType {
+	case entities.AuditEntitySkill:
+		return s.repo.GetSkillByID(ctx, id)
+	case entities.AuditEntityCertificate:
+		return s.repo.GetCertificateByID(ctx, id)
+	case entities.AuditEntityCategory:
+		return s.repo.GetCategoryByID(ctx, id)
+	case entities.AuditEntityAgeExceptions:
+		return s.repo.GetCategoryAgeExceptions(ctx, id)
+	case entities.AuditEntityReview:
+		return s.repo.GetReviewByID(ctx, id)
+	case entities.AuditEntityReviewReply:
+		return s.repo.GetReviewReply(ctx, id)
+	case entities.AuditEntityComplaint:
+		complaint, err := s.repo.GetComplaintByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		// users are snapshotted by ids only
+		complaint.Complainer, complaint.Reported = nil, nil
+
+		return complaint, nil
+	case entities.AuditEntityUserSuspension:
+		return s.repo.GetActiveSuspension(ctx, id)
+	case entities.AuditEntityModerationItem:
+		return s.repo.GetModerationQueueItemByID(ctx, id)
+	case entities.AuditEntityUserRoles:
+		return s.repo.GetUserAccess(ctx, id)
+	case entities.AuditEntityUser:
+		user, err := s.repo.GetUserByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		// password hash never gets into the log
+		user.Password = ""
+
+		return user, nil
+	default:
+		return nil, nil
+	}
+}
+
+// getCategoryOrder returns ids of children of parent category (root categories if parentID is nil) in their order.
+func (s *AuditService) getCategoryOrder(ctx context.Context, parentID *int) ([]int, error) {
+	categories, err := s.repo.GetCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order := make([]int, 0)
+
+	for _, category := range categories {
+		isSameParent := (category.ParentID == nil && parentID == nil) ||
+			(category.ParentID != nil && parentID != nil && *category.ParentID == *parentID)
+
+		if isSameParent {
+			order = append(order, category.ID)
+		}
+	}
+
+	return order, nil
+}
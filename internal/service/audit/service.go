@@ -0,0 +1,36 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+)
+
+type Repository interface {
+	SetAdminAuditEntryAfter(ctx context.Context, id int, after []byte) error
+	GetAdminAuditLog(ctx context.Context, filter *entities.AuditLogFilter) ([]*entities.AdminAuditEntry, bool, error)
+
+	// for snapshots
+	GetSkillByID(ctx context.Context, id int) (*entities.Skill, error)
+	GetCertificateByID(ctx context.Context, id int) (*entities.TeacherCertificate, error)
+	GetCategoryByID(ctx context.Context, id int) (*entities.Category, error)
+	GetCategories(ctx context.Context) ([]*entities.Category, error)
+	GetCategoryAgeExceptions(ctx context.Context, categoryID int) ([]entities.CategoryAgeException, error)
+	GetReviewByID(ctx context.Context, id int) (*entities.Review, error)
+	GetReviewReply(ctx context.Context, reviewID int) (*entities.ReviewReply, error)
+	GetComplaintByID(ctx context.Context, id int) (*entities.Complaint, error)
+	GetActiveSuspension(ctx context.Context, userID int) (*entities.UserSuspension, error)
+	GetModerationQueueItemByID(ctx context.Context, id int) (*entities.ModerationQueueItem, error)
+	GetUserAccess(ctx context.Context, userID int) (*entities.UserAccess, error)
+	GetUserByID(ctx context.Context, id int) (*entities.User, error)
+}
+
+type AuditService struct {
+	repo Repository
+}
+
+func NewService(repo Repository) *AuditService {
+	return &AuditService{
+		repo: repo,
+	}
+}
@@ -0,0 +1,87 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// GetCategoriesForUser returns not archived categories and marks ones user is too young for.
+func (s *CategoryService) GetCategoriesForUser(ctx context.Context, userID int) ([]*entities.Category, error) {
+	categories, err := s.GetCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.repo.GetTooYoungCategoryIDs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get too young categories: %w", err)
+	}
+
+	tooYoung := make(map[int]struct{}, len(ids))
+	for _, id := range ids {
+		tooYoung[id] = struct{}{}
+	}
+
+	for _, category := range categories {
+		_, category.IsTooYoung = tooYoung[category.ID]
+	}
+
+	return categories, nil
+}
+
+// GrantAgeException allows user to book lessons (and register skill) in category despite its min age.
+func (s *CategoryService) GrantAgeException(ctx context.Context, categoryID, userID, adminID int, auditEntry *entities.AdminAuditEntry) error {
+	if _, err := s.getCategoryByID(ctx, categoryID); err != nil {
+		return err
+	}
+
+	exists, err := s.repo.IsUserExistsByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to find user by id: %w", err)
+	}
+
+	if !exists {
+		return serviceErrs.ErrorUserNotFound
+	}
+
+	exception := &entities.CategoryAgeException{
+		UserID:     userID,
+		CategoryID: categoryID,
+		GrantedBy:  &adminID,
+	}
+
+	if err = s.repo.CreateCategoryAgeException(ctx, exception, auditEntry); err != nil {
+		return fmt.Errorf("failed to create category age exception: %w", err)
+	}
+
+	return nil
+}
+
+func (s *CategoryService) RevokeAgeException(ctx context.Context, categoryID, userID int, auditEntry *entities.AdminAuditEntry) error {
+	if err := s.repo.DeleteCategoryAgeException(ctx, userID, categoryID, auditEntry); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorAgeExceptionNotFound
+		}
+
+		return fmt.Errorf("failed to delete category age exception: %w", err)
+	}
+
+	return nil
+}
+
+func (s *CategoryService) GetAgeExceptions(ctx context.Context, categoryID int) ([]entities.CategoryAgeException, error) {
+	if _, err := s.getCategoryByID(ctx, categoryID); err != nil {
+		return nil, err
+	}
+
+	exceptions, err := s.repo.GetCategoryAgeExceptions(ctx, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category age exceptions: %w", err)
+	}
+
+	return exceptions, nil
+}
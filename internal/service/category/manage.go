@@ -0,0 +1,211 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// CreateCategory creates category (subcategory if ParentID is set) and returns its id.
+func (s *CategoryService) CreateCategory(ctx context.Context, category *entities.Category, auditEntry *entities.AdminAuditEntry) (int, error) {
+	if category.ParentID != nil {
+		if err := s.checkParent(ctx, *category.ParentID); err != nil {
+			return 0, err
+		}
+	}
+
+	id, err := s.repo.CreateCategory(ctx, category, auditEntry)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorNonUniqueData) {
+			return 0, serviceErrs.ErrorCategoryExists
+		}
+
+		return 0, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return id, nil
+}
+
+// UpdateCategory renames category, changes its min age or moves it to another parent
+// (nil fields are not changed, parentID 0 makes category root).
+func (s *CategoryService) UpdateCategory(ctx context.Context, id int, name *string, minAge *int, parentID *int, auditEntry *entities.AdminAuditEntry) error {
+	category, err := s.getCategoryByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if name != nil {
+		category.Name = *name
+	}
+
+	if minAge != nil {
+		category.MinAge = *minAge
+	}
+
+	switch {
+	case parentID == nil:
+	case *parentID == 0:
+		category.ParentID = nil
+	default:
+		if err = s.checkParent(ctx, *parentID); err != nil {
+			return err
+		}
+
+		isDescendant, err := s.isInSubtree(ctx, id, *parentID)
+		if err != nil {
+			return err
+		}
+
+		if isDescendant {
+			return serviceErrs.ErrorCategoryCycle
+		}
+
+		category.ParentID = parentID
+	}
+
+	if err = s.repo.UpdateCategory(ctx, category, auditEntry); err != nil {
+		switch {
+		case errors.Is(err, serviceErrs.ErrorNonUniqueData):
+			return serviceErrs.ErrorCategoryExists
+		case errors.Is(err, serviceErrs.ErrorSelectEmpty):
+			return serviceErrs.ErrorCategoryNotFound
+		}
+
+		return fmt.Errorf("failed to update category: %w", err)
+	}
+
+	return nil
+}
+
+// ArchiveCategory hides category and its subcategories from new skills and catalogue filters.
+func (s *CategoryService) ArchiveCategory(ctx context.Context, id int, auditEntry *entities.AdminAuditEntry) error {
+	return s.setArchived(ctx, id, true, auditEntry)
+}
+
+// RestoreCategory restores archived category with its subcategories.
+func (s *CategoryService) RestoreCategory(ctx context.Context, id int, auditEntry *entities.AdminAuditEntry) error {
+	category, err := s.getCategoryByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if category.ParentID != nil {
+		parent, err := s.getCategoryByID(ctx, *category.ParentID)
+		if err != nil {
+			return err
+		}
+
+		if parent.IsArchived {
+			return serviceErrs.ErrorCategoryParentArchived
+		}
+	}
+
+	return s.setArchived(ctx, id, false, auditEntry)
+}
+
+// ReorderCategories sets order of subcategories of parent (root categories if parentID is nil),
+// ids must contain all of them.
+func (s *CategoryService) ReorderCategories(ctx context.Context, parentID *int, ids []int, auditEntry *entities.AdminAuditEntry) error {
+	categories, err := s.repo.GetCategories(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get categories from db: %w", err)
+	}
+
+	siblings := make([]int, 0)
+
+	for _, category := range categories {
+		if sameParent(category.ParentID, parentID) {
+			siblings = append(siblings, category.ID)
+		}
+	}
+
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	slices.Sort(siblings)
+
+	if !slices.Equal(sorted, siblings) {
+		return serviceErrs.ErrorCategoryReorderMismatch
+	}
+
+	if err = s.repo.ReorderCategories(ctx, ids, auditEntry); err != nil {
+		return fmt.Errorf("failed to reorder categories: %w", err)
+	}
+
+	return nil
+}
+
+func (s *CategoryService) setArchived(ctx context.Context, id int, isArchived bool, auditEntry *entities.AdminAuditEntry) error {
+	if err := s.repo.SetCategoryArchived(ctx, id, isArchived, auditEntry); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorCategoryNotFound
+		}
+
+		return fmt.Errorf("failed to set category archived: %w", err)
+	}
+
+	return nil
+}
+
+func (s *CategoryService) checkParent(ctx context.Context, parentID int) error {
+	parent, err := s.repo.GetCategoryByID(ctx, parentID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorCategoryParentNotFound
+		}
+
+		return fmt.Errorf("failed to get parent category: %w", err)
+	}
+
+	if parent.IsArchived {
+		return serviceErrs.ErrorCategoryParentArchived
+	}
+
+	return nil
+}
+
+// isInSubtree checks is category with id in subtree of category with rootID (including root).
+func (s *CategoryService) isInSubtree(ctx context.Context, rootID, id int) (bool, error) {
+	categories, err := s.repo.GetCategories(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get categories from db: %w", err)
+	}
+
+	parents := make(map[int]*int, len(categories))
+	for _, category := range categories {
+		parents[category.ID] = category.ParentID
+	}
+
+	// go up from category to root, tree is small
+	for current := &id; current != nil; current = parents[*current] {
+		if *current == rootID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s *CategoryService) getCategoryByID(ctx context.Context, id int) (*entities.Category, error) {
+	category, err := s.repo.GetCategoryByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, serviceErrs.ErrorCategoryNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	return category, nil
+}
+
+func sameParent(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return *a == *b
+}
@@ -9,6 +9,17 @@ import (
 
 type Repository interface {
 	GetCategories(ctx context.Context) ([]*entities.Category, error)
+	GetCategoryByID(ctx context.Context, id int) (*entities.Category, error)
+	CreateCategory(ctx context.Context, category *entities.Category, auditEntry *entities.AdminAuditEntry) (int, error)
+	UpdateCategory(ctx context.Context, category *entities.Category, auditEntry *entities.AdminAuditEntry) error
+	SetCategoryArchived(ctx context.Context, id int, isArchived bool, auditEntry *entities.AdminAuditEntry) error
+	ReorderCategories(ctx context.Context, ids []int, auditEntry *entities.AdminAuditEntry) error
+
+	IsUserExistsByID(ctx context.Context, id int) (bool, error)
+	GetTooYoungCategoryIDs(ctx context.Context, userID int) ([]int, error)
+	CreateCategoryAgeException(ctx context.Context, exception *entities.CategoryAgeException, auditEntry *entities.AdminAuditEntry) error
+	DeleteCategoryAgeException(ctx context.Context, userID, categoryID int, auditEntry *entities.AdminAuditEntry) error
+	GetCategoryAgeExceptions(ctx context.Context, categoryID int) ([]entities.CategoryAgeException, error)
 }
 
 type CategoryService struct {
@@ -21,12 +32,30 @@ func NewService(repo Repository) *CategoryService {
 	}
 }
 
-// GetCategories returns all categories.
+// GetCategories returns not archived categories.
 func (s *CategoryService) GetCategories(ctx context.Context) ([]*entities.Category, error) {
 	categories, err := s.repo.GetCategories(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get categories from db: %w", err)
 	}
 
+	active := make([]*entities.Category, 0, len(categories))
+
+	for _, category := range categories {
+		if !category.IsArchived {
+			active = append(active, category)
+		}
+	}
+
+	return active, nil
+}
+
+// GetAllCategories returns all categories including archived ones.
+func (s *CategoryService) GetAllCategories(ctx context.Context) ([]*entities.Category, error) {
+	categories, err := s.repo.GetCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories from db: %w", err)
+	}
+
 	return categories, nil
 }
@@ -2,41 +2,127 @@ package complaint
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
+
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
 	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/pkg/storage/object"
 )
 
-func (s *ComplaintService) CreateComplaint(ctx context.Context, complaint *entities.Complaint) error {
+// CreateComplaint creates complaint with evidence files and returns its id.
+// Complaint about lesson can be made only by its participant and only about the other one
+// (reported user is taken from the lesson if it is not set).
+func (s *ComplaintService) CreateComplaint(ctx context.Context, complaint *entities.Complaint, files []*object.File) (int, error) {
 	// is complainer exists
 	exists, err := s.repo.IsUserExistsByID(ctx, complaint.ComplainerID)
 	if err != nil {
-		return fmt.Errorf("failed to check user existstance by id: %w", err)
+		return 0, fmt.Errorf("failed to check user existstance by id: %w", err)
 	}
 
 	if !exists {
-		return serviceErrs.ErrorUserNotFound
+		return 0, serviceErrs.ErrorUserNotFound
+	}
+
+	if complaint.LessonID != nil {
+		if err = s.fillReportedFromLesson(ctx, complaint); err != nil {
+			return 0, err
+		}
 	}
 
 	// is reported exists
 	exists, err = s.repo.IsUserExistsByID(ctx, complaint.ReportedID)
 	if err != nil {
-		return fmt.Errorf("failed to check user existstance by id: %w", err)
+		return 0, fmt.Errorf("failed to check user existstance by id: %w", err)
 	}
 
 	if !exists {
-		return serviceErrs.ErrorReportedUserNotFound
+		return 0, serviceErrs.ErrorReportedUserNotFound
 	}
 
 	// is complainer != reported
 	if complaint.ComplainerID == complaint.ReportedID {
-		return serviceErrs.ErrorComplainerAndReportedSame
+		return 0, serviceErrs.ErrorComplainerAndReportedSame
 	}
 
-	if err := s.repo.CreateComplaint(ctx, complaint); err != nil {
-		return fmt.Errorf("failed to create complaint: %w", err)
+	moderationItem, err := s.moderator.CheckContent(ctx, &entities.UserContent{
+		Kind:     entities.ContentComplaint,
+		AuthorID: complaint.ComplainerID,
+		Text:     complaint.Reason + "\n" + complaint.Description,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	complaint.Attachments = make([]entities.ComplaintAttachment, 0, len(files))
+
+	for _, file := range files {
+		file.Name = uuid.New().String() + "." + file.Extension
+
+		if err = s.objectStorage.UploadFile(ctx, file); err != nil {
+			s.deleteAttachmentFiles(ctx, complaint.Attachments)
+
+			return 0, fmt.Errorf("failed to upload complaint attachment: %w", err)
+		}
+
+		complaint.Attachments = append(complaint.Attachments, entities.ComplaintAttachment{FileName: file.Name})
+	}
+
+	id, err := s.repo.CreateComplaint(ctx, complaint, moderationItem)
+	if err != nil {
+		s.deleteAttachmentFiles(ctx, complaint.Attachments)
+
+		return 0, fmt.Errorf("failed to create complaint: %w", err)
+	}
+
+	return id, nil
+}
+
+// fillReportedFromLesson checks that complainer took part in the lesson
+// and sets (or checks) reported user as the other participant.
+func (s *ComplaintService) fillReportedFromLesson(ctx context.Context, complaint *entities.Complaint) error {
+	lesson, err := s.repo.GetLessonByID(ctx, *complaint.LessonID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorLessonNotFound
+		}
+
+		return fmt.Errorf("failed to get lesson by id: %w", err)
+	}
+
+	teacherUserID, err := s.repo.GetUserIDByTeacherID(ctx, lesson.TeacherID)
+	if err != nil {
+		return fmt.Errorf("failed to get user id of lesson's teacher: %w", err)
+	}
+
+	var otherParticipantID int
+
+	switch complaint.ComplainerID {
+	case lesson.StudentID:
+		otherParticipantID = teacherUserID
+	case teacherUserID:
+		otherParticipantID = lesson.StudentID
+	default:
+		return serviceErrs.ErrorNotRelatedUserToLesson
+	}
+
+	if complaint.ReportedID == 0 {
+		complaint.ReportedID = otherParticipantID
+	}
+
+	if complaint.ReportedID != otherParticipantID {
+		return serviceErrs.ErrorReportedNotRelatedToLesson
 	}
 
 	return nil
 }
+
+// deleteAttachmentFiles removes uploaded files of not created complaint, failures are ignored:
+// such files are just not referenced anymore.
+func (s *ComplaintService) deleteAttachmentFiles(ctx context.Context, attachments []entities.ComplaintAttachment) {
+	for _, attachment := range attachments {
+		_ = s.objectStorage.DeleteFile(ctx, attachment.FileName)
+	}
+}
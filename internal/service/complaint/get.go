@@ -0,0 +1,83 @@
+package complaint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/pkg/storage/object"
+)
+
+// GetComplaint returns complaint with its attachments, internal notes
+// and the lesson with its transition history if complaint is about lesson.
+func (s *ComplaintService) GetComplaint(ctx context.Context, id int) (*entities.ComplaintDetails, error) {
+	complaint, err := s.getComplaintByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	complaint.Attachments, err = s.repo.GetComplaintAttachments(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get complaint attachments: %w", err)
+	}
+
+	notes, err := s.repo.GetComplaintNotes(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get complaint notes: %w", err)
+	}
+
+	details := &entities.ComplaintDetails{
+		Complaint: complaint,
+		Notes:     notes,
+	}
+
+	if complaint.LessonID == nil {
+		return details, nil
+	}
+
+	details.Lesson, err = s.repo.GetLessonByID(ctx, *complaint.LessonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lesson of complaint: %w", err)
+	}
+
+	details.LessonHistory, err = s.repo.GetStateTransitionHistory(ctx, details.Lesson.StateMachineItemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lesson transition history: %w", err)
+	}
+
+	return details, nil
+}
+
+// GetComplaintAttachmentFile returns evidence file of the complaint.
+func (s *ComplaintService) GetComplaintAttachmentFile(ctx context.Context, complaintID, attachmentID int) (*object.File, error) {
+	attachment, err := s.repo.GetComplaintAttachmentByID(ctx, complaintID, attachmentID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, serviceErrs.ErrorComplaintAttachmentNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get complaint attachment: %w", err)
+	}
+
+	file, err := s.objectStorage.GetFile(ctx, attachment.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get complaint attachment file: %w", err)
+	}
+
+	return file, nil
+}
+
+func (s *ComplaintService) getComplaintByID(ctx context.Context, id int) (*entities.Complaint, error) {
+	complaint, err := s.repo.GetComplaintByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, serviceErrs.ErrorComplaintNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get complaint by id: %w", err)
+	}
+
+	return complaint, nil
+}
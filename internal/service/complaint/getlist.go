@@ -2,35 +2,75 @@ package complaint
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
-	"github.com/LearnShareApp/learn-share-backend/pkg/workerpool"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+const (
+	DefaultComplaintListLimit = 20
+	MaxComplaintListLimit     = 100
 )
 
-func (s *ComplaintService) GetComplaintList(ctx context.Context) ([]*entities.Complaint, error) {
-	complaints, err := s.repo.GetAllComplaints(ctx)
+// GetComplaintList returns one page of complaints (newest first) and cursor of the next page
+// (empty cursor means that it was the last page).
+func (s *ComplaintService) GetComplaintList(ctx context.Context, filter *entities.ComplaintListFilter, cursor string) ([]*entities.Complaint, string, error) {
+	if filter.Limit <= 0 || filter.Limit > MaxComplaintListLimit {
+		filter.Limit = DefaultComplaintListLimit
+	}
+
+	if cursor != "" {
+		lastID, err := decodeComplaintListCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		filter.Cursor = &lastID
+	}
+
+	complaints, hasMore, err := s.repo.GetComplaintsFiltered(ctx, filter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get complaints: %w", err)
+		return nil, "", fmt.Errorf("failed to get complaints: %w", err)
 	}
 
-	// all unique users
-	users := make(map[int]*entities.User)
-	for _, c := range complaints {
-		users[c.ComplainerID] = nil
-		users[c.ReportedID] = nil
+	if !hasMore || len(complaints) == 0 {
+		return complaints, "", nil
 	}
 
-	wp := workerpool.NewWorkerPool[entities.User](10)
-	err = wp.FillMap(ctx, users, s.repo.GetUserByID)
+	nextCursor, err := encodeComplaintListCursor(complaints[len(complaints)-1].ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return complaints, nextCursor, nil
+}
+
+type complaintListCursorPayload struct {
+	ComplaintID int `json:"id"`
+}
+
+func encodeComplaintListCursor(lastID int) (string, error) {
+	data, err := json.Marshal(complaintListCursorPayload{ComplaintID: lastID})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
 
+func decodeComplaintListCursor(cursor string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get info about users: %w", err)
+		return 0, serviceErrs.ErrorInvalidCursor
 	}
 
-	for i, c := range complaints {
-		complaints[i].Complainer = users[c.ComplainerID]
-		complaints[i].Reported = users[c.ReportedID]
+	var payload complaintListCursorPayload
+	if err = json.Unmarshal(data, &payload); err != nil || payload.ComplaintID <= 0 {
+		return 0, serviceErrs.ErrorInvalidCursor
 	}
 
-	return complaints, nil
+	return payload.ComplaintID, nil
 }
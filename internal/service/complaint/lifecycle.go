@@ -0,0 +1,119 @@
+package complaint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// complaintNotificationTypes maps final state of complaint to notification for complainer.
+var complaintNotificationTypes = map[entities.StateName]entities.NotificationType{
+	entities.Resolved:  entities.NotificationComplaintResolved,
+	entities.Dismissed: entities.NotificationComplaintDismissed,
+}
+
+// AssignComplaint assigns complaint to admin and takes open complaint in review.
+// Complaint which is already in review is reassigned.
+func (s *ComplaintService) AssignComplaint(ctx context.Context, adminID, complaintID int, auditEntry *entities.AdminAuditEntry) error {
+	complaint, err := s.getComplaintByID(ctx, complaintID)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(entities.ClosedComplaintStates, complaint.StateName) {
+		return serviceErrs.ErrorUnavailableStateTransition
+	}
+
+	item, nextStateID, err := s.getComplaintTransition(ctx, complaint, entities.InReview, true)
+	if err != nil {
+		return err
+	}
+
+	if err = s.repo.AssignComplaint(ctx, complaintID, item, nextStateID, adminID, auditEntry); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorUnavailableStateTransition
+		}
+
+		return fmt.Errorf("failed to assign complaint: %w", err)
+	}
+
+	return nil
+}
+
+// ResolveComplaint closes complaint with admin's decision and notifies complainer about it.
+func (s *ComplaintService) ResolveComplaint(ctx context.Context, resolution *entities.ComplaintResolution, auditEntry *entities.AdminAuditEntry) error {
+	notificationType, ok := complaintNotificationTypes[resolution.State]
+	if !ok {
+		return serviceErrs.ErrorUnavailableStateTransition
+	}
+
+	complaint, err := s.getComplaintByID(ctx, resolution.ComplaintID)
+	if err != nil {
+		return err
+	}
+
+	// closed complaint keeps its first resolution
+	if slices.Contains(entities.ClosedComplaintStates, complaint.StateName) {
+		return serviceErrs.ErrorUnavailableStateTransition
+	}
+
+	item, nextStateID, err := s.getComplaintTransition(ctx, complaint, resolution.State, false)
+	if err != nil {
+		return err
+	}
+
+	notification := &entities.Notification{
+		UserID:   complaint.ComplainerID,
+		Type:     notificationType,
+		EntityID: &complaint.ID,
+		Message:  &resolution.Resolution,
+	}
+
+	if err = s.repo.ResolveComplaint(ctx, resolution, item, nextStateID, notification, auditEntry); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorUnavailableStateTransition
+		}
+
+		return fmt.Errorf("failed to resolve complaint: %w", err)
+	}
+
+	return nil
+}
+
+// getComplaintTransition returns complaint's state machine item and id of the next state
+// if complaint can be moved to it (staying in the same state is allowed only if allowSameState is set).
+func (s *ComplaintService) getComplaintTransition(ctx context.Context, complaint *entities.Complaint, state entities.StateName,
+	allowSameState bool) (*entities.StateMachineItem, int, error) {
+	nextStateID, err := s.repo.GetStateIDByName(ctx, state)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get next stateID by name: %w", err)
+	}
+
+	item, err := s.repo.GetStateMachineItemByID(ctx, complaint.StateMachineItemID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get statemachine item by id: %w", err)
+	}
+
+	if item.StateID == nextStateID {
+		if !allowSameState {
+			return nil, 0, serviceErrs.ErrorUnavailableStateTransition
+		}
+
+		return item, nextStateID, nil
+	}
+
+	available, err := s.repo.CheckIsTransitionAvailable(ctx, item.StateMachineID, item.StateID, nextStateID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to check if transition is available: %w", err)
+	}
+
+	if !available {
+		return nil, 0, serviceErrs.ErrorUnavailableStateTransition
+	}
+
+	return item, nextStateID, nil
+}
@@ -0,0 +1,22 @@
+package complaint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+)
+
+// AddComplaintNote adds admin's internal note to the complaint and returns its id.
+func (s *ComplaintService) AddComplaintNote(ctx context.Context, note *entities.ComplaintNote, auditEntry *entities.AdminAuditEntry) (int, error) {
+	if _, err := s.getComplaintByID(ctx, note.ComplaintID); err != nil {
+		return 0, err
+	}
+
+	id, err := s.repo.CreateComplaintNote(ctx, note, auditEntry)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create complaint note: %w", err)
+	}
+
+	return id, nil
+}
@@ -4,22 +4,53 @@ import (
 	"context"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	"github.com/LearnShareApp/learn-share-backend/pkg/storage/object"
 )
 
+type ObjectStorage interface {
+	UploadFile(ctx context.Context, file *object.File) error
+	GetFile(ctx context.Context, fileName string) (*object.File, error)
+	DeleteFile(ctx context.Context, fileName string) error
+}
+
 type Repository interface {
-	CreateComplaint(ctx context.Context, complaint *entities.Complaint) error
-	GetAllComplaints(ctx context.Context) ([]*entities.Complaint, error)
+	CreateComplaint(ctx context.Context, complaint *entities.Complaint, moderationItem *entities.ModerationQueueItem) (int, error)
+	GetComplaintsFiltered(ctx context.Context, filter *entities.ComplaintListFilter) ([]*entities.Complaint, bool, error)
+	GetComplaintByID(ctx context.Context, id int) (*entities.Complaint, error)
+	AssignComplaint(ctx context.Context, complaintID int, item *entities.StateMachineItem, newStateID, adminID int, auditEntry *entities.AdminAuditEntry) error
+	ResolveComplaint(ctx context.Context, resolution *entities.ComplaintResolution, item *entities.StateMachineItem, newStateID int, notification *entities.Notification, auditEntry *entities.AdminAuditEntry) error
+	CreateComplaintNote(ctx context.Context, note *entities.ComplaintNote, auditEntry *entities.AdminAuditEntry) (int, error)
+	GetComplaintNotes(ctx context.Context, complaintID int) ([]*entities.ComplaintNote, error)
+	GetComplaintAttachments(ctx context.Context, complaintID int) ([]entities.ComplaintAttachment, error)
+	GetComplaintAttachmentByID(ctx context.Context, complaintID, attachmentID int) (*entities.ComplaintAttachment, error)
+
+	GetLessonByID(ctx context.Context, id int) (*entities.Lesson, error)
+	GetUserIDByTeacherID(ctx context.Context, id int) (int, error)
+	GetStateTransitionHistory(ctx context.Context, itemID int) ([]entities.StateTransitionLogEntry, error)
+
+	GetStateIDByName(ctx context.Context, name entities.StateName) (int, error)
+	GetStateMachineItemByID(ctx context.Context, id int) (*entities.StateMachineItem, error)
+	CheckIsTransitionAvailable(ctx context.Context, stateMachineID, currentStateID, nextStateID int) (bool, error)
 
 	IsUserExistsByID(ctx context.Context, userId int) (bool, error)
-	GetUserByID(ctx context.Context, id int) (*entities.User, error)
+}
+
+// ContentModerator checks user-submitted text before it's saved.
+type ContentModerator interface {
+	ModerateContent(ctx context.Context, content *entities.UserContent) error
+	CheckContent(ctx context.Context, content *entities.UserContent) (*entities.ModerationQueueItem, error)
 }
 
 type ComplaintService struct {
-	repo Repository
+	repo          Repository
+	objectStorage ObjectStorage
+	moderator     ContentModerator
 }
 
-func NewService(repo Repository) *ComplaintService {
+func NewService(repo Repository, objectStorage ObjectStorage, moderator ContentModerator) *ComplaintService {
 	return &ComplaintService{
-		repo: repo,
+		repo:          repo,
+		objectStorage: objectStorage,
+		moderator:     moderator,
 	}
 }
@@ -28,6 +28,15 @@ func (s *LessonService) BookLesson(ctx context.Context, lesson *entities.Lesson)
 		return serviceErrs.ErrorStudentAndTeacherSame
 	}
 
+	// suspended teacher can't get new lessons
+	suspended, err := s.repo.IsUserSuspended(ctx, teacher.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to check teacher suspension: %w", err)
+	}
+	if suspended {
+		return serviceErrs.ErrorTeacherSuspended
+	}
+
 	// is categories exists
 	exists, err := s.repo.IsCategoryExistsByID(ctx, lesson.CategoryID)
 	if err != nil {
@@ -37,6 +46,15 @@ func (s *LessonService) BookLesson(ctx context.Context, lesson *entities.Lesson)
 		return serviceErrs.ErrorCategoryNotFound
 	}
 
+	// is student old enough for category (or has exception)
+	isOldEnough, err := s.repo.IsUserOldEnoughForCategory(ctx, lesson.StudentID, lesson.CategoryID)
+	if err != nil {
+		return fmt.Errorf("failed to check student age for category: %w", err)
+	}
+	if !isOldEnough {
+		return serviceErrs.ErrorStudentTooYoung
+	}
+
 	// is teacher have such ACTIVE skill
 	skill, err := s.repo.GetSkillByTeacherIDAndCategoryID(ctx, lesson.TeacherID, lesson.CategoryID)
 	if err != nil {
@@ -72,7 +90,8 @@ func (s *LessonService) BookLesson(ctx context.Context, lesson *entities.Lesson)
 		lesson.ScheduleTimeID,
 		lesson.StudentID,
 		lesson.TeacherID,
-		lesson.CategoryID); err != nil {
+		lesson.CategoryID,
+		skill.Price); err != nil {
 		// if some another booked faster between check and upd
 		if errors.Is(err, serviceErrs.ErrorNonUniqueData) {
 			return serviceErrs.ErrorLessonTimeBooked
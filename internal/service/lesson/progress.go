@@ -0,0 +1,254 @@
+package lesson
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+)
+
+const (
+	// duration of finished lessons which weren't started/finished through the platform
+	defaultLessonDuration = time.Hour
+
+	week = 7 * 24 * time.Hour
+)
+
+var (
+	lessonsMilestones    = []int{1, 5, 10, 25, 50, 100}
+	hoursMilestones      = []int{10, 25, 50, 100}
+	categoriesMilestones = []int{3, 5}
+	streakMilestones     = []int{4, 12, 26}
+)
+
+// GetStudentProgress returns learning progress of student: timeline of studied hours by weeks (in days range from-to)
+// and for all time streaks, teachers and reached milestones.
+func (s *LessonService) GetStudentProgress(ctx context.Context, userID int, from, to time.Time) (*entities.StudentProgress, error) {
+	if err := s.validateUserExists(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	lessons, err := s.repo.GetStudentFinishedLessons(ctx, userID, defaultLessonDuration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get student finished lessons: %w", err)
+	}
+
+	progress := &entities.StudentProgress{
+		From:       weekStart(from),
+		To:         to,
+		Milestones: buildMilestones(lessons),
+	}
+
+	progress.Weeks = buildWeeks(lessons, progress.From, progress.To)
+	progress.CurrentStreakWeeks, progress.LongestStreakWeeks = countStreaks(lessons, time.Now())
+
+	for _, lesson := range lessons {
+		progress.TotalLessons++
+		progress.TotalMinutes += lesson.DurationMinutes
+	}
+
+	if progress.Teachers, err = s.buildProgressTeachers(ctx, lessons); err != nil {
+		return nil, err
+	}
+
+	return progress, nil
+}
+
+func (s *LessonService) buildProgressTeachers(ctx context.Context,
+	lessons []entities.StudentFinishedLesson) ([]entities.ProgressTeacher, error) {
+	stats := make(map[int]*entities.ProgressTeacher)
+	ids := make(map[int]bool)
+
+	for _, lesson := range lessons {
+		stat, ok := stats[lesson.TeacherID]
+		if !ok {
+			stat = &entities.ProgressTeacher{FirstLesson: lesson.Datetime}
+			stats[lesson.TeacherID] = stat
+			ids[lesson.TeacherID] = true
+		}
+
+		stat.LessonsCount++
+		stat.Minutes += lesson.DurationMinutes
+		stat.LastLesson = lesson.Datetime
+	}
+
+	if len(ids) == 0 {
+		return []entities.ProgressTeacher{}, nil
+	}
+
+	teachers, err := s.repo.GetShortTeacherDatasByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teachers short data: %w", err)
+	}
+
+	result := make([]entities.ProgressTeacher, 0, len(teachers))
+
+	for i := range teachers {
+		stat := stats[teachers[i].TeacherData.ID]
+		stat.Teacher = &teachers[i]
+		result = append(result, *stat)
+	}
+
+	// last worked with teachers first
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].LastLesson.After(result[j].LastLesson)
+	})
+
+	return result, nil
+}
+
+// buildWeeks returns all weeks from first week (its monday) to week of the last day (empty weeks included).
+func buildWeeks(lessons []entities.StudentFinishedLesson, first, last time.Time) []entities.ProgressWeek {
+	weeks := make([]entities.ProgressWeek, 0)
+	indexes := make(map[time.Time]int)
+
+	for start := first; !start.After(last); start = start.Add(week) {
+		indexes[start] = len(weeks)
+		weeks = append(weeks, entities.ProgressWeek{
+			WeekStart:  start,
+			Categories: make([]entities.ProgressCategory, 0),
+		})
+	}
+
+	end := last.AddDate(0, 0, 1) // last day is inclusive
+
+	for _, lesson := range lessons {
+		if lesson.Datetime.Before(first) || !lesson.Datetime.Before(end) {
+			continue
+		}
+
+		w := &weeks[indexes[weekStart(lesson.Datetime)]]
+		w.Minutes += lesson.DurationMinutes
+
+		categoryIndex := -1
+
+		for i := range w.Categories {
+			if w.Categories[i].CategoryID == lesson.CategoryID {
+				categoryIndex = i
+
+				break
+			}
+		}
+
+		if categoryIndex == -1 {
+			categoryIndex = len(w.Categories)
+			w.Categories = append(w.Categories, entities.ProgressCategory{
+				CategoryID:   lesson.CategoryID,
+				CategoryName: lesson.CategoryName,
+			})
+		}
+
+		w.Categories[categoryIndex].LessonsCount++
+		w.Categories[categoryIndex].Minutes += lesson.DurationMinutes
+	}
+
+	return weeks
+}
+
+// countStreaks returns current and longest count of weeks in a row with finished lessons.
+// Current streak isn't broken while current week has no lessons yet.
+func countStreaks(lessons []entities.StudentFinishedLesson, now time.Time) (int, int) {
+	weeksWithLessons := make(map[time.Time]bool)
+
+	longest, run := 0, 0
+
+	var previous time.Time
+
+	// lessons are sorted by time
+	for _, lesson := range lessons {
+		start := weekStart(lesson.Datetime)
+		if weeksWithLessons[start] {
+			continue
+		}
+
+		weeksWithLessons[start] = true
+
+		if !previous.IsZero() && start.Sub(previous) == week {
+			run++
+		} else {
+			run = 1
+		}
+
+		previous = start
+		longest = max(longest, run)
+	}
+
+	current := 0
+	start := weekStart(now)
+
+	if !weeksWithLessons[start] {
+		start = start.Add(-week)
+	}
+
+	for weeksWithLessons[start] {
+		current++
+		start = start.Add(-week)
+	}
+
+	return current, longest
+}
+
+// buildMilestones returns reached milestones in order of reaching.
+func buildMilestones(lessons []entities.StudentFinishedLesson) []entities.ProgressMilestone {
+	milestones := make([]entities.ProgressMilestone, 0)
+
+	reach := func(thresholds []int, previous, current int, code, title string, at time.Time) {
+		for _, threshold := range thresholds {
+			if previous < threshold && current >= threshold {
+				milestones = append(milestones, entities.ProgressMilestone{
+					Code:      fmt.Sprintf("%s_%d", code, threshold),
+					Title:     fmt.Sprintf(title, threshold),
+					ReachedAt: at,
+				})
+			}
+		}
+	}
+
+	categories := make(map[int]bool)
+	minutes, run := 0, 0
+
+	var previousWeek time.Time
+
+	for i, lesson := range lessons {
+		reach(lessonsMilestones, i, i+1, "lessons", "%d lessons finished", lesson.Datetime)
+
+		reach(hoursMilestones, minutes/60, (minutes+lesson.DurationMinutes)/60,
+			"hours", "%d hours studied", lesson.Datetime)
+		minutes += lesson.DurationMinutes
+
+		if !categories[lesson.CategoryID] {
+			categories[lesson.CategoryID] = true
+			reach(categoriesMilestones, len(categories)-1, len(categories),
+				"categories", "%d categories studied", lesson.Datetime)
+		}
+
+		start := weekStart(lesson.Datetime)
+		if start.Equal(previousWeek) {
+			continue
+		}
+
+		newRun := 1
+		if !previousWeek.IsZero() && start.Sub(previousWeek) == week {
+			newRun = run + 1
+		}
+
+		if newRun > run {
+			reach(streakMilestones, run, newRun, "streak_weeks", "%d weeks in a row", lesson.Datetime)
+		}
+
+		run = newRun
+		previousWeek = start
+	}
+
+	return milestones
+}
+
+// weekStart returns monday (UTC) of t's week.
+func weekStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
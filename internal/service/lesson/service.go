@@ -2,6 +2,7 @@ package lesson
 
 import (
 	"context"
+	"time"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
 )
@@ -14,10 +15,12 @@ type Repository interface {
 	ChangeLessonStatus(ctx context.Context, lessonID int, statusID int) error
 
 	GetTeacherByID(ctx context.Context, id int) (*entities.Teacher, error)
+	IsUserSuspended(ctx context.Context, userID int) (bool, error)
 	IsCategoryExistsByID(ctx context.Context, id int) (bool, error)
+	IsUserOldEnoughForCategory(ctx context.Context, userID, categoryID int) (bool, error)
 	GetSkillByTeacherIDAndCategoryID(ctx context.Context, teacherID int, categoryID int) (*entities.Skill, error)
 	GetScheduleTimeByID(ctx context.Context, id int) (*entities.ScheduleTime, error)
-	BookLesson(ctx context.Context, scheduleTimeID, studentID, teacherID, categoryID int) error
+	BookLesson(ctx context.Context, scheduleTimeID, studentID, teacherID, categoryID, price int) error
 
 	GetStateByID(ctx context.Context, id int) (*entities.State, error)
 	GetStateIDByName(ctx context.Context, name entities.StateName) (int, error)
@@ -33,6 +36,9 @@ type Repository interface {
 
 	GetLessonsByTeacherID(ctx context.Context, teacherID int) ([]*entities.Lesson, error)
 	GetLessonsByStudentID(ctx context.Context, studentID int) ([]*entities.Lesson, error)
+
+	GetStudentFinishedLessons(ctx context.Context, studentID int, defaultDuration time.Duration) ([]entities.StudentFinishedLesson, error)
+	GetShortTeacherDatasByIDs(ctx context.Context, teacherIDs map[int]bool) ([]entities.User, error)
 }
 
 type MeetCreator interface {
@@ -0,0 +1,69 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	textmoderation "github.com/LearnShareApp/learn-share-backend/pkg/moderation"
+)
+
+// ModerateContent passes user-submitted text of existing entity through moderation pipeline before it's saved:
+// returns serviceErrs.ErrorContentRejected (wrapped with reasons) if text is rejected,
+// puts it into admin's moderation queue if it's flagged and returns nil if it may be saved.
+func (s *ModerationService) ModerateContent(ctx context.Context, content *entities.UserContent) error {
+	item, err := s.CheckContent(ctx, content)
+	if err != nil {
+		return err
+	}
+
+	if item == nil {
+		return nil
+	}
+
+	if _, err = s.repo.CreateModerationQueueItem(ctx, item); err != nil {
+		return fmt.Errorf("failed to put content into moderation queue: %w", err)
+	}
+
+	return nil
+}
+
+// CheckContent passes user-submitted text through moderation pipeline (with language of request from ctx):
+// returns serviceErrs.ErrorContentRejected (wrapped with reasons) if text is rejected,
+// not saved moderation queue item if it's flagged and nil item if it may be saved.
+// Entity being created saves returned item in its own transaction, so item gets id of entity.
+func (s *ModerationService) CheckContent(ctx context.Context, content *entities.UserContent) (*entities.ModerationQueueItem, error) {
+	if strings.TrimSpace(content.Text) == "" {
+		return nil, nil
+	}
+
+	result, err := s.pipeline.Check(ctx, textmoderation.Text{
+		Language: textmoderation.LanguageFromContext(ctx),
+		Body:     content.Text,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to moderate content: %w", err)
+	}
+
+	switch result.Verdict {
+	case textmoderation.Reject:
+		return nil, fmt.Errorf("%w: %s", serviceErrs.ErrorContentRejected, strings.Join(result.Reasons, ", "))
+	case textmoderation.Flag:
+		item := &entities.ModerationQueueItem{
+			Kind:     content.Kind,
+			EntityID: content.EntityID,
+			Text:     content.Text,
+			Reasons:  result.Reasons,
+		}
+
+		if content.AuthorID != 0 {
+			item.AuthorID = &content.AuthorID
+		}
+
+		return item, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,102 @@
+package moderation
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+const (
+	DefaultModerationQueueLimit = 20
+	MaxModerationQueueLimit     = 100
+)
+
+// GetModerationQueue returns one page of pending flagged texts (oldest first) and cursor of the next page
+// (empty cursor means that it was the last page).
+func (s *ModerationService) GetModerationQueue(ctx context.Context, filter *entities.ModerationQueueFilter, cursor string) ([]*entities.ModerationQueueItem, string, error) {
+	if filter.Limit <= 0 || filter.Limit > MaxModerationQueueLimit {
+		filter.Limit = DefaultModerationQueueLimit
+	}
+
+	if cursor != "" {
+		lastID, err := decodeModerationQueueCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		filter.Cursor = &lastID
+	}
+
+	items, hasMore, err := s.repo.GetModerationQueue(ctx, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get moderation queue: %w", err)
+	}
+
+	if !hasMore || len(items) == 0 {
+		return items, "", nil
+	}
+
+	nextCursor, err := encodeModerationQueueCursor(items[len(items)-1].ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return items, nextCursor, nil
+}
+
+// DecideModerationQueueItem closes pending item of moderation queue with admin's decision.
+func (s *ModerationService) DecideModerationQueueItem(ctx context.Context, adminID, itemID int, decision entities.ModerationDecision, auditEntry *entities.AdminAuditEntry) error {
+	if decision != entities.ModerationApproved && decision != entities.ModerationViolation {
+		return serviceErrs.ErrorUnknownModerationDecision
+	}
+
+	if _, err := s.repo.GetModerationQueueItemByID(ctx, itemID); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorModerationItemNotFound
+		}
+
+		return fmt.Errorf("failed to get moderation queue item: %w", err)
+	}
+
+	if err := s.repo.DecideModerationQueueItem(ctx, itemID, decision, adminID, auditEntry); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorModerationItemDecided
+		}
+
+		return fmt.Errorf("failed to decide moderation queue item: %w", err)
+	}
+
+	return nil
+}
+
+type moderationQueueCursorPayload struct {
+	ItemID int `json:"id"`
+}
+
+func encodeModerationQueueCursor(lastID int) (string, error) {
+	data, err := json.Marshal(moderationQueueCursorPayload{ItemID: lastID})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeModerationQueueCursor(cursor string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, serviceErrs.ErrorInvalidCursor
+	}
+
+	var payload moderationQueueCursorPayload
+	if err = json.Unmarshal(data, &payload); err != nil || payload.ItemID <= 0 {
+		return 0, serviceErrs.ErrorInvalidCursor
+	}
+
+	return payload.ItemID, nil
+}
@@ -0,0 +1,70 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	textmoderation "github.com/LearnShareApp/learn-share-backend/pkg/moderation"
+)
+
+type Repository interface {
+	CreateModerationQueueItem(ctx context.Context, item *entities.ModerationQueueItem) (int, error)
+	GetModerationQueue(ctx context.Context, filter *entities.ModerationQueueFilter) ([]*entities.ModerationQueueItem, bool, error)
+	GetModerationQueueItemByID(ctx context.Context, id int) (*entities.ModerationQueueItem, error)
+	DecideModerationQueueItem(ctx context.Context, id int, decision entities.ModerationDecision, adminID int, auditEntry *entities.AdminAuditEntry) error
+}
+
+// Config contains settings of user content moderation.
+type Config struct {
+	// WordListsDir is a dir with "<language>.reject.txt" and "<language>.flag.txt" word lists, empty disables word lists.
+	WordListsDir string `env:"MODERATION_WORDLISTS_DIR" env-default:""`
+	// ContactsVerdict is a verdict (allow, flag or reject) for texts with links, emails or phone numbers.
+	ContactsVerdict string `env:"MODERATION_CONTACTS_VERDICT" env-default:"flag"`
+}
+
+type ModerationService struct {
+	repo     Repository
+	pipeline *textmoderation.Pipeline
+}
+
+// Option is a function type to configure checkers of ModerationService.
+type Option func(checkers []textmoderation.Checker) []textmoderation.Checker
+
+// WithClassifier adds classifier (ML model, external API) after word lists and contacts checks.
+func WithClassifier(classifier textmoderation.Classifier, flagThreshold, rejectThreshold float64) Option {
+	return func(checkers []textmoderation.Checker) []textmoderation.Checker {
+		return append(checkers, textmoderation.NewClassifierChecker(classifier, flagThreshold, rejectThreshold))
+	}
+}
+
+func NewService(repo Repository, config Config, opts ...Option) (*ModerationService, error) {
+	var checkers []textmoderation.Checker
+
+	if config.WordListsDir != "" {
+		lists, err := textmoderation.LoadWordLists(config.WordListsDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load word lists: %w", err)
+		}
+
+		checkers = append(checkers, textmoderation.NewWordListChecker(lists))
+	}
+
+	contactsVerdict, err := textmoderation.ParseVerdict(config.ContactsVerdict)
+	if err != nil {
+		return nil, err
+	}
+
+	if contactsVerdict != textmoderation.Allow {
+		checkers = append(checkers, textmoderation.NewContactsChecker(contactsVerdict))
+	}
+
+	for _, opt := range opts {
+		checkers = opt(checkers)
+	}
+
+	return &ModerationService{
+		repo:     repo,
+		pipeline: textmoderation.NewPipeline(checkers...),
+	}, nil
+}
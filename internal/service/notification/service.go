@@ -0,0 +1,48 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+type Repository interface {
+	GetNotificationsByUserID(ctx context.Context, userID int, unreadOnly bool) ([]*entities.Notification, error)
+	MarkNotificationRead(ctx context.Context, userID, notificationID int) error
+}
+
+type NotificationService struct {
+	repo Repository
+}
+
+func NewService(repo Repository) *NotificationService {
+	return &NotificationService{
+		repo: repo,
+	}
+}
+
+// GetNotifications returns notifications of user from newest to oldest.
+func (s *NotificationService) GetNotifications(ctx context.Context, userID int, unreadOnly bool) ([]*entities.Notification, error) {
+	notifications, err := s.repo.GetNotificationsByUserID(ctx, userID, unreadOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// MarkNotificationRead marks user's notification as read.
+func (s *NotificationService) MarkNotificationRead(ctx context.Context, userID, notificationID int) error {
+	if err := s.repo.MarkNotificationRead(ctx, userID, notificationID); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorNotificationNotFound
+		}
+
+		return fmt.Errorf("failed to mark notification read: %w", err)
+	}
+
+	return nil
+}
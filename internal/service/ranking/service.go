@@ -0,0 +1,69 @@
+package ranking
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultRefreshInterval = 10 * time.Minute
+	defaultPriorWeight     = 10
+)
+
+type Repository interface {
+	RefreshRankingScores(ctx context.Context, priorWeight float64, halfLife time.Duration) error
+}
+
+// Config contains settings of ranking scores.
+type Config struct {
+	RefreshInterval time.Duration `env:"RANKING_REFRESH_INTERVAL" env-default:"10m"`
+	// PriorWeight is a count of virtual reviews with platform mean rate added to every teacher and skill.
+	PriorWeight float64 `env:"RANKING_PRIOR_WEIGHT" env-default:"10"`
+	// HalfLife is a time after which weight of review halves, zero disables time decay.
+	HalfLife time.Duration `env:"RANKING_HALF_LIFE" env-default:"0"`
+}
+
+// RankingService keeps ranking scores of teachers and skills (Bayesian average of reviews rate)
+// up to date in background (see Run). Unlike raw average rate, score of teacher with a few reviews
+// stays close to platform mean until enough reviews are collected.
+type RankingService struct {
+	repo   Repository
+	config Config
+	log    *zap.Logger
+}
+
+func NewService(repo Repository, config Config, log *zap.Logger) *RankingService {
+	if config.RefreshInterval <= 0 {
+		config.RefreshInterval = defaultRefreshInterval
+	}
+
+	if config.PriorWeight < 0 {
+		config.PriorWeight = defaultPriorWeight
+	}
+
+	return &RankingService{
+		repo:   repo,
+		config: config,
+		log:    log,
+	}
+}
+
+// Run recomputes ranking scores every RefreshInterval until ctx is done.
+func (s *RankingService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.repo.RefreshRankingScores(ctx, s.config.PriorWeight, s.config.HalfLife); err != nil {
+			s.log.Error("failed to refresh ranking scores", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+)
+
+const (
+	DefaultRecommendationsLimit = 10
+	MaxRecommendationsLimit     = 50
+
+	categoryScoreWeight      = 0.4
+	similarStudentsWeight    = 0.3
+	ratingScoreWeight        = 0.2
+	availabilityScoreWeight  = 0.1
+	maxRate                  = 5.0
+	reviewsConfidenceDivisor = 3.0
+)
+
+const (
+	reasonCategory        = "because you studied %s"
+	reasonSimilarStudents = "students with similar lessons studied with this teacher"
+	reasonRating          = "highly rated by students"
+	reasonAvailability    = "has free time in the near future"
+	reasonNewTeacher      = "new teacher on the platform"
+)
+
+type scoredTeacher struct {
+	teacher *teacherInfo
+	score   float64
+	reason  string
+}
+
+// RecommendTeachers returns teachers ranked for student with userID.
+// Teachers with whom the student already finished lessons are not recommended.
+func (s *RecommendationService) RecommendTeachers(ctx context.Context, userID int, limit int) ([]entities.TeacherRecommendation, error) {
+	if limit <= 0 {
+		limit = DefaultRecommendationsLimit
+	}
+
+	limit = min(limit, MaxRecommendationsLimit)
+
+	snap, err := s.getSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scored := snap.rank(userID)
+	if len(scored) > limit {
+		scored = scored[:limit]
+	}
+
+	if len(scored) == 0 {
+		return []entities.TeacherRecommendation{}, nil
+	}
+
+	ids := make(map[int]bool, len(scored))
+	for _, st := range scored {
+		ids[st.teacher.teacherID] = true
+	}
+
+	teachers, err := s.repo.GetShortTeacherDatasByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teachers data: %w", err)
+	}
+
+	teachersByID := make(map[int]*entities.User, len(teachers))
+	for i := range teachers {
+		teachersByID[teachers[i].TeacherData.ID] = &teachers[i]
+	}
+
+	recommendations := make([]entities.TeacherRecommendation, 0, len(scored))
+
+	for _, st := range scored {
+		teacher, ok := teachersByID[st.teacher.teacherID]
+		if !ok { // teacher was deleted after snapshot refresh
+			continue
+		}
+
+		teacher.TeacherData.Rate = float32(st.teacher.rate)
+		teacher.TeacherData.ReviewsCount = st.teacher.reviewsCount
+
+		recommendations = append(recommendations, entities.TeacherRecommendation{
+			Teacher: teacher,
+			Score:   st.score,
+			Reason:  st.reason,
+		})
+	}
+
+	return recommendations, nil
+}
+
+// rank scores all teachers for student and sorts them by score descending.
+func (snap *snapshot) rank(userID int) []scoredTeacher {
+	interests := snap.studentCategories[userID]
+	studied := snap.studentTeachers[userID]
+	similar := snap.similarStudentsTeachers(userID)
+
+	maxInterest := 0.0
+	for _, weight := range interests {
+		maxInterest = max(maxInterest, weight)
+	}
+
+	scored := make([]scoredTeacher, 0, len(snap.teachers))
+
+	for i := range snap.teachers {
+		teacher := &snap.teachers[i]
+
+		if teacher.userID == userID || studied[teacher.teacherID] {
+			continue
+		}
+
+		categoryScore, bestCategoryID := 0.0, 0
+
+		for _, categoryID := range teacher.categoryIDs {
+			if interests[categoryID] > categoryScore {
+				categoryScore, bestCategoryID = interests[categoryID], categoryID
+			}
+		}
+
+		if maxInterest > 0 {
+			categoryScore /= maxInterest
+		}
+
+		similarScore := similar[teacher.teacherID]
+
+		reviews := float64(teacher.reviewsCount)
+		ratingScore := teacher.rate / maxRate * reviews / (reviews + reviewsConfidenceDivisor)
+
+		availabilityScore := 0.0
+		if teacher.hasAvailableTime {
+			availabilityScore = 1
+		}
+
+		parts := []struct {
+			value  float64
+			reason string
+		}{
+			{categoryScoreWeight * categoryScore, fmt.Sprintf(reasonCategory, snap.categoryNames[bestCategoryID])},
+			{similarStudentsWeight * similarScore, reasonSimilarStudents},
+			{ratingScoreWeight * ratingScore, reasonRating},
+			{availabilityScoreWeight * availabilityScore, reasonAvailability},
+		}
+
+		st := scoredTeacher{teacher: teacher, reason: reasonNewTeacher}
+		best := 0.0
+
+		for _, part := range parts {
+			st.score += part.value
+
+			if part.value > best {
+				best, st.reason = part.value, part.reason
+			}
+		}
+
+		scored = append(scored, st)
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		if scored[i].score != scored[j].score {
+			return scored[i].score > scored[j].score
+		}
+
+		return scored[i].teacher.teacherID < scored[j].teacher.teacherID
+	})
+
+	return scored
+}
+
+// similarStudentsTeachers returns normalized (0..1) score of teachers, with whom studied
+// students having common teachers with the student.
+func (snap *snapshot) similarStudentsTeachers(userID int) map[int]float64 {
+	studied := snap.studentTeachers[userID]
+
+	similarity := make(map[int]float64)
+
+	for teacherID := range studied {
+		for studentID := range snap.teacherStudents[teacherID] {
+			if studentID != userID {
+				similarity[studentID]++
+			}
+		}
+	}
+
+	scores := make(map[int]float64)
+	maxScore := 0.0
+
+	for studentID, sim := range similarity {
+		for teacherID := range snap.studentTeachers[studentID] {
+			if studied[teacherID] {
+				continue
+			}
+
+			scores[teacherID] += sim
+			maxScore = max(maxScore, scores[teacherID])
+		}
+	}
+
+	for teacherID := range scores {
+		scores[teacherID] /= maxScore
+	}
+
+	return scores
+}
@@ -0,0 +1,109 @@
+package recommendation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultRefreshInterval = 10 * time.Minute
+	defaultAvailableWithin = 72 * time.Hour
+)
+
+type Repository interface {
+	GetStudentsActivity(ctx context.Context) ([]entities.StudentActivity, error)
+	GetAllReviewsShort(ctx context.Context) ([]entities.Review, error)
+	GetRecommendableTeachers(ctx context.Context, availableWithin time.Duration) ([]entities.RecommendableTeacher, error)
+	GetCategories(ctx context.Context) ([]*entities.Category, error)
+	GetShortTeacherDatasByIDs(ctx context.Context, teacherIDs map[int]bool) ([]entities.User, error)
+}
+
+// Config contains settings of recommendations refreshing.
+type Config struct {
+	RefreshInterval time.Duration `env:"RECOMMENDATIONS_REFRESH_INTERVAL" env-default:"10m"`
+	AvailableWithin time.Duration `env:"RECOMMENDATIONS_AVAILABLE_WITHIN" env-default:"72h"`
+}
+
+// RecommendationService ranks teachers for students.
+// Ranking data is kept in memory and periodically rebuilt from the database (see Run).
+type RecommendationService struct {
+	repo   Repository
+	config Config
+	log    *zap.Logger
+
+	mu       sync.RWMutex
+	snapshot *snapshot
+}
+
+func NewService(repo Repository, config Config, log *zap.Logger) *RecommendationService {
+	if config.RefreshInterval <= 0 {
+		config.RefreshInterval = defaultRefreshInterval
+	}
+
+	if config.AvailableWithin <= 0 {
+		config.AvailableWithin = defaultAvailableWithin
+	}
+
+	return &RecommendationService{
+		repo:   repo,
+		config: config,
+		log:    log,
+	}
+}
+
+// Run rebuilds recommendations snapshot every RefreshInterval until ctx is done.
+func (s *RecommendationService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Refresh(ctx); err != nil {
+			s.log.Error("failed to refresh recommendations", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh rebuilds recommendations snapshot immediately.
+func (s *RecommendationService) Refresh(ctx context.Context) error {
+	snap, err := s.buildSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.snapshot = snap
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *RecommendationService) getSnapshot(ctx context.Context) (*snapshot, error) {
+	s.mu.RLock()
+	snap := s.snapshot
+	s.mu.RUnlock()
+
+	if snap != nil {
+		return snap, nil
+	}
+
+	// first request before background refresh finished
+	if err := s.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.snapshot, nil
+}
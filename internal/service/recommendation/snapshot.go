@@ -0,0 +1,118 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	bookedLessonWeight   = 1.0
+	finishedLessonWeight = 2.0
+	reviewWeight         = 1.0
+)
+
+type teacherInfo struct {
+	teacherID        int
+	userID           int
+	rate             float64
+	reviewsCount     int
+	categoryIDs      []int
+	hasAvailableTime bool
+}
+
+// snapshot is immutable in-memory data for ranking, built from lessons, reviews and skills.
+type snapshot struct {
+	// student user id -> category id -> interest weight
+	studentCategories map[int]map[int]float64
+	// student user id -> teachers ids with finished lessons
+	studentTeachers map[int]map[int]bool
+	// teacher id -> students user ids with finished lessons
+	teacherStudents map[int]map[int]bool
+
+	teachers      []teacherInfo
+	categoryNames map[int]string
+}
+
+func (s *RecommendationService) buildSnapshot(ctx context.Context) (*snapshot, error) {
+	activity, err := s.repo.GetStudentsActivity(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get students activity: %w", err)
+	}
+
+	reviews, err := s.repo.GetAllReviewsShort(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviews: %w", err)
+	}
+
+	teachers, err := s.repo.GetRecommendableTeachers(ctx, s.config.AvailableWithin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recommendable teachers: %w", err)
+	}
+
+	categories, err := s.repo.GetCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	snap := &snapshot{
+		studentCategories: make(map[int]map[int]float64),
+		studentTeachers:   make(map[int]map[int]bool),
+		teacherStudents:   make(map[int]map[int]bool),
+		teachers:          make([]teacherInfo, 0, len(teachers)),
+		categoryNames:     make(map[int]string, len(categories)),
+	}
+
+	for _, category := range categories {
+		snap.categoryNames[category.ID] = category.Name
+	}
+
+	for _, a := range activity {
+		weight := bookedLessonWeight
+
+		if a.IsFinished {
+			weight = finishedLessonWeight
+
+			addToSet(snap.studentTeachers, a.StudentID, a.TeacherID)
+			addToSet(snap.teacherStudents, a.TeacherID, a.StudentID)
+		}
+
+		snap.addInterest(a.StudentID, a.CategoryID, weight)
+	}
+
+	for _, review := range reviews {
+		snap.addInterest(review.StudentID, review.CategoryID, reviewWeight)
+	}
+
+	for _, t := range teachers {
+		snap.teachers = append(snap.teachers, teacherInfo{
+			teacherID:        t.TeacherID,
+			userID:           t.UserID,
+			rate:             float64(t.Rate),
+			reviewsCount:     t.ReviewsCount,
+			categoryIDs:      t.CategoryIDs,
+			hasAvailableTime: t.HasAvailableTime,
+		})
+	}
+
+	return snap, nil
+}
+
+func (snap *snapshot) addInterest(studentID, categoryID int, weight float64) {
+	interests, ok := snap.studentCategories[studentID]
+	if !ok {
+		interests = make(map[int]float64)
+		snap.studentCategories[studentID] = interests
+	}
+
+	interests[categoryID] += weight
+}
+
+func addToSet(sets map[int]map[int]bool, key, value int) {
+	set, ok := sets[key]
+	if !ok {
+		set = make(map[int]bool)
+		sets[key] = set
+	}
+
+	set[value] = true
+}
@@ -4,18 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
 	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
 )
 
-// CreateReview create new review about teacher
+// CreateReview create new review about teacher's lesson
 // Firstly check:
 // - is such user (student) exists
-// - is this teacher exists
-// - is user (student) and teacher the different user
-// - is category exists
-// - is teacher has such skill
-// - is user (student) have had finished lesson with this teacher on this category
+// - is this lesson exists and user (student) is its student
+// - is lesson finished
+// - is teacher has skill of lesson's category
+// Teacher and category of review are taken from lesson.
 func (s *ReviewService) CreateReview(ctx context.Context, review *entities.Review) error {
 	// is user exists
 	err := s.validateUserExists(ctx, review.StudentID)
@@ -23,33 +24,36 @@ func (s *ReviewService) CreateReview(ctx context.Context, review *entities.Revie
 		return err
 	}
 
-	// get teacher
-	teacher, err := s.repo.GetTeacherByID(ctx, review.TeacherID)
+	// get lesson
+	lesson, err := s.repo.GetLessonByID(ctx, *review.LessonID)
 	if err != nil {
 		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
-			return serviceErrs.ErrorTeacherNotFound
+			return serviceErrs.ErrorLessonNotFound
 		}
 
-		return fmt.Errorf("failed to get teacher by id: %w", err)
+		return fmt.Errorf("failed to get lesson by id: %w", err)
 	}
 
-	// is teacher != student
-	if teacher.UserID == review.StudentID {
-		return serviceErrs.ErrorStudentAndTeacherSame
+	// is user student of lesson
+	if lesson.StudentID != review.StudentID {
+		return serviceErrs.ErrorNotRelatedUserToLesson
 	}
 
-	// is category exists
-	exists, err := s.repo.IsCategoryExistsByID(ctx, review.CategoryID)
+	// is lesson finished
+	stateMachineItem, err := s.repo.GetStateMachineItemByID(ctx, lesson.StateMachineItemID)
 	if err != nil {
-		return fmt.Errorf("failed to check category existstance by id: %w", err)
+		return fmt.Errorf("failed to get lesson's state: %w", err)
 	}
 
-	if !exists {
-		return serviceErrs.ErrorCategoryNotFound
+	if !slices.Contains(entities.FinishedLessonStates, entities.StateName(stateMachineItem.StateName)) {
+		return serviceErrs.ErrorLessonNotFinished
 	}
 
+	review.TeacherID = lesson.TeacherID
+	review.CategoryID = lesson.CategoryID
+
 	// is teacher have such ACTIVE skill
-	skill, err := s.repo.GetSkillByTeacherIDAndCategoryID(ctx, teacher.ID, review.CategoryID)
+	skill, err := s.repo.GetSkillByTeacherIDAndCategoryID(ctx, lesson.TeacherID, lesson.CategoryID)
 	if err != nil {
 		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
 			return serviceErrs.ErrorSkillUnregistered
@@ -59,18 +63,17 @@ func (s *ReviewService) CreateReview(ctx context.Context, review *entities.Revie
 
 	review.SkillID = skill.ID
 
-	// is student has finished lesson with this teacher and this category
-	exists, err = s.repo.IsLessonExistsByArgs(ctx, review.TeacherID, review.StudentID, review.CategoryID, entities.FinishedStatusName)
+	moderationItem, err := s.moderator.CheckContent(ctx, &entities.UserContent{
+		Kind:     entities.ContentReview,
+		AuthorID: review.StudentID,
+		Text:     review.Comment,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to check finished lesson existence by teacher id, student id and category id: %w", err)
-	}
-
-	if !exists {
-		return serviceErrs.ErrorFinishedLessonNotFound
+		return err
 	}
 
 	// create review
-	if err = s.repo.CreateReview(ctx, review); err != nil {
+	if err = s.repo.CreateReview(ctx, review, moderationItem); err != nil {
 		if errors.Is(err, serviceErrs.ErrorNonUniqueData) {
 			return serviceErrs.ErrorReviewExists
 		}
@@ -0,0 +1,89 @@
+package review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// ReviewEditWindow is a time since creation when student can edit or delete his review.
+const ReviewEditWindow = 14 * 24 * time.Hour
+
+// UpdateReview changes rate and comment of student's own review.
+func (s *ReviewService) UpdateReview(ctx context.Context, userID, reviewID, rate int, comment string) error {
+	if _, err := s.getEditableReview(ctx, userID, reviewID); err != nil {
+		return err
+	}
+
+	if err := s.moderator.ModerateContent(ctx, &entities.UserContent{
+		Kind:     entities.ContentReview,
+		AuthorID: userID,
+		EntityID: &reviewID,
+		Text:     comment,
+	}); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateReview(ctx, reviewID, rate, comment); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorReviewNotFound
+		}
+
+		return fmt.Errorf("failed to update review: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteReview deletes student's own review.
+func (s *ReviewService) DeleteReview(ctx context.Context, userID, reviewID int) error {
+	if _, err := s.getEditableReview(ctx, userID, reviewID); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteReviewByID(ctx, reviewID); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorReviewNotFound
+		}
+
+		return fmt.Errorf("failed to delete review: %w", err)
+	}
+
+	return nil
+}
+
+// RepairRatingAggregates recomputes ratings of all skills and teachers from reviews and returns fixed drifts.
+func (s *ReviewService) RepairRatingAggregates(ctx context.Context, auditEntry *entities.AdminAuditEntry) ([]entities.RatingDrift, error) {
+	drifts, err := s.repo.RepairRatingAggregates(ctx, auditEntry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to repair rating aggregates: %w", err)
+	}
+
+	return drifts, nil
+}
+
+// getEditableReview returns review if it belongs to user and its edit window isn't expired.
+func (s *ReviewService) getEditableReview(ctx context.Context, userID, reviewID int) (*entities.Review, error) {
+	review, err := s.repo.GetReviewByID(ctx, reviewID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, serviceErrs.ErrorReviewNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get review by id: %w", err)
+	}
+
+	if review.StudentID != userID {
+		return nil, serviceErrs.ErrorNotReviewAuthor
+	}
+
+	if time.Since(review.CreatedAt) > ReviewEditWindow {
+		return nil, serviceErrs.ErrorReviewEditWindowExpired
+	}
+
+	return review, nil
+}
@@ -0,0 +1,129 @@
+package review
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+const (
+	DefaultReviewListLimit = 20
+	MaxReviewListLimit     = 100
+)
+
+// GetReviews returns one page of reviews about the teacher and cursor of the next page
+// (empty cursor means that it was the last page).
+func (s *ReviewService) GetReviews(ctx context.Context, filter *entities.ReviewListFilter, cursor string) ([]*entities.Review, string, error) {
+	if err := s.validateTeacherExists(ctx, filter.TeacherID); err != nil {
+		return nil, "", err
+	}
+
+	if filter.Limit <= 0 || filter.Limit > MaxReviewListLimit {
+		filter.Limit = DefaultReviewListLimit
+	}
+
+	if filter.SortBy == "" {
+		filter.SortBy = entities.ReviewSortByNewest
+	}
+
+	if cursor != "" {
+		decoded, err := decodeReviewListCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// cursor is valid only for the same ordering
+		if decoded.SortBy != filter.SortBy {
+			return nil, "", serviceErrs.ErrorInvalidCursor
+		}
+
+		filter.Cursor = decoded
+	}
+
+	reviews, hasMore, err := s.repo.GetReviewsFiltered(ctx, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get reviews by teacher id: %w", err)
+	}
+
+	if !hasMore || len(reviews) == 0 {
+		return reviews, "", nil
+	}
+
+	last := reviews[len(reviews)-1]
+
+	nextCursor, err := encodeReviewListCursor(&entities.ReviewListCursor{
+		SortBy:    filter.SortBy,
+		Rate:      last.Rate,
+		CreatedAt: last.CreatedAt,
+		ReviewID:  last.ID,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return reviews, nextCursor, nil
+}
+
+// GetReviewSummary returns rates distribution and per-category averages of reviews about the teacher.
+func (s *ReviewService) GetReviewSummary(ctx context.Context, teacherID int) (*entities.ReviewSummary, error) {
+	if err := s.validateTeacherExists(ctx, teacherID); err != nil {
+		return nil, err
+	}
+
+	summary, err := s.repo.GetTeacherReviewSummary(ctx, teacherID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review summary: %w", err)
+	}
+
+	return summary, nil
+}
+
+func (s *ReviewService) validateTeacherExists(ctx context.Context, teacherID int) error {
+	exists, err := s.repo.IsTeacherExistsById(ctx, teacherID)
+	if err != nil {
+		return fmt.Errorf("failed to check teacher existence: %w", err)
+	}
+
+	if !exists {
+		return serviceErrs.ErrorTeacherNotFound
+	}
+
+	return nil
+}
+
+type reviewListCursorPayload struct {
+	SortBy    entities.ReviewSortField `json:"s"`
+	Rate      int                      `json:"r"`
+	CreatedAt time.Time                `json:"t"`
+	ReviewID  int                      `json:"id"`
+}
+
+func encodeReviewListCursor(cursor *entities.ReviewListCursor) (string, error) {
+	data, err := json.Marshal(reviewListCursorPayload(*cursor))
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeReviewListCursor(cursor string) (*entities.ReviewListCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, serviceErrs.ErrorInvalidCursor
+	}
+
+	var payload reviewListCursorPayload
+	if err = json.Unmarshal(data, &payload); err != nil || payload.ReviewID <= 0 {
+		return nil, serviceErrs.ErrorInvalidCursor
+	}
+
+	decoded := entities.ReviewListCursor(payload)
+
+	return &decoded, nil
+}
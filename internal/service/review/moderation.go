@@ -0,0 +1,134 @@
+package review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// moderationNotificationTypes are notification types for author about moderation action by target.
+var moderationNotificationTypes = map[entities.ReviewReportTarget]map[entities.ReviewModerationAction]entities.NotificationType{
+	entities.ReviewReportTargetReview: {
+		entities.ReviewModerationHide:    entities.NotificationReviewHidden,
+		entities.ReviewModerationRestore: entities.NotificationReviewRestored,
+		entities.ReviewModerationDelete:  entities.NotificationReviewDeleted,
+	},
+	entities.ReviewReportTargetReply: {
+		entities.ReviewModerationHide:    entities.NotificationReviewReplyHidden,
+		entities.ReviewModerationRestore: entities.NotificationReviewReplyRestored,
+		entities.ReviewModerationDelete:  entities.NotificationReviewReplyDeleted,
+	},
+}
+
+// ReportReview reports review or teacher's reply to it for moderation.
+func (s *ReviewService) ReportReview(ctx context.Context, report *entities.ReviewReport) error {
+	authorID, _, err := s.getModerationTarget(ctx, report.ReviewID, report.Target)
+	if err != nil {
+		return err
+	}
+
+	if authorID == report.ReporterID {
+		return serviceErrs.ErrorReportOwnReview
+	}
+
+	moderationItem, err := s.moderator.CheckContent(ctx, &entities.UserContent{
+		Kind:     entities.ContentReviewReport,
+		AuthorID: report.ReporterID,
+		Text:     report.Reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = s.repo.CreateReviewReport(ctx, report, moderationItem); err != nil {
+		if errors.Is(err, serviceErrs.ErrorNonUniqueData) {
+			return serviceErrs.ErrorReviewAlreadyReported
+		}
+
+		return fmt.Errorf("failed to create review report: %w", err)
+	}
+
+	return nil
+}
+
+// GetReviewModerationQueue returns reviews and replies with pending reports.
+func (s *ReviewService) GetReviewModerationQueue(ctx context.Context) ([]*entities.ReviewModerationItem, error) {
+	items, err := s.repo.GetReviewModerationQueue(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get moderation queue: %w", err)
+	}
+
+	return items, nil
+}
+
+// ModerateReview hides, restores or deletes review or reply, resolves its reports and notifies author
+// (hide and restore of already hidden or visible one only resolve reports).
+func (s *ReviewService) ModerateReview(ctx context.Context, moderation *entities.ReviewModeration, auditEntry *entities.AdminAuditEntry) error {
+	authorID, isHidden, err := s.getModerationTarget(ctx, moderation.ReviewID, moderation.Target)
+	if err != nil {
+		return err
+	}
+
+	var notification *entities.Notification
+
+	isChanged := moderation.Action == entities.ReviewModerationDelete ||
+		isHidden != (moderation.Action == entities.ReviewModerationHide)
+
+	if isChanged {
+		notification = &entities.Notification{
+			UserID:   authorID,
+			Type:     moderationNotificationTypes[moderation.Target][moderation.Action],
+			EntityID: &moderation.ReviewID,
+			Message:  &moderation.Reason,
+		}
+	}
+
+	if err = s.repo.ModerateReview(ctx, moderation, notification, auditEntry); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			if moderation.Target == entities.ReviewReportTargetReply {
+				return serviceErrs.ErrorReviewReplyNotFound
+			}
+
+			return serviceErrs.ErrorReviewNotFound
+		}
+
+		return fmt.Errorf("failed to moderate review: %w", err)
+	}
+
+	return nil
+}
+
+// getModerationTarget returns author (user id) of review or reply and whether it's hidden.
+func (s *ReviewService) getModerationTarget(ctx context.Context, reviewID int, target entities.ReviewReportTarget) (int, bool, error) {
+	review, err := s.repo.GetReviewByID(ctx, reviewID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return 0, false, serviceErrs.ErrorReviewNotFound
+		}
+
+		return 0, false, fmt.Errorf("failed to get review by id: %w", err)
+	}
+
+	if target == entities.ReviewReportTargetReview {
+		return review.StudentID, review.IsHidden, nil
+	}
+
+	reply, err := s.repo.GetReviewReply(ctx, reviewID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return 0, false, serviceErrs.ErrorReviewReplyNotFound
+		}
+
+		return 0, false, fmt.Errorf("failed to get review reply: %w", err)
+	}
+
+	authorID, err := s.repo.GetUserIDByTeacherID(ctx, reply.TeacherID)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get user id of teacher: %w", err)
+	}
+
+	return authorID, reply.IsHidden, nil
+}
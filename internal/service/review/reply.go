@@ -0,0 +1,109 @@
+package review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// CreateReviewReply adds teacher's reply to review about him and notifies review author.
+func (s *ReviewService) CreateReviewReply(ctx context.Context, userID, reviewID int, comment string) error {
+	review, err := s.getRepliableReview(ctx, userID, reviewID)
+	if err != nil {
+		return err
+	}
+
+	if err = s.moderateReply(ctx, userID, review.ID, comment); err != nil {
+		return err
+	}
+
+	reply := &entities.ReviewReply{
+		ReviewID:  review.ID,
+		TeacherID: review.TeacherID,
+		Comment:   comment,
+	}
+
+	notification := &entities.Notification{
+		UserID:   review.StudentID,
+		Type:     entities.NotificationReviewReplied,
+		EntityID: &review.ID,
+	}
+
+	if err = s.repo.CreateReviewReply(ctx, reply, notification); err != nil {
+		if errors.Is(err, serviceErrs.ErrorNonUniqueData) {
+			return serviceErrs.ErrorReviewReplyExists
+		}
+
+		return fmt.Errorf("failed to create review reply: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateReviewReply changes teacher's reply to review about him and notifies review author.
+func (s *ReviewService) UpdateReviewReply(ctx context.Context, userID, reviewID int, comment string) error {
+	review, err := s.getRepliableReview(ctx, userID, reviewID)
+	if err != nil {
+		return err
+	}
+
+	if err = s.moderateReply(ctx, userID, review.ID, comment); err != nil {
+		return err
+	}
+
+	notification := &entities.Notification{
+		UserID:   review.StudentID,
+		Type:     entities.NotificationReviewReplyUpdated,
+		EntityID: &review.ID,
+	}
+
+	if err = s.repo.UpdateReviewReply(ctx, review.ID, comment, notification); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorReviewReplyNotFound
+		}
+
+		return fmt.Errorf("failed to update review reply: %w", err)
+	}
+
+	return nil
+}
+
+// getRepliableReview returns review if it's about teacher of user.
+func (s *ReviewService) getRepliableReview(ctx context.Context, userID, reviewID int) (*entities.Review, error) {
+	teacher, err := s.repo.GetTeacherByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, serviceErrs.ErrorUserIsNotTeacher
+		}
+
+		return nil, fmt.Errorf("failed to get teacher by user id: %w", err)
+	}
+
+	review, err := s.repo.GetReviewByID(ctx, reviewID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, serviceErrs.ErrorReviewNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get review by id: %w", err)
+	}
+
+	if review.TeacherID != teacher.ID {
+		return nil, serviceErrs.ErrorNotReviewedTeacher
+	}
+
+	return review, nil
+}
+
+// moderateReply checks teacher's reply, reply is identified by its review.
+func (s *ReviewService) moderateReply(ctx context.Context, userID, reviewID int, comment string) error {
+	return s.moderator.ModerateContent(ctx, &entities.UserContent{
+		Kind:     entities.ContentReviewReply,
+		AuthorID: userID,
+		EntityID: &reviewID,
+		Text:     comment,
+	})
+}
@@ -3,47 +3,72 @@ package review
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
-	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
 )
 
 type Repository interface {
 	IsUserExistsByID(ctx context.Context, userId int) (bool, error)
-	GetTeacherByID(ctx context.Context, teacherId int) (*entities.Teacher, error)
-	IsCategoryExistsByID(ctx context.Context, categoryID int) (bool, error)
+	GetLessonByID(ctx context.Context, id int) (*entities.Lesson, error)
+	GetStateMachineItemByID(ctx context.Context, id int) (*entities.StateMachineItem, error)
 	GetSkillByTeacherIDAndCategoryID(ctx context.Context, teacherID int, categoryID int) (*entities.Skill, error)
-	IsLessonExistsByArgs(ctx context.Context, teacherID int, studentID int, categoryID int, stateName entities.StateName) (bool, error)
-	CreateReview(ctx context.Context, review *entities.Review) error
+	CreateReview(ctx context.Context, review *entities.Review, moderationItem *entities.ModerationQueueItem) error
+	GetTeacherWeightedRate(ctx context.Context, teacherID int, halfLife time.Duration) (float64, error)
 	IsTeacherExistsById(ctx context.Context, teacherID int) (bool, error)
-	GetReviewsByTeacherId(ctx context.Context, teacherID int) ([]*entities.Review, error)
+	GetReviewsFiltered(ctx context.Context, filter *entities.ReviewListFilter) ([]*entities.Review, bool, error)
+	GetTeacherReviewSummary(ctx context.Context, teacherID int) (*entities.ReviewSummary, error)
+	GetReviewByID(ctx context.Context, id int) (*entities.Review, error)
+	UpdateReview(ctx context.Context, id, rate int, comment string) error
+	DeleteReviewByID(ctx context.Context, id int) error
+	RepairRatingAggregates(ctx context.Context, auditEntry *entities.AdminAuditEntry) ([]entities.RatingDrift, error)
+	GetTeacherByUserID(ctx context.Context, id int) (*entities.Teacher, error)
+	GetReviewReply(ctx context.Context, reviewID int) (*entities.ReviewReply, error)
+	CreateReviewReply(ctx context.Context, reply *entities.ReviewReply, notification *entities.Notification) error
+	UpdateReviewReply(ctx context.Context, reviewID int, comment string, notification *entities.Notification) error
+	GetUserIDByTeacherID(ctx context.Context, id int) (int, error)
+	CreateReviewReport(ctx context.Context, report *entities.ReviewReport, moderationItem *entities.ModerationQueueItem) error
+	GetReviewModerationQueue(ctx context.Context) ([]*entities.ReviewModerationItem, error)
+	ModerateReview(ctx context.Context, moderation *entities.ReviewModeration, notification *entities.Notification, auditEntry *entities.AdminAuditEntry) error
+}
+
+// ContentModerator checks user-submitted text before it's saved.
+type ContentModerator interface {
+	ModerateContent(ctx context.Context, content *entities.UserContent) error
+	CheckContent(ctx context.Context, content *entities.UserContent) (*entities.ModerationQueueItem, error)
+}
+
+// Config contains settings of reviews.
+type Config struct {
+	// RatingHalfLife is a time after which weight of review in weighted teacher rate halves, zero disables weighting.
+	RatingHalfLife time.Duration `env:"REVIEW_RATING_HALF_LIFE" env-default:"0"`
 }
 
 type ReviewService struct {
-	repo Repository
+	repo      Repository
+	moderator ContentModerator
+	config    Config
 }
 
-func NewService(repo Repository) *ReviewService {
+func NewService(repo Repository, moderator ContentModerator, config Config) *ReviewService {
 	return &ReviewService{
-		repo: repo,
+		repo:      repo,
+		moderator: moderator,
+		config:    config,
 	}
 }
 
-// GetReviews returns all reviews about the teacher.
-func (s *ReviewService) GetReviews(ctx context.Context, teacherId int) ([]*entities.Review, error) {
-	exists, err := s.repo.IsTeacherExistsById(ctx, teacherId)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check teacher existence: %w", err)
-	}
-
-	if !exists {
-		return nil, serviceErrs.ErrorTeacherNotFound
+// GetWeightedRate returns teacher rate where reviews of recent lessons weigh more,
+// nil if weighting is disabled.
+func (s *ReviewService) GetWeightedRate(ctx context.Context, teacherID int) (*float64, error) {
+	if s.config.RatingHalfLife <= 0 {
+		return nil, nil
 	}
 
-	reviews, err := s.repo.GetReviewsByTeacherId(ctx, teacherId)
+	rate, err := s.repo.GetTeacherWeightedRate(ctx, teacherID, s.config.RatingHalfLife)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get reviews by teacher id: %w", err)
+		return nil, fmt.Errorf("failed to get weighted rate: %w", err)
 	}
 
-	return reviews, nil
+	return &rate, nil
 }
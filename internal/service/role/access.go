@@ -0,0 +1,97 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// GetUserAccess returns roles of user and permissions given by them (both empty for not admins).
+func (s *RoleService) GetUserAccess(ctx context.Context, userID int) (*entities.UserAccess, error) {
+	access, err := s.repo.GetUserAccess(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user access: %w", err)
+	}
+
+	return access, nil
+}
+
+// GetRoles returns all roles with their permissions.
+func (s *RoleService) GetRoles(ctx context.Context) ([]*entities.Role, error) {
+	roles, err := s.repo.GetRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles: %w", err)
+	}
+
+	return roles, nil
+}
+
+// AssignRole gives role to user on behalf of admin.
+func (s *RoleService) AssignRole(ctx context.Context, adminID, userID int, roleName entities.RoleName, auditEntry *entities.AdminAuditEntry) error {
+	exists, err := s.repo.IsUserExistsByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to check user existence by id: %w", err)
+	}
+
+	if !exists {
+		return serviceErrs.ErrorUserNotFound
+	}
+
+	role, err := s.getRole(ctx, roleName)
+	if err != nil {
+		return err
+	}
+
+	userRole := &entities.UserRole{
+		UserID:    userID,
+		RoleID:    role.ID,
+		GrantedBy: &adminID,
+	}
+
+	if err = s.repo.AssignUserRole(ctx, userRole, auditEntry); err != nil {
+		if errors.Is(err, serviceErrs.ErrorNonUniqueData) {
+			return serviceErrs.ErrorRoleAlreadyAssigned
+		}
+
+		return fmt.Errorf("failed to assign role: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeRole takes role from user, the last superadmin keeps his role.
+func (s *RoleService) RevokeRole(ctx context.Context, userID int, roleName entities.RoleName, auditEntry *entities.AdminAuditEntry) error {
+	role, err := s.getRole(ctx, roleName)
+	if err != nil {
+		return err
+	}
+
+	if err = s.repo.RevokeUserRole(ctx, userID, role, auditEntry); err != nil {
+		switch {
+		case errors.Is(err, serviceErrs.ErrorSelectEmpty):
+			return serviceErrs.ErrorRoleNotAssigned
+		case errors.Is(err, serviceErrs.ErrorLastSuperadmin):
+			return serviceErrs.ErrorLastSuperadmin
+		default:
+			return fmt.Errorf("failed to revoke role: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *RoleService) getRole(ctx context.Context, name entities.RoleName) (*entities.Role, error) {
+	role, err := s.repo.GetRoleByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, serviceErrs.ErrorRoleNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get role by name: %w", err)
+	}
+
+	return role, nil
+}
@@ -0,0 +1,26 @@
+package role
+
+import (
+	"context"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+)
+
+type Repository interface {
+	IsUserExistsByID(ctx context.Context, id int) (bool, error)
+	GetRoles(ctx context.Context) ([]*entities.Role, error)
+	GetRoleByName(ctx context.Context, name entities.RoleName) (*entities.Role, error)
+	GetUserAccess(ctx context.Context, userID int) (*entities.UserAccess, error)
+	AssignUserRole(ctx context.Context, userRole *entities.UserRole, auditEntry *entities.AdminAuditEntry) error
+	RevokeUserRole(ctx context.Context, userID int, role *entities.Role, auditEntry *entities.AdminAuditEntry) error
+}
+
+type RoleService struct {
+	repo Repository
+}
+
+func NewService(repo Repository) *RoleService {
+	return &RoleService{
+		repo: repo,
+	}
+}
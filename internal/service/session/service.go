@@ -0,0 +1,43 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+)
+
+type Repository interface {
+	CreateSession(ctx context.Context, session *entities.UserSession, token *entities.RefreshToken) (int, error)
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, usedTokenID int, token *entities.RefreshToken) error
+	RevokeSession(ctx context.Context, sessionID int) error
+	RevokeUserSessions(ctx context.Context, userID int) error
+	IsSessionActive(ctx context.Context, sessionID, userID int) (bool, error)
+	IsUserSuspended(ctx context.Context, userID int) (bool, error)
+}
+
+// AccessTokenGenerator issues short-lived access tokens bound to session.
+type AccessTokenGenerator interface {
+	GenerateJWTToken(userID, sessionID int) (string, time.Time, error)
+}
+
+// Config contains settings of login sessions.
+type Config struct {
+	// RefreshTTL is a lifetime of refresh token, session ends if it isn't refreshed for this time.
+	RefreshTTL time.Duration `env:"JWT_REFRESH_TTL" env-default:"720h"`
+}
+
+type SessionService struct {
+	repo   Repository
+	tokens AccessTokenGenerator
+	config Config
+}
+
+func NewService(repo Repository, tokens AccessTokenGenerator, config Config) *SessionService {
+	return &SessionService{
+		repo:   repo,
+		tokens: tokens,
+		config: config,
+	}
+}
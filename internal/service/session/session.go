@@ -0,0 +1,180 @@
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+const refreshTokenBytes = 32
+
+// StartSession creates new session of user (on login or registration) and gives its first tokens.
+func (s *SessionService) StartSession(ctx context.Context, userID int) (*entities.AuthTokens, error) {
+	refreshToken, token, err := s.newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	session := &entities.UserSession{
+		UserID:    userID,
+		ExpiresAt: token.ExpiresAt,
+	}
+
+	sessionID, err := s.repo.CreateSession(ctx, session, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return s.authTokens(userID, sessionID, refreshToken, token.ExpiresAt)
+}
+
+// RefreshSession exchanges refresh token for new access and refresh tokens, refresh token can be used only once.
+// Using already used refresh token means it has been stolen, so its session is revoked.
+func (s *SessionService) RefreshSession(ctx context.Context, refreshToken string) (*entities.AuthTokens, error) {
+	usedToken, err := s.getRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if usedToken.SessionRevokedAt != nil {
+		return nil, serviceErrs.ErrorSessionRevoked
+	}
+
+	if usedToken.UsedAt != nil {
+		return nil, s.revokeReusedSession(ctx, usedToken.SessionID)
+	}
+
+	if !usedToken.ExpiresAt.After(time.Now()) {
+		return nil, serviceErrs.ErrorRefreshTokenExpired
+	}
+
+	suspended, err := s.repo.IsUserSuspended(ctx, usedToken.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user suspension: %w", err)
+	}
+
+	if suspended {
+		return nil, serviceErrs.ErrorUserSuspended
+	}
+
+	newRefreshToken, token, err := s.newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	token.SessionID = usedToken.SessionID
+
+	if err = s.repo.RotateRefreshToken(ctx, usedToken.ID, token); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			// token was used by concurrent request or session was revoked meanwhile
+			return nil, s.revokeReusedSession(ctx, usedToken.SessionID)
+		}
+
+		return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+
+	return s.authTokens(usedToken.UserID, usedToken.SessionID, newRefreshToken, token.ExpiresAt)
+}
+
+// Logout revokes session of refresh token (any token of session, even used or expired one, fits),
+// or all sessions of its user if allSessions is set, then only valid refresh token is accepted.
+func (s *SessionService) Logout(ctx context.Context, refreshToken string, allSessions bool) error {
+	token, err := s.getRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+
+	if !allSessions {
+		if err = s.repo.RevokeSession(ctx, token.SessionID); err != nil {
+			return fmt.Errorf("failed to revoke session: %w", err)
+		}
+
+		return nil
+	}
+
+	if token.SessionRevokedAt != nil || token.UsedAt != nil || !token.ExpiresAt.After(time.Now()) {
+		return serviceErrs.ErrorRefreshTokenInvalid
+	}
+
+	if err = s.repo.RevokeUserSessions(ctx, token.UserID); err != nil {
+		return fmt.Errorf("failed to revoke user sessions: %w", err)
+	}
+
+	return nil
+}
+
+// IsSessionActive checks that session of access token is neither revoked (by logout or refresh token reuse) nor expired.
+func (s *SessionService) IsSessionActive(ctx context.Context, sessionID, userID int) (bool, error) {
+	active, err := s.repo.IsSessionActive(ctx, sessionID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check session: %w", err)
+	}
+
+	return active, nil
+}
+
+func (s *SessionService) getRefreshToken(ctx context.Context, refreshToken string) (*entities.RefreshToken, error) {
+	token, err := s.repo.GetRefreshTokenByHash(ctx, hashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, serviceErrs.ErrorRefreshTokenInvalid
+		}
+
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (s *SessionService) revokeReusedSession(ctx context.Context, sessionID int) error {
+	if err := s.repo.RevokeSession(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to revoke session of reused refresh token: %w", err)
+	}
+
+	return serviceErrs.ErrorRefreshTokenReused
+}
+
+func (s *SessionService) authTokens(userID, sessionID int, refreshToken string,
+	refreshExpiresAt time.Time) (*entities.AuthTokens, error) {
+	accessToken, accessExpiresAt, err := s.tokens.GenerateJWTToken(userID, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate access token: %w", err)
+	}
+
+	return &entities.AuthTokens{
+		SessionID:        sessionID,
+		AccessToken:      accessToken,
+		AccessExpiresAt:  accessExpiresAt,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExpiresAt,
+	}, nil
+}
+
+// newRefreshToken generates random refresh token, returns it and its entity to store (without session id).
+func (s *SessionService) newRefreshToken() (string, *entities.RefreshToken, error) {
+	buf := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	refreshToken := base64.RawURLEncoding.EncodeToString(buf)
+
+	return refreshToken, &entities.RefreshToken{
+		TokenHash: hashRefreshToken(refreshToken),
+		ExpiresAt: time.Now().Add(s.config.RefreshTTL),
+	}, nil
+}
+
+func hashRefreshToken(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+
+	return hex.EncodeToString(sum[:])
+}
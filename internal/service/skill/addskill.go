@@ -9,7 +9,7 @@ import (
 	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
 )
 
-func (s *SkillService) AddSkill(ctx context.Context, userID, categoryID int, videoCardLink string, about string) error {
+func (s *SkillService) AddSkill(ctx context.Context, userID, categoryID int, videoCardLink string, about string, price int) error {
 	// is user exists
 	exists, err := s.repo.IsUserExistsByID(ctx, userID)
 	if err != nil {
@@ -26,14 +26,37 @@ func (s *SkillService) AddSkill(ctx context.Context, userID, categoryID int, vid
 		return fmt.Errorf("failed to create teacher: %w", err)
 	}
 
-	// is category exists
-	exists, err = s.repo.IsCategoryExistsByID(ctx, categoryID)
+	// is category exists and not archived
+	category, err := s.repo.GetCategoryByID(ctx, categoryID)
 	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorCategoryNotFound
+		}
+
 		return fmt.Errorf("failed to find category by id: %w", err)
 	}
 
-	if !exists {
-		return serviceErrs.ErrorCategoryNotFound
+	if category.IsArchived {
+		return serviceErrs.ErrorCategoryArchived
+	}
+
+	// teacher must be old enough for category too (or have exception)
+	isOldEnough, err := s.repo.IsUserOldEnoughForCategory(ctx, userID, categoryID)
+	if err != nil {
+		return fmt.Errorf("failed to check teacher age for category: %w", err)
+	}
+
+	if !isOldEnough {
+		return serviceErrs.ErrorTeacherTooYoung
+	}
+
+	moderationItem, err := s.moderator.CheckContent(ctx, &entities.UserContent{
+		Kind:     entities.ContentSkillAbout,
+		AuthorID: userID,
+		Text:     about,
+	})
+	if err != nil {
+		return err
 	}
 
 	// create skill
@@ -42,9 +65,10 @@ func (s *SkillService) AddSkill(ctx context.Context, userID, categoryID int, vid
 		CategoryID:    categoryID,
 		VideoCardLink: videoCardLink,
 		About:         about,
+		Price:         price,
 	}
 
-	if err = s.repo.CreateSkill(ctx, skill); err != nil {
+	if err = s.repo.CreateSkill(ctx, skill, moderationItem); err != nil {
 		if errors.Is(err, serviceErrs.ErrorNonUniqueData) {
 			return serviceErrs.ErrorSkillRegistered
 		}
@@ -5,10 +5,11 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
 	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
 )
 
-func (s *SkillService) ApproveTeacherSkill(ctx context.Context, skillID int) error {
+func (s *SkillService) ApproveTeacherSkill(ctx context.Context, skillID int, auditEntry *entities.AdminAuditEntry) error {
 	skill, err := s.repo.GetSkillByID(ctx, skillID)
 	if err != nil {
 		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
@@ -22,7 +23,7 @@ func (s *SkillService) ApproveTeacherSkill(ctx context.Context, skillID int) err
 		return serviceErrs.ErrorSkillAlreadyApproved
 	}
 
-	if err = s.repo.ActivateSkillByID(ctx, skillID); err != nil {
+	if err = s.repo.ActivateSkillByID(ctx, skillID, auditEntry); err != nil {
 		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
 			return serviceErrs.ErrorSkillNotFound
 		}
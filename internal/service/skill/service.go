@@ -4,26 +4,50 @@ import (
 	"context"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	"github.com/LearnShareApp/learn-share-backend/pkg/storage/object"
+	"go.uber.org/zap"
 )
 
+type ObjectStorage interface {
+	UploadFile(ctx context.Context, file *object.File) error
+	GetFile(ctx context.Context, fileName string) (*object.File, error)
+	DeleteFile(ctx context.Context, fileName string) error
+}
+
 type Repository interface {
 	GetSkillByID(ctx context.Context, id int) (*entities.Skill, error)
 	GetAllSkills(ctx context.Context) ([]entities.Skill, error)
 	GetUnactiveSkills(ctx context.Context) ([]entities.Skill, error)
 
 	IsUserExistsByID(ctx context.Context, id int) (bool, error)
-	IsCategoryExistsByID(ctx context.Context, id int) (bool, error)
+	GetCategoryByID(ctx context.Context, id int) (*entities.Category, error)
+	IsUserOldEnoughForCategory(ctx context.Context, userID, categoryID int) (bool, error)
 	CreateTeacherIfNotExists(ctx context.Context, userId int) (int, error)
-	CreateSkill(ctx context.Context, skill *entities.Skill) error
-	ActivateSkillByID(ctx context.Context, id int) error
+	CreateSkill(ctx context.Context, skill *entities.Skill, moderationItem *entities.ModerationQueueItem) error
+	ActivateSkillByID(ctx context.Context, id int, auditEntry *entities.AdminAuditEntry) error
+	UpdateSkillVideoCard(ctx context.Context, id int, fileName string, duration int) error
+
+	GetTeacherByUserID(ctx context.Context, id int) (*entities.Teacher, error)
+}
+
+// ContentModerator checks user-submitted text before it's saved.
+type ContentModerator interface {
+	ModerateContent(ctx context.Context, content *entities.UserContent) error
+	CheckContent(ctx context.Context, content *entities.UserContent) (*entities.ModerationQueueItem, error)
 }
 
 type SkillService struct {
-	repo Repository
+	repo          Repository
+	objectStorage ObjectStorage
+	moderator     ContentModerator
+	log           *zap.Logger
 }
 
-func NewService(repo Repository) *SkillService {
+func NewService(repo Repository, objectStorage ObjectStorage, moderator ContentModerator, log *zap.Logger) *SkillService {
 	return &SkillService{
-		repo: repo,
+		repo:          repo,
+		objectStorage: objectStorage,
+		moderator:     moderator,
+		log:           log,
 	}
 }
@@ -0,0 +1,143 @@
+package skill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/pkg/storage/object"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// UploadSkillVideoCard uploads already validated video card of teacher's skill.
+// Skill with new video card becomes inactive until admin approves it again.
+func (s *SkillService) UploadSkillVideoCard(ctx context.Context, userID, skillID int, video *object.File, duration time.Duration) error {
+	teacher, err := s.repo.GetTeacherByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorUserIsNotTeacher
+		}
+
+		return fmt.Errorf("failed to get teacher by user id: %w", err)
+	}
+
+	skill, err := s.repo.GetSkillByID(ctx, skillID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorSkillNotFound
+		}
+
+		return fmt.Errorf("failed to get skill by id: %w", err)
+	}
+
+	if skill.TeacherID != teacher.ID {
+		return serviceErrs.ErrorSkillUnregistered
+	}
+
+	video.Name = uuid.New().String() + "." + video.Extension
+
+	if err = s.objectStorage.UploadFile(ctx, video); err != nil {
+		return fmt.Errorf("failed to upload video card: %w", err)
+	}
+
+	if err = s.repo.UpdateSkillVideoCard(ctx, skillID, video.Name, int(duration.Round(time.Second).Seconds())); err != nil {
+		// uploaded file isn't referenced by skill, failure to delete it is ignored
+		_ = s.objectStorage.DeleteFile(ctx, video.Name)
+
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorSkillNotFound
+		}
+
+		return fmt.Errorf("failed to update video card of skill: %w", err)
+	}
+
+	// new video card is already saved, so failure to delete the previous one is only logged
+	if skill.VideoCardFile != "" {
+		if err = s.objectStorage.DeleteFile(ctx, skill.VideoCardFile); err != nil {
+			s.log.Error("failed to delete previous video card",
+				zap.String("file", skill.VideoCardFile),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// GetSkillVideoCard returns uploaded video card of active skill, video of not approved skill is "not found".
+func (s *SkillService) GetSkillVideoCard(ctx context.Context, skillID int) (*object.File, error) {
+	skill, err := s.getSkillByID(ctx, skillID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !skill.IsActive {
+		return nil, serviceErrs.ErrorSkillNotFound
+	}
+
+	return s.getVideoCardFile(ctx, skill)
+}
+
+// GetOwnSkillVideoCard returns uploaded video card of skill (active or not) of teacher with userID.
+func (s *SkillService) GetOwnSkillVideoCard(ctx context.Context, userID, skillID int) (*object.File, error) {
+	teacher, err := s.repo.GetTeacherByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, serviceErrs.ErrorUserIsNotTeacher
+		}
+
+		return nil, fmt.Errorf("failed to get teacher by user id: %w", err)
+	}
+
+	skill, err := s.getSkillByID(ctx, skillID)
+	if err != nil {
+		return nil, err
+	}
+
+	if skill.TeacherID != teacher.ID {
+		return nil, serviceErrs.ErrorSkillUnregistered
+	}
+
+	return s.getVideoCardFile(ctx, skill)
+}
+
+// GetSkillVideoCardForReview returns uploaded video card of any skill (active or not) for admin.
+func (s *SkillService) GetSkillVideoCardForReview(ctx context.Context, skillID int) (*object.File, error) {
+	skill, err := s.getSkillByID(ctx, skillID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getVideoCardFile(ctx, skill)
+}
+
+func (s *SkillService) getSkillByID(ctx context.Context, skillID int) (*entities.Skill, error) {
+	skill, err := s.repo.GetSkillByID(ctx, skillID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, serviceErrs.ErrorSkillNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get skill by id: %w", err)
+	}
+
+	return skill, nil
+}
+
+func (s *SkillService) getVideoCardFile(ctx context.Context, skill *entities.Skill) (*object.File, error) {
+	if skill.VideoCardFile == "" {
+		return nil, serviceErrs.ErrorVideoCardNotFound
+	}
+
+	file, err := s.objectStorage.GetFile(ctx, skill.VideoCardFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get video card: %w", err)
+	}
+
+	return file, nil
+}
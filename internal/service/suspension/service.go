@@ -0,0 +1,27 @@
+package suspension
+
+import (
+	"context"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+)
+
+type Repository interface {
+	IsUserAdminByID(ctx context.Context, id int) (bool, error)
+	GetActiveSuspension(ctx context.Context, userID int) (*entities.UserSuspension, error)
+	GetFutureLessonsOfTeacherUser(ctx context.Context, userID int, states []entities.StateName) ([]entities.SuspendedLesson, error)
+	SuspendUser(ctx context.Context, suspension *entities.UserSuspension, lessons []entities.SuspendedLesson, auditEntry *entities.AdminAuditEntry) error
+	LiftSuspension(ctx context.Context, userID, adminID int, auditEntry *entities.AdminAuditEntry) error
+
+	GetStateIDByName(ctx context.Context, name entities.StateName) (int, error)
+}
+
+type SuspensionService struct {
+	repo Repository
+}
+
+func NewService(repo Repository) *SuspensionService {
+	return &SuspensionService{
+		repo: repo,
+	}
+}
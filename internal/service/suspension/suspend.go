@@ -0,0 +1,107 @@
+package suspension
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+const suspendedTeacherLessonMessage = "teacher is suspended"
+
+// lessonStatesOnSuspension maps state of future lesson of suspended teacher to its next state.
+var lessonStatesOnSuspension = map[entities.StateName]entities.StateName{
+	entities.Pending: entities.Rejected,
+	entities.Planned: entities.Cancelled,
+}
+
+var lessonNotificationTypes = map[entities.StateName]entities.NotificationType{
+	entities.Rejected:  entities.NotificationLessonRejected,
+	entities.Cancelled: entities.NotificationLessonCancelled,
+}
+
+// SuspendUser suspends user until suspension.Until (or bans if it is nil), previous suspension is replaced.
+// Future lessons of suspended teacher are cancelled (pending ones are rejected), students are notified.
+func (s *SuspensionService) SuspendUser(ctx context.Context, suspension *entities.UserSuspension, auditEntry *entities.AdminAuditEntry) error {
+	isAdmin, err := s.repo.IsUserAdminByID(ctx, suspension.UserID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorUserNotFound
+		}
+
+		return fmt.Errorf("failed to check user on admin: %w", err)
+	}
+
+	if isAdmin {
+		return serviceErrs.ErrorSuspendAdmin
+	}
+
+	if suspension.Until != nil && !suspension.Until.After(time.Now()) {
+		return serviceErrs.ErrorSuspensionUntilPast
+	}
+
+	fromStates := make([]entities.StateName, 0, len(lessonStatesOnSuspension))
+	nextStateIDs := make(map[entities.StateName]int, len(lessonStatesOnSuspension))
+
+	for from, to := range lessonStatesOnSuspension {
+		fromStates = append(fromStates, from)
+
+		nextStateIDs[from], err = s.repo.GetStateIDByName(ctx, to)
+		if err != nil {
+			return fmt.Errorf("failed to get stateID by name: %w", err)
+		}
+	}
+
+	lessons, err := s.repo.GetFutureLessonsOfTeacherUser(ctx, suspension.UserID, fromStates)
+	if err != nil {
+		return fmt.Errorf("failed to get future lessons of teacher: %w", err)
+	}
+
+	message := suspendedTeacherLessonMessage
+
+	for i := range lessons {
+		lessons[i].NextStateID = nextStateIDs[lessons[i].StateName]
+		lessons[i].Notification = &entities.Notification{
+			UserID:   lessons[i].StudentID,
+			Type:     lessonNotificationTypes[lessonStatesOnSuspension[lessons[i].StateName]],
+			EntityID: &lessons[i].LessonID,
+			Message:  &message,
+		}
+	}
+
+	if err = s.repo.SuspendUser(ctx, suspension, lessons, auditEntry); err != nil {
+		return fmt.Errorf("failed to suspend user: %w", err)
+	}
+
+	return nil
+}
+
+// LiftSuspension lifts current suspension (or ban) of user.
+func (s *SuspensionService) LiftSuspension(ctx context.Context, adminID, userID int, auditEntry *entities.AdminAuditEntry) error {
+	if err := s.repo.LiftSuspension(ctx, userID, adminID, auditEntry); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorUserNotSuspended
+		}
+
+		return fmt.Errorf("failed to lift user suspension: %w", err)
+	}
+
+	return nil
+}
+
+// GetActiveSuspension returns current suspension (or ban) of user or nil if user is not suspended.
+func (s *SuspensionService) GetActiveSuspension(ctx context.Context, userID int) (*entities.UserSuspension, error) {
+	suspension, err := s.repo.GetActiveSuspension(ctx, userID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get user suspension: %w", err)
+	}
+
+	return suspension, nil
+}
@@ -0,0 +1,58 @@
+package teacher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// AddFavoriteTeacher bookmarks teacher for user.
+func (s *TeacherService) AddFavoriteTeacher(ctx context.Context, userID, teacherID int) error {
+	teacher, err := s.repo.GetTeacherByID(ctx, teacherID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorTeacherNotFound
+		}
+
+		return fmt.Errorf("failed to get teacher by id: %w", err)
+	}
+
+	if teacher.UserID == userID {
+		return serviceErrs.ErrorFavoriteYourself
+	}
+
+	if err = s.repo.AddFavoriteTeacher(ctx, userID, teacherID); err != nil {
+		return fmt.Errorf("failed to add favorite teacher: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveFavoriteTeacher removes teacher from user's bookmarks.
+func (s *TeacherService) RemoveFavoriteTeacher(ctx context.Context, userID, teacherID int) error {
+	if err := s.repo.DeleteFavoriteTeacher(ctx, userID, teacherID); err != nil {
+		return fmt.Errorf("failed to remove favorite teacher: %w", err)
+	}
+
+	return nil
+}
+
+// GetFavoriteTeachers returns one page of user's favorite teachers (see GetTeacherList).
+func (s *TeacherService) GetFavoriteTeachers(ctx context.Context, filter *entities.TeacherListFilter, cursor string) ([]entities.User, string, error) {
+	filter.IsFavorites = true
+
+	return s.GetTeacherList(ctx, filter, cursor)
+}
+
+// IsFavoriteTeacher checks is teacher in user's favorites.
+func (s *TeacherService) IsFavoriteTeacher(ctx context.Context, userID, teacherID int) (bool, error) {
+	isFavorite, err := s.repo.IsFavoriteTeacher(ctx, userID, teacherID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check favorite teacher: %w", err)
+	}
+
+	return isFavorite, nil
+}
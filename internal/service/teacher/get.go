@@ -25,6 +25,18 @@ func (s *TeacherService) GetTeacher(ctx context.Context, teacher *entities.Teach
 
 	user.TeacherData = teacher
 
+	if teacher.Languages, err = s.repo.GetTeacherLanguages(ctx, teacher.ID); err != nil {
+		return nil, fmt.Errorf("failed to get teacher languages: %w", err)
+	}
+
+	if teacher.Education, err = s.repo.GetTeacherEducations(ctx, teacher.ID); err != nil {
+		return nil, fmt.Errorf("failed to get teacher education: %w", err)
+	}
+
+	if teacher.Certificates, err = s.repo.GetTeacherCertificates(ctx, teacher.ID); err != nil {
+		return nil, fmt.Errorf("failed to get teacher certificates: %w", err)
+	}
+
 	teacher.Skills, err = s.repo.GetSkillsByTeacherID(ctx, teacher.ID)
 	if err != nil {
 		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
@@ -2,16 +2,113 @@ package teacher
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
 )
 
-func (s *TeacherService) GetTeacherList(ctx context.Context, userID int, isMyTeachers bool, category string, isFilteredByCategory bool) ([]entities.User, error) {
-	teachers, err := s.repo.GetAllTeachersDataFiltered(ctx, userID, isMyTeachers, category, isFilteredByCategory)
+const (
+	DefaultTeacherListLimit = 20
+	MaxTeacherListLimit     = 100
+)
+
+// GetTeacherList returns one page of the teacher catalogue and cursor of the next page
+// (empty cursor means that it was the last page).
+func (s *TeacherService) GetTeacherList(ctx context.Context, filter *entities.TeacherListFilter, cursor string) ([]entities.User, string, error) {
+	if filter.Limit <= 0 || filter.Limit > MaxTeacherListLimit {
+		filter.Limit = DefaultTeacherListLimit
+	}
+
+	if filter.SortBy == "" {
+		filter.SortBy = entities.SortByRanking
+	}
+
+	if cursor != "" {
+		decoded, err := decodeTeacherListCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// cursor is valid only for the same ordering
+		if decoded.SortBy != filter.SortBy || decoded.Desc != filter.Desc {
+			return nil, "", serviceErrs.ErrorInvalidCursor
+		}
+
+		filter.Cursor = decoded
+	}
+
+	teachers, hasMore, err := s.repo.GetAllTeachersDataFiltered(ctx, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get list of teachers: %w", err)
+	}
+
+	if !hasMore || len(teachers) == 0 {
+		return teachers, "", nil
+	}
+
+	last := teachers[len(teachers)-1].TeacherData
+
+	nextCursor, err := encodeTeacherListCursor(&entities.TeacherListCursor{
+		SortBy:    filter.SortBy,
+		Desc:      filter.Desc,
+		Value:     teacherSortValue(last, filter.SortBy),
+		TeacherID: last.ID,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return teachers, nextCursor, nil
+}
+
+func teacherSortValue(teacher *entities.Teacher, sortBy entities.TeacherSortField) float64 {
+	switch sortBy {
+	case entities.SortByReviewsCount:
+		return float64(teacher.ReviewsCount)
+	case entities.SortByFinishedLessons:
+		return float64(teacher.TeacherStat.CountOfFinishedLesson)
+	case entities.SortByPrice:
+		return float64(teacher.MinPrice)
+	case entities.SortByNewest:
+		return float64(teacher.ID)
+	case entities.SortByRating:
+		return float64(teacher.Rate)
+	default:
+		return teacher.RankingScore
+	}
+}
+
+type teacherListCursorPayload struct {
+	SortBy    entities.TeacherSortField `json:"s"`
+	Desc      bool                      `json:"d"`
+	Value     float64                   `json:"v"`
+	TeacherID int                       `json:"id"`
+}
+
+func encodeTeacherListCursor(cursor *entities.TeacherListCursor) (string, error) {
+	data, err := json.Marshal(teacherListCursorPayload(*cursor))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get list of teachers: %w", err)
+		return "", fmt.Errorf("failed to marshal cursor: %w", err)
 	}
 
-	return teachers, nil
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeTeacherListCursor(cursor string) (*entities.TeacherListCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, serviceErrs.ErrorInvalidCursor
+	}
+
+	var payload teacherListCursorPayload
+	if err = json.Unmarshal(data, &payload); err != nil || payload.TeacherID <= 0 {
+		return nil, serviceErrs.ErrorInvalidCursor
+	}
+
+	decoded := entities.TeacherListCursor(payload)
+
+	return &decoded, nil
 }
@@ -0,0 +1,258 @@
+package teacher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/pkg/storage/object"
+
+	"github.com/google/uuid"
+)
+
+// UpdateTeacherProfile replaces profile (headline, bio, languages, education) of teacher with userID.
+func (s *TeacherService) UpdateTeacherProfile(ctx context.Context, userID int, profile *entities.TeacherProfile) error {
+	teacher, err := s.getTeacherByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	languages := make(map[string]bool, len(profile.Languages))
+
+	for _, language := range profile.Languages {
+		if !slices.Contains(entities.LanguageLevels, language.Level) {
+			return serviceErrs.ErrorInvalidLanguageLevel
+		}
+
+		key := strings.ToLower(language.Language)
+		if languages[key] {
+			return serviceErrs.ErrorDuplicateLanguage
+		}
+
+		languages[key] = true
+	}
+
+	if err = s.moderator.ModerateContent(ctx, &entities.UserContent{
+		Kind:     entities.ContentTeacherProfile,
+		AuthorID: userID,
+		EntityID: &teacher.ID,
+		Text:     profileText(profile),
+	}); err != nil {
+		return err
+	}
+
+	if err = s.repo.UpdateTeacherProfile(ctx, teacher.ID, profile); err != nil {
+		return fmt.Errorf("failed to update teacher profile: %w", err)
+	}
+
+	return nil
+}
+
+// profileText joins all user-written parts of profile into one text for moderation.
+func profileText(profile *entities.TeacherProfile) string {
+	parts := make([]string, 0, 2+len(profile.Languages)+len(profile.Education))
+	parts = append(parts, profile.Headline, profile.Bio)
+
+	for _, language := range profile.Languages {
+		parts = append(parts, language.Language)
+	}
+
+	for _, education := range profile.Education {
+		parts = append(parts, education.Institution+" "+education.Degree+" "+education.FieldOfStudy)
+	}
+
+	return strings.Join(parts, "\n")
+}
+
+// AddTeacherCertificate uploads certificate file into object storage and returns id of the certificate.
+func (s *TeacherService) AddTeacherCertificate(ctx context.Context, userID int, title string,
+	fileReader io.Reader, fileSize int64, extension string) (int, error) {
+	teacher, err := s.getTeacherByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	moderationItem, err := s.moderator.CheckContent(ctx, &entities.UserContent{
+		Kind:     entities.ContentCertificate,
+		AuthorID: userID,
+		Text:     title,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	fileName := uuid.New().String() + "." + extension
+
+	file := object.File{
+		Name:       fileName,
+		Extension:  extension,
+		Size:       fileSize,
+		FileReader: fileReader,
+	}
+
+	if err = s.objectStorage.UploadFile(ctx, &file); err != nil {
+		return 0, fmt.Errorf("failed to upload certificate: %w", err)
+	}
+
+	id, err := s.repo.CreateCertificate(ctx, &entities.TeacherCertificate{
+		TeacherID: teacher.ID,
+		Title:     title,
+		FileName:  fileName,
+	}, moderationItem)
+	if err != nil {
+		// file of not created certificate isn't referenced, failure to delete it is ignored
+		_ = s.objectStorage.DeleteFile(ctx, fileName)
+
+		return 0, fmt.Errorf("failed to create certificate: %w", err)
+	}
+
+	return id, nil
+}
+
+// DeleteTeacherCertificate deletes certificate of teacher with userID and its file.
+func (s *TeacherService) DeleteTeacherCertificate(ctx context.Context, userID, certificateID int) error {
+	teacher, err := s.getTeacherByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	certificate, err := s.getCertificateByID(ctx, certificateID)
+	if err != nil {
+		return err
+	}
+
+	// somebody else's certificate is "not found" for teacher
+	if certificate.TeacherID != teacher.ID {
+		return serviceErrs.ErrorCertificateNotFound
+	}
+
+	if err = s.repo.DeleteCertificateByID(ctx, certificateID); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorCertificateNotFound
+		}
+
+		return fmt.Errorf("failed to delete certificate: %w", err)
+	}
+
+	if err = s.objectStorage.DeleteFile(ctx, certificate.FileName); err != nil {
+		return fmt.Errorf("failed to delete certificate file: %w", err)
+	}
+
+	return nil
+}
+
+// GetCertificateFile returns file of verified certificate by its id, not verified certificates are "not found".
+func (s *TeacherService) GetCertificateFile(ctx context.Context, certificateID int) (*object.File, error) {
+	certificate, err := s.getCertificateByID(ctx, certificateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !certificate.IsVerified {
+		return nil, serviceErrs.ErrorCertificateNotFound
+	}
+
+	return s.getCertificateFile(ctx, certificate)
+}
+
+// GetOwnCertificateFile returns file of certificate (verified or not) of teacher with userID.
+func (s *TeacherService) GetOwnCertificateFile(ctx context.Context, userID, certificateID int) (*object.File, error) {
+	teacher, err := s.getTeacherByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err := s.getCertificateByID(ctx, certificateID)
+	if err != nil {
+		return nil, err
+	}
+
+	// somebody else's certificate is "not found" for teacher
+	if certificate.TeacherID != teacher.ID {
+		return nil, serviceErrs.ErrorCertificateNotFound
+	}
+
+	return s.getCertificateFile(ctx, certificate)
+}
+
+// GetCertificateFileForReview returns file of any certificate (verified or not) for admin.
+func (s *TeacherService) GetCertificateFileForReview(ctx context.Context, certificateID int) (*object.File, error) {
+	certificate, err := s.getCertificateByID(ctx, certificateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getCertificateFile(ctx, certificate)
+}
+
+func (s *TeacherService) getCertificateFile(ctx context.Context, certificate *entities.TeacherCertificate) (*object.File, error) {
+	file, err := s.objectStorage.GetFile(ctx, certificate.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certificate file: %w", err)
+	}
+
+	return file, nil
+}
+
+// VerifyTeacherCertificate marks certificate as verified by admin.
+func (s *TeacherService) VerifyTeacherCertificate(ctx context.Context, certificateID int, auditEntry *entities.AdminAuditEntry) error {
+	certificate, err := s.getCertificateByID(ctx, certificateID)
+	if err != nil {
+		return err
+	}
+
+	if certificate.IsVerified {
+		return serviceErrs.ErrorCertificateAlreadyVerified
+	}
+
+	if err = s.repo.VerifyCertificateByID(ctx, certificateID, auditEntry); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorCertificateNotFound
+		}
+
+		return fmt.Errorf("failed to verify certificate: %w", err)
+	}
+
+	return nil
+}
+
+// GetUnverifiedCertificateList returns certificates waiting for admin verification.
+func (s *TeacherService) GetUnverifiedCertificateList(ctx context.Context) ([]entities.TeacherCertificate, error) {
+	certificates, err := s.repo.GetUnverifiedCertificates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unverified certificates: %w", err)
+	}
+
+	return certificates, nil
+}
+
+func (s *TeacherService) getTeacherByUserID(ctx context.Context, userID int) (*entities.Teacher, error) {
+	teacher, err := s.repo.GetTeacherByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, serviceErrs.ErrorUserIsNotTeacher
+		}
+
+		return nil, fmt.Errorf("failed to get teacher by user id: %w", err)
+	}
+
+	return teacher, nil
+}
+
+func (s *TeacherService) getCertificateByID(ctx context.Context, certificateID int) (*entities.TeacherCertificate, error) {
+	certificate, err := s.repo.GetCertificateByID(ctx, certificateID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, serviceErrs.ErrorCertificateNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get certificate: %w", err)
+	}
+
+	return certificate, nil
+}
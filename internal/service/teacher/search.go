@@ -0,0 +1,56 @@
+package teacher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+)
+
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 50
+
+	maxSearchTerms = 8
+)
+
+// SearchTeachers makes full-text search of teachers by their names, categories and skills descriptions.
+// Every word of the query is matched as prefix, so it can be used for autocomplete.
+func (s *TeacherService) SearchTeachers(ctx context.Context, query string, limit int) ([]entities.TeacherSearchResult, error) {
+	if limit <= 0 || limit > MaxSearchLimit {
+		limit = DefaultSearchLimit
+	}
+
+	tsQuery := buildPrefixTSQuery(query)
+	if tsQuery == "" {
+		return []entities.TeacherSearchResult{}, nil
+	}
+
+	results, err := s.repo.SearchTeachers(ctx, tsQuery, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search teachers: %w", err)
+	}
+
+	return results, nil
+}
+
+// buildPrefixTSQuery turns user input into tsquery syntax: "joh smi" => "joh:* & smi:*".
+// All symbols except letters and digits are dropped, so input can't break tsquery syntax.
+func buildPrefixTSQuery(query string) string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	if len(words) > maxSearchTerms {
+		words = words[:maxSearchTerms]
+	}
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		terms = append(terms, word+":*")
+	}
+
+	return strings.Join(terms, " & ")
+}
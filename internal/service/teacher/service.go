@@ -4,13 +4,20 @@ import (
 	"context"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	"github.com/LearnShareApp/learn-share-backend/pkg/storage/object"
 )
 
+type ObjectStorage interface {
+	UploadFile(ctx context.Context, file *object.File) error
+	GetFile(ctx context.Context, fileName string) (*object.File, error)
+	DeleteFile(ctx context.Context, fileName string) error
+}
+
 type Repository interface {
 	IsUserExistsByID(ctx context.Context, id int) (bool, error)
 	IsCategoryExistsByID(ctx context.Context, id int) (bool, error)
 	CreateTeacherIfNotExists(ctx context.Context, userId int) (int, error)
-	CreateSkill(ctx context.Context, skill *entities.Skill) error
+	CreateSkill(ctx context.Context, skill *entities.Skill, moderationItem *entities.ModerationQueueItem) error
 	IsTeacherExistsByUserID(ctx context.Context, id int) (bool, error)
 	CreateTeacher(ctx context.Context, userID int) error
 
@@ -21,15 +28,40 @@ type Repository interface {
 	GetSkillsByTeacherID(ctx context.Context, id int) ([]*entities.Skill, error)
 
 	GetShortTeacherDatasByIDs(ctx context.Context, teacherIDs map[int]bool) ([]entities.User, error)
-	GetAllTeachersDataFiltered(ctx context.Context, userID int, isUsersTeachers bool, category string, isFilteredByCategory bool) ([]entities.User, error)
+	GetAllTeachersDataFiltered(ctx context.Context, filter *entities.TeacherListFilter) ([]entities.User, bool, error)
+	SearchTeachers(ctx context.Context, tsQuery string, limit int) ([]entities.TeacherSearchResult, error)
+
+	AddFavoriteTeacher(ctx context.Context, userID, teacherID int) error
+	DeleteFavoriteTeacher(ctx context.Context, userID, teacherID int) error
+	IsFavoriteTeacher(ctx context.Context, userID, teacherID int) (bool, error)
+
+	UpdateTeacherProfile(ctx context.Context, teacherID int, profile *entities.TeacherProfile) error
+	GetTeacherLanguages(ctx context.Context, teacherID int) ([]entities.TeacherLanguage, error)
+	GetTeacherEducations(ctx context.Context, teacherID int) ([]entities.TeacherEducation, error)
+	GetTeacherCertificates(ctx context.Context, teacherID int) ([]entities.TeacherCertificate, error)
+	GetUnverifiedCertificates(ctx context.Context) ([]entities.TeacherCertificate, error)
+	GetCertificateByID(ctx context.Context, id int) (*entities.TeacherCertificate, error)
+	CreateCertificate(ctx context.Context, certificate *entities.TeacherCertificate, moderationItem *entities.ModerationQueueItem) (int, error)
+	DeleteCertificateByID(ctx context.Context, id int) error
+	VerifyCertificateByID(ctx context.Context, id int, auditEntry *entities.AdminAuditEntry) error
+}
+
+// ContentModerator checks user-submitted text before it's saved.
+type ContentModerator interface {
+	ModerateContent(ctx context.Context, content *entities.UserContent) error
+	CheckContent(ctx context.Context, content *entities.UserContent) (*entities.ModerationQueueItem, error)
 }
 
 type TeacherService struct {
-	repo Repository
+	repo          Repository
+	objectStorage ObjectStorage
+	moderator     ContentModerator
 }
 
-func NewService(repo Repository) *TeacherService {
+func NewService(repo Repository, objectStorage ObjectStorage, moderator ContentModerator) *TeacherService {
 	return &TeacherService{
-		repo: repo,
+		repo:          repo,
+		objectStorage: objectStorage,
+		moderator:     moderator,
 	}
 }
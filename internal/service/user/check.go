@@ -10,7 +10,8 @@ import (
 	"github.com/LearnShareApp/learn-share-backend/pkg/hasher"
 )
 
-// CheckUser check user existence (by email) and compare password, if all correct returns his id.
+// CheckUser check user existence (by email), compare password, that user is not suspended
+// and doesn't have to confirm his email, if all correct returns his id.
 func (s *UserService) CheckUser(ctx context.Context, reqUser *entities.User) (int, error) {
 	realUser, err := s.repo.GetUserByEmail(ctx, reqUser.Email)
 	if err != nil {
@@ -25,5 +26,31 @@ func (s *UserService) CheckUser(ctx context.Context, reqUser *entities.User) (in
 		return 0, serviceErrs.ErrorPasswordIncorrect
 	}
 
+	suspended, err := s.repo.IsUserSuspended(ctx, realUser.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check user suspension: %w", err)
+	}
+
+	if suspended {
+		return 0, serviceErrs.ErrorUserSuspended
+	}
+
+	if realUser.EmailVerificationRequired {
+		return 0, serviceErrs.ErrorEmailVerificationRequired
+	}
+
 	return realUser.ID, nil
 }
+
+// ConfirmEmail confirms email of user, who has to verify it, with token from the letter.
+func (s *UserService) ConfirmEmail(ctx context.Context, token string) error {
+	if err := s.repo.ConfirmEmailVerification(ctx, hasher.HashToken(token)); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorEmailVerificationTokenInvalid
+		}
+
+		return fmt.Errorf("failed to confirm email: %w", err)
+	}
+
+	return nil
+}
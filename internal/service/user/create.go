@@ -28,6 +28,15 @@ func (s *UserService) CreateUser(ctx context.Context, user *entities.User, avata
 		return 0, serviceErrs.ErrorPasswordTooShort
 	}
 
+	// author is not registered yet, flagged name gets him as author when it's saved with user
+	moderationItem, err := s.moderator.CheckContent(ctx, &entities.UserContent{
+		Kind: entities.ContentUserName,
+		Text: user.Name + " " + user.Surname,
+	})
+	if err != nil {
+		return 0, err
+	}
+
 	hashedPassword, err := hasher.HashPassword(user.Password)
 	if err != nil {
 		return 0, fmt.Errorf("failed to hash password: %w", err)
@@ -51,7 +60,7 @@ func (s *UserService) CreateUser(ctx context.Context, user *entities.User, avata
 
 	user.Avatar = avatarName
 
-	userID, err := s.repo.CreateUser(ctx, user)
+	userID, err := s.repo.CreateUser(ctx, user, moderationItem)
 	if err != nil {
 		return 0, fmt.Errorf("failed to save user: %w", err)
 	}
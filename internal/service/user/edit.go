@@ -24,12 +24,27 @@ func (s *UserService) EditUser(ctx context.Context, userID int, user *entities.U
 		return fmt.Errorf("failed to get user: %w", err)
 	}
 
+	isNameChanged := false
+
 	if user.Name != "" && oldUserData.Name != user.Name {
 		oldUserData.Name = user.Name
+		isNameChanged = true
 	}
 
 	if user.Surname != "" && oldUserData.Surname != user.Surname {
 		oldUserData.Surname = user.Surname
+		isNameChanged = true
+	}
+
+	if isNameChanged {
+		if err = s.moderator.ModerateContent(ctx, &entities.UserContent{
+			Kind:     entities.ContentUserName,
+			AuthorID: userID,
+			EntityID: &userID,
+			Text:     oldUserData.Name + " " + oldUserData.Surname,
+		}); err != nil {
+			return err
+		}
 	}
 
 	if user.Birthdate != oldUserData.Birthdate &&
@@ -16,19 +16,29 @@ type Repository interface {
 	IsTeacherExistsByUserID(ctx context.Context, id int) (bool, error)
 	GetUserByID(ctx context.Context, id int) (*entities.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*entities.User, error)
+	IsUserSuspended(ctx context.Context, userID int) (bool, error)
 	GetUserStatByUserID(ctx context.Context, id int) (*entities.StudentStatistic, error)
 	UpdateUser(ctx context.Context, userID int, user *entities.User) error
-	CreateUser(ctx context.Context, user *entities.User) (int, error)
+	CreateUser(ctx context.Context, user *entities.User, moderationItem *entities.ModerationQueueItem) (int, error)
+	ConfirmEmailVerification(ctx context.Context, tokenHash string) error
+}
+
+// ContentModerator checks user-submitted text before it's saved.
+type ContentModerator interface {
+	ModerateContent(ctx context.Context, content *entities.UserContent) error
+	CheckContent(ctx context.Context, content *entities.UserContent) (*entities.ModerationQueueItem, error)
 }
 
 type UserService struct {
 	repo          Repository
 	objectStorage ObjectStorage
+	moderator     ContentModerator
 }
 
-func NewService(repo Repository, objectStorage ObjectStorage) *UserService {
+func NewService(repo Repository, objectStorage ObjectStorage, moderator ContentModerator) *UserService {
 	return &UserService{
 		repo:          repo,
 		objectStorage: objectStorage,
+		moderator:     moderator,
 	}
 }
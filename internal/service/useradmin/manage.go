@@ -0,0 +1,92 @@
+package useradmin
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/pkg/hasher"
+)
+
+const (
+	emailVerificationTokenBytes = 32
+	emailVerificationSubject    = "Confirm your email"
+)
+
+// ResetUserAvatar replaces avatar of user with default one, uploaded avatar is deleted.
+func (s *UserAdminService) ResetUserAvatar(ctx context.Context, userID int, auditEntry *entities.AdminAuditEntry) error {
+	oldAvatar, err := s.repo.ResetUserAvatar(ctx, userID, auditEntry)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorUserNotFound
+		}
+
+		return fmt.Errorf("failed to reset user avatar: %w", err)
+	}
+
+	if oldAvatar != "" {
+		if err = s.objectStorage.DeleteFile(ctx, oldAvatar); err != nil {
+			return fmt.Errorf("failed to delete avatar: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// RequireEmailVerification makes user confirm his email again: he gets letter with verification token,
+// his sessions are revoked and he can't log in until he confirms email with the token.
+// Letter is sent first, so user isn't locked out if it can't be sent.
+func (s *UserAdminService) RequireEmailVerification(ctx context.Context, userID int, auditEntry *entities.AdminAuditEntry) error {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorUserNotFound
+		}
+
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	buf := make([]byte, emailVerificationTokenBytes)
+	if _, err = rand.Read(buf); err != nil {
+		return fmt.Errorf("failed to generate email verification token: %w", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	expiresAt := time.Now().Add(s.config.EmailVerificationTTL)
+
+	if err = s.mailer.Send(ctx, user.Email, emailVerificationSubject, s.emailVerificationLetter(token, expiresAt)); err != nil {
+		return fmt.Errorf("failed to send email verification letter: %w", err)
+	}
+
+	if err = s.repo.RequireEmailVerification(ctx, userID, hasher.HashToken(token), expiresAt, auditEntry); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorUserNotFound
+		}
+
+		return fmt.Errorf("failed to require email verification: %w", err)
+	}
+
+	return nil
+}
+
+func (s *UserAdminService) emailVerificationLetter(token string, expiresAt time.Time) string {
+	confirmation := "Verification token: " + token
+	if s.config.EmailVerificationURL != "" {
+		separator := "?"
+		if strings.Contains(s.config.EmailVerificationURL, "?") {
+			separator = "&"
+		}
+
+		confirmation = s.config.EmailVerificationURL + separator + "token=" + url.QueryEscape(token)
+	}
+
+	return "Please confirm your email to continue using LearnShare:\n\n" + confirmation +
+		"\n\nIt is valid until " + expiresAt.UTC().Format(time.RFC1123) + "."
+}
@@ -0,0 +1,84 @@
+package useradmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// MergeUsers merges duplicate (source) account into target one: lessons, reviews, complaints, favourites,
+// notifications, sanctions and teacher profile of source user are moved to target user, then source user is deleted.
+// Admins, suspended users, two teachers and users having lessons with each other can't be merged.
+func (s *UserAdminService) MergeUsers(ctx context.Context, targetID, sourceID int, auditEntry *entities.AdminAuditEntry) error {
+	if targetID == sourceID {
+		return serviceErrs.ErrorMergeSameUser
+	}
+
+	source, err := s.repo.GetUserByID(ctx, sourceID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorUserNotFound
+		}
+
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	access, err := s.repo.GetUserAccess(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("failed to get user access: %w", err)
+	}
+
+	if access.IsAdmin() {
+		return serviceErrs.ErrorMergeAdmin
+	}
+
+	isTeacher := make(map[int]bool, 2)
+
+	for _, id := range []int{targetID, sourceID} {
+		suspended, err := s.repo.IsUserSuspended(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to check user suspension: %w", err)
+		}
+
+		if suspended {
+			return serviceErrs.ErrorMergeSuspendedUser
+		}
+
+		if isTeacher[id], err = s.repo.IsTeacherExistsByUserID(ctx, id); err != nil {
+			return fmt.Errorf("failed to check whether the user is a teacher: %w", err)
+		}
+	}
+
+	if isTeacher[targetID] && isTeacher[sourceID] {
+		return serviceErrs.ErrorMergeTeachers
+	}
+
+	// merged user would be a student of his own lessons
+	hasCommonLessons, err := s.repo.IsLessonBetweenUsersExists(ctx, targetID, sourceID)
+	if err != nil {
+		return fmt.Errorf("failed to check lessons between users: %w", err)
+	}
+
+	if hasCommonLessons {
+		return serviceErrs.ErrorMergeSharedLessons
+	}
+
+	if err = s.repo.MergeUsers(ctx, targetID, sourceID, auditEntry); err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return serviceErrs.ErrorUserNotFound
+		}
+
+		return fmt.Errorf("failed to merge users: %w", err)
+	}
+
+	if source.Avatar != "" {
+		if err = s.objectStorage.DeleteFile(ctx, source.Avatar); err != nil {
+			return fmt.Errorf("failed to delete avatar of merged user: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package useradmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+// GetUserOverview returns user with his roles, lessons (as student and as teacher), skills,
+// complaints filed and received and all his sanctions.
+func (s *UserAdminService) GetUserOverview(ctx context.Context, userID int) (*entities.UserOverview, error) {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+			return nil, serviceErrs.ErrorUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	user.Password = ""
+
+	overview := &entities.UserOverview{User: user}
+
+	if overview.Access, err = s.repo.GetUserAccess(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to get user access: %w", err)
+	}
+
+	if overview.StudentLessons, err = s.repo.GetStudentLessonsByUserID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to get student lessons: %w", err)
+	}
+
+	teacherID, err := s.repo.GetTeacherIdByUserId(ctx, userID)
+	if err != nil && !errors.Is(err, serviceErrs.ErrorSelectEmpty) {
+		return nil, fmt.Errorf("failed to get teacher id by user id: %w", err)
+	}
+
+	// user is a teacher
+	if err == nil {
+		if overview.TeacherLessons, err = s.repo.GetTeacherLessonsByTeacherID(ctx, teacherID); err != nil {
+			return nil, fmt.Errorf("failed to get teacher lessons: %w", err)
+		}
+
+		if overview.Skills, err = s.repo.GetSkillsByTeacherID(ctx, teacherID); err != nil {
+			return nil, fmt.Errorf("failed to get skills: %w", err)
+		}
+	}
+
+	sortLessonsByTimeDesc(overview.StudentLessons)
+	sortLessonsByTimeDesc(overview.TeacherLessons)
+
+	complaints, err := s.repo.GetUserComplaints(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user complaints: %w", err)
+	}
+
+	for _, complaint := range complaints {
+		if complaint.ComplainerID == userID {
+			overview.ComplaintsFiled = append(overview.ComplaintsFiled, complaint)
+		}
+
+		if complaint.ReportedID == userID {
+			overview.ComplaintsReceived = append(overview.ComplaintsReceived, complaint)
+		}
+	}
+
+	if overview.Suspensions, err = s.repo.GetUserSuspensions(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to get user suspensions: %w", err)
+	}
+
+	return overview, nil
+}
+
+func sortLessonsByTimeDesc(lessons []*entities.Lesson) {
+	sort.Slice(lessons, func(i, j int) bool {
+		return lessons[i].ScheduleTimeDatetime.After(lessons[j].ScheduleTimeDatetime)
+	})
+}
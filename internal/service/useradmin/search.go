@@ -0,0 +1,76 @@
+package useradmin
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrs "github.com/LearnShareApp/learn-share-backend/internal/errors"
+)
+
+const (
+	DefaultUserSearchLimit = 20
+	MaxUserSearchLimit     = 100
+)
+
+// SearchUsers returns one page of users found by id, email, name or surname (newest first)
+// and cursor of the next page (empty cursor means that it was the last page).
+func (s *UserAdminService) SearchUsers(ctx context.Context, filter *entities.UserSearchFilter, cursor string) ([]*entities.User, string, error) {
+	if filter.Limit <= 0 || filter.Limit > MaxUserSearchLimit {
+		filter.Limit = DefaultUserSearchLimit
+	}
+
+	if cursor != "" {
+		lastID, err := decodeUserSearchCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		filter.Cursor = &lastID
+	}
+
+	users, hasMore, err := s.repo.SearchUsers(ctx, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to search users: %w", err)
+	}
+
+	if !hasMore || len(users) == 0 {
+		return users, "", nil
+	}
+
+	nextCursor, err := encodeUserSearchCursor(users[len(users)-1].ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return users, nextCursor, nil
+}
+
+type userSearchCursorPayload struct {
+	UserID int `json:"id"`
+}
+
+func encodeUserSearchCursor(lastID int) (string, error) {
+	data, err := json.Marshal(userSearchCursorPayload{UserID: lastID})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeUserSearchCursor(cursor string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, serviceErrs.ErrorInvalidCursor
+	}
+
+	var payload userSearchCursorPayload
+	if err = json.Unmarshal(data, &payload); err != nil || payload.UserID <= 0 {
+		return 0, serviceErrs.ErrorInvalidCursor
+	}
+
+	return payload.UserID, nil
+}
@@ -0,0 +1,60 @@
+package useradmin
+
+import (
+	"context"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+)
+
+type ObjectStorage interface {
+	DeleteFile(ctx context.Context, fileName string) error
+}
+
+// Mailer sends plain text letters to users.
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// Config contains settings of email verification required by admin.
+type Config struct {
+	// EmailVerificationTTL is a lifetime of email verification token sent to user.
+	EmailVerificationTTL time.Duration `env:"EMAIL_VERIFICATION_TTL" env-default:"72h"`
+	// EmailVerificationURL is a frontend page confirming email, token is added as "token" query param.
+	// Letter contains only token if it's empty.
+	EmailVerificationURL string `env:"EMAIL_VERIFICATION_URL" env-default:""`
+}
+
+type Repository interface {
+	SearchUsers(ctx context.Context, filter *entities.UserSearchFilter) ([]*entities.User, bool, error)
+	GetUserByID(ctx context.Context, id int) (*entities.User, error)
+	GetUserAccess(ctx context.Context, userID int) (*entities.UserAccess, error)
+	GetTeacherIdByUserId(ctx context.Context, id int) (int, error)
+	GetStudentLessonsByUserID(ctx context.Context, id int) ([]*entities.Lesson, error)
+	GetTeacherLessonsByTeacherID(ctx context.Context, id int) ([]*entities.Lesson, error)
+	GetSkillsByTeacherID(ctx context.Context, teacherID int) ([]*entities.Skill, error)
+	GetUserComplaints(ctx context.Context, userID int) ([]*entities.Complaint, error)
+	GetUserSuspensions(ctx context.Context, userID int) ([]*entities.UserSuspension, error)
+	IsUserSuspended(ctx context.Context, userID int) (bool, error)
+	IsTeacherExistsByUserID(ctx context.Context, id int) (bool, error)
+	IsLessonBetweenUsersExists(ctx context.Context, firstID, secondID int) (bool, error)
+	ResetUserAvatar(ctx context.Context, userID int, auditEntry *entities.AdminAuditEntry) (string, error)
+	RequireEmailVerification(ctx context.Context, userID int, tokenHash string, expiresAt time.Time, auditEntry *entities.AdminAuditEntry) error
+	MergeUsers(ctx context.Context, targetID, sourceID int, auditEntry *entities.AdminAuditEntry) error
+}
+
+type UserAdminService struct {
+	repo          Repository
+	objectStorage ObjectStorage
+	mailer        Mailer
+	config        Config
+}
+
+func NewService(repo Repository, objectStorage ObjectStorage, mailer Mailer, config Config) *UserAdminService {
+	return &UserAdminService{
+		repo:          repo,
+		objectStorage: objectStorage,
+		mailer:        mailer,
+		config:        config,
+	}
+}
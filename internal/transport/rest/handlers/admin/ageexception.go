@@ -0,0 +1,218 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	ageExceptionsRoute      = "/categories/{id}/age-exceptions"
+	revokeAgeExceptionRoute = "/categories/{id}/age-exceptions/{user_id}"
+)
+
+// GetAgeExceptionList returns http.HandlerFunc
+// @Summary get category's age exceptions
+// @Description returns users who are allowed to use category despite its min_age
+// @Tags admin
+// @Produce json
+// @Param id path int true "categoryID"
+// @Success 200 {object} getAgeExceptionsResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/categories/{id}/age-exceptions [get]
+// @Security     BearerAuth
+func (h *AdminHandlers) GetAgeExceptionList() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		categoryID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		exceptions, err := h.service.GetAgeExceptions(r.Context(), categoryID)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorCategoryNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		resp := getAgeExceptionsResponse{
+			Exceptions: make([]respAgeException, 0, len(exceptions)),
+		}
+
+		for _, exception := range exceptions {
+			resp.Exceptions = append(resp.Exceptions, respAgeException{
+				UserID:    exception.UserID,
+				GrantedBy: exception.GrantedBy,
+				CreatedAt: exception.CreatedAt,
+			})
+		}
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+// GrantAgeException returns http.HandlerFunc
+// @Summary grant age exception
+// @Description allow student to book lessons (or teacher to register skill) in category despite its min_age. Granting existing exception isn't error
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "categoryID"
+// @Param grantAgeExceptionRequest body grantAgeExceptionRequest true "User"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/categories/{id}/age-exceptions [post]
+// @Security     BearerAuth
+func (h *AdminHandlers) GrantAgeException() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		categoryID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		var req grantAgeExceptionRequest
+
+		if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		if req.UserID <= 0 {
+			httputils.RespondWith400(w, "user_id is required", h.log)
+
+			return
+		}
+
+		before := h.auditSnapshot(r.Context(), entities.AuditEntityAgeExceptions, &categoryID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditAgeExceptionGrant, entities.AuditEntityAgeExceptions, &categoryID, before)
+
+		if err = h.service.GrantAgeException(r.Context(), categoryID, req.UserID, userID, auditEntry); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorCategoryNotFound),
+				errors.Is(err, serviceErrors.ErrorUserNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+// RevokeAgeException returns http.HandlerFunc
+// @Summary revoke age exception
+// @Description remove user's exception from category's min_age, already booked lessons are kept
+// @Tags admin
+// @Produce json
+// @Param id path int true "categoryID"
+// @Param user_id path int true "userID"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/categories/{id}/age-exceptions/{user_id} [delete]
+// @Security     BearerAuth
+func (h *AdminHandlers) RevokeAgeException() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		categoryID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		exceptionUserID, err := httputils.GetIntParamFromRequestPath(r, "user_id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {user_id} param in url path", h.log)
+
+			return
+		}
+
+		before := h.auditSnapshot(r.Context(), entities.AuditEntityAgeExceptions, &categoryID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditAgeExceptionRevoke, entities.AuditEntityAgeExceptions, &categoryID, before)
+
+		if err = h.service.RevokeAgeException(r.Context(), categoryID, exceptionUserID, auditEntry); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorAgeExceptionNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+type grantAgeExceptionRequest struct {
+	UserID int `json:"user_id" example:"42"`
+}
+
+// @Description category's age exceptions getAgeExceptionsResponse.
+type getAgeExceptionsResponse struct {
+	Exceptions []respAgeException `json:"exceptions"`
+}
+
+// @Description data of respAgeException.
+type respAgeException struct {
+	UserID    int       `json:"user_id"              example:"42"`
+	GrantedBy *int      `json:"granted_by,omitempty" example:"1"`
+	CreatedAt time.Time `json:"created_at"           example:"2025-01-01T12:00:00Z"`
+}
@@ -0,0 +1,201 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+)
+
+const (
+	analyticsRoute = "/analytics"
+
+	analyticsDateLayout       = time.DateOnly
+	defaultAnalyticsRangeDays = 30
+	maxAnalyticsRangeDays     = 731
+)
+
+// GetPlatformAnalytics returns http.HandlerFunc
+// @Summary Get platform analytics dashboard
+// @Description Signups, new teachers, approved skills, lessons booked/started/completed/cancelled/rejected, cancellation and rejection rates and GMV (sum of prices of completed lessons) for days range: total and by days, weeks or months. Top categories by completed lessons and skill approval backlog with median approval time. Everything is counted by day (UTC) of event. Data is refreshed every few minutes, backlog is current
+// @Tags admin
+// @Produce json
+// @Param from query string false "First day of range, YYYY-MM-DD (default 29 days before to)"
+// @Param to query string false "Last day of range, YYYY-MM-DD (default today)"
+// @Param period query string false "Grouping period (default day)" Enums(day, week, month)
+// @Success 200 {object} platformAnalyticsResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/analytics [get]
+// @Security     BearerAuth
+func (h *AdminHandlers) GetPlatformAnalytics() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter, err := parsePlatformAnalyticsFilter(r.URL.Query())
+		if err != nil {
+			httputils.RespondWith400(w, err.Error(), h.log)
+
+			return
+		}
+
+		analytics, err := h.service.GetPlatformAnalytics(r.Context(), *filter)
+		if err != nil {
+			h.log.Error(err.Error())
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		resp := platformAnalyticsResponse{
+			From:          analytics.Filter.From.Format(analyticsDateLayout),
+			To:            analytics.Filter.To.Format(analyticsDateLayout),
+			Period:        string(analytics.Filter.Period),
+			Total:         mapPlatformStatsBucket(&analytics.Total),
+			Periods:       make([]respPlatformPeriod, 0, len(analytics.Periods)),
+			TopCategories: make([]respPlatformCategory, 0, len(analytics.TopCategories)),
+			SkillApproval: respSkillApproval{
+				PendingCount:              analytics.SkillApproval.PendingCount,
+				OldestPendingSince:        analytics.SkillApproval.OldestPendingSince,
+				ApprovedCount:             analytics.SkillApproval.ApprovedCount,
+				MedianApprovalTimeSeconds: int64(analytics.SkillApproval.MedianApprovalTime.Seconds()),
+			},
+		}
+
+		for i := range analytics.Periods {
+			resp.Periods = append(resp.Periods, respPlatformPeriod{
+				PeriodStart:       analytics.Periods[i].PeriodStart.Format(analyticsDateLayout),
+				respPlatformStats: mapPlatformStatsBucket(&analytics.Periods[i]),
+			})
+		}
+
+		for i := range analytics.TopCategories {
+			resp.TopCategories = append(resp.TopCategories, respPlatformCategory{
+				CategoryID:       analytics.TopCategories[i].CategoryID,
+				CategoryName:     analytics.TopCategories[i].CategoryName,
+				LessonsBooked:    analytics.TopCategories[i].LessonsBooked,
+				LessonsCompleted: analytics.TopCategories[i].LessonsCompleted,
+				LessonsCancelled: analytics.TopCategories[i].LessonsCancelled,
+				GMV:              analytics.TopCategories[i].GMV,
+				CancellationRate: analytics.TopCategories[i].CancellationRate,
+			})
+		}
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+func parsePlatformAnalyticsFilter(query url.Values) (*entities.PlatformAnalyticsFilter, error) {
+	now := time.Now().UTC()
+
+	filter := &entities.PlatformAnalyticsFilter{
+		To:     time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC),
+		Period: entities.AnalyticsPeriodDay,
+	}
+
+	var err error
+
+	if value := query.Get("to"); value != "" {
+		if filter.To, err = time.Parse(analyticsDateLayout, value); err != nil {
+			return nil, errors.New("to must be date in YYYY-MM-DD format")
+		}
+	}
+
+	filter.From = filter.To.AddDate(0, 0, -(defaultAnalyticsRangeDays - 1))
+
+	if value := query.Get("from"); value != "" {
+		if filter.From, err = time.Parse(analyticsDateLayout, value); err != nil {
+			return nil, errors.New("from must be date in YYYY-MM-DD format")
+		}
+	}
+
+	if filter.From.After(filter.To) {
+		return nil, errors.New("from must be less or equal than to")
+	}
+
+	if filter.To.Sub(filter.From) >= maxAnalyticsRangeDays*24*time.Hour {
+		return nil, fmt.Errorf("range must be not longer than %d days", maxAnalyticsRangeDays)
+	}
+
+	if value := query.Get("period"); value != "" {
+		switch entities.AnalyticsPeriod(value) {
+		case entities.AnalyticsPeriodDay, entities.AnalyticsPeriodWeek, entities.AnalyticsPeriodMonth:
+			filter.Period = entities.AnalyticsPeriod(value)
+		default:
+			return nil, errors.New("period must be one of: day, week, month")
+		}
+	}
+
+	return filter, nil
+}
+
+func mapPlatformStatsBucket(bucket *entities.PlatformStatsBucket) respPlatformStats {
+	return respPlatformStats{
+		Signups:          bucket.Signups,
+		NewTeachers:      bucket.NewTeachers,
+		SkillsApproved:   bucket.SkillsApproved,
+		LessonsBooked:    bucket.LessonsBooked,
+		LessonsStarted:   bucket.LessonsStarted,
+		LessonsCompleted: bucket.LessonsCompleted,
+		LessonsCancelled: bucket.LessonsCancelled,
+		LessonsRejected:  bucket.LessonsRejected,
+		GMV:              bucket.GMV,
+		CancellationRate: bucket.CancellationRate,
+		RejectionRate:    bucket.RejectionRate,
+	}
+}
+
+// @Description platform dashboard platformAnalyticsResponse.
+type platformAnalyticsResponse struct {
+	From          string                 `json:"from"           example:"2025-01-01"`
+	To            string                 `json:"to"             example:"2025-01-30"`
+	Period        string                 `json:"period"         example:"day"`
+	Total         respPlatformStats      `json:"total"`
+	Periods       []respPlatformPeriod   `json:"periods"`
+	TopCategories []respPlatformCategory `json:"top_categories"`
+	SkillApproval respSkillApproval      `json:"skill_approval"`
+}
+
+// @Description aggregated platform stats respPlatformStats, rates are from 0 to 1.
+type respPlatformStats struct {
+	Signups          int     `json:"signups"           example:"120"`
+	NewTeachers      int     `json:"new_teachers"      example:"8"`
+	SkillsApproved   int     `json:"skills_approved"   example:"11"`
+	LessonsBooked    int     `json:"lessons_booked"    example:"340"`
+	LessonsStarted   int     `json:"lessons_started"   example:"290"`
+	LessonsCompleted int     `json:"lessons_completed" example:"280"`
+	LessonsCancelled int     `json:"lessons_cancelled" example:"25"`
+	LessonsRejected  int     `json:"lessons_rejected"  example:"10"`
+	GMV              int64   `json:"gmv"               example:"140000"`
+	CancellationRate float64 `json:"cancellation_rate" example:"0.07"`
+	RejectionRate    float64 `json:"rejection_rate"    example:"0.03"`
+}
+
+// @Description stats of one day, week or month respPlatformPeriod.
+type respPlatformPeriod struct {
+	PeriodStart string `json:"period_start" example:"2025-01-06"`
+	respPlatformStats
+}
+
+// @Description lessons stats of one category respPlatformCategory.
+type respPlatformCategory struct {
+	CategoryID       int     `json:"category_id"       example:"1"`
+	CategoryName     string  `json:"category_name"     example:"Programming"`
+	LessonsBooked    int     `json:"lessons_booked"    example:"120"`
+	LessonsCompleted int     `json:"lessons_completed" example:"100"`
+	LessonsCancelled int     `json:"lessons_cancelled" example:"9"`
+	GMV              int64   `json:"gmv"               example:"50000"`
+	CancellationRate float64 `json:"cancellation_rate" example:"0.075"`
+}
+
+// @Description skill approval backlog respSkillApproval.
+type respSkillApproval struct {
+	PendingCount              int        `json:"pending_count"                  example:"14"`
+	OldestPendingSince        *time.Time `json:"oldest_pending_since,omitempty" example:"2025-01-02T10:10:10Z"`
+	ApprovedCount             int        `json:"approved_count"                 example:"11"`
+	MedianApprovalTimeSeconds int64      `json:"median_approval_time_seconds"   example:"86400"` // of approvals in range
+}
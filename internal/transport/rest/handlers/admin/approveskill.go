@@ -4,10 +4,10 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
 	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
 	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
-	"go.uber.org/zap"
 )
 
 const approveSkillRoute = "/skills/{id}/approve"
@@ -41,19 +41,10 @@ func (h *AdminHandlers) ApproveSkill() http.HandlerFunc {
 			return
 		}
 
-		isAdmin, err := h.service.CheckUserOnAdminByID(r.Context(), userID)
-		if err != nil {
-			h.log.Error("failed to check user on admin", zap.Error(err))
-			httputils.RespondWith500(w, h.log)
-			return
-		}
+		before := h.auditSnapshot(r.Context(), entities.AuditEntitySkill, &skillID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditSkillApprove, entities.AuditEntitySkill, &skillID, before)
 
-		if !isAdmin {
-			httputils.RespondWith403(w, serviceErrors.ErrorNotAdmin.Error(), h.log)
-			return
-		}
-
-		err = h.service.ApproveTeacherSkill(r.Context(), skillID)
+		err = h.service.ApproveTeacherSkill(r.Context(), skillID, auditEntry)
 
 		if err != nil {
 			switch {
@@ -68,6 +59,8 @@ func (h *AdminHandlers) ApproveSkill() http.HandlerFunc {
 			return
 		}
 
+		h.completeAudit(r.Context(), auditEntry)
+
 		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
 	}
 }
@@ -0,0 +1,281 @@
+package admin
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+)
+
+const (
+	auditLogRoute       = "/audit"
+	exportAuditLogRoute = "/audit/export"
+)
+
+// GetAuditLog returns http.HandlerFunc
+// @Summary get admin audit log
+// @Description returns one page of admin mutations (newest first) with before and after snapshots of changed entity. Use next_cursor from response as cursor param to get the next page (empty next_cursor means the last page)
+// @Tags admin
+// @Produce json
+// @Param admin_id query int false "Admin's user ID"
+// @Param action query string false "Action, e.g. skill.approve"
+// @Param entity_type query string false "Entity type, e.g. skill"
+// @Param entity_id query int false "Entity ID"
+// @Param from query string false "From time inclusive (RFC3339)"
+// @Param to query string false "To time exclusive (RFC3339)"
+// @Param limit query int false "Page size (default 50, max 200)"
+// @Param cursor query string false "Cursor of the page"
+// @Success 200 {object} auditLogResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/audit [get]
+// @Security     BearerAuth
+func (h *AdminHandlers) GetAuditLog() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter, err := parseAuditLogFilter(r.URL.Query())
+		if err != nil {
+			httputils.RespondWith400(w, err.Error(), h.log)
+
+			return
+		}
+
+		entries, nextCursor, err := h.service.GetAuditLog(r.Context(), filter, r.URL.Query().Get("cursor"))
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorInvalidCursor):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		resp := auditLogResponse{
+			Entries:    make([]respAuditEntry, 0, len(entries)),
+			NextCursor: nextCursor,
+		}
+
+		for _, entry := range entries {
+			resp.Entries = append(resp.Entries, respAuditEntry{
+				ID:         entry.ID,
+				AdminID:    entry.AdminID,
+				Action:     string(entry.Action),
+				EntityType: string(entry.EntityType),
+				EntityID:   entry.EntityID,
+				Before:     json.RawMessage(entry.Before),
+				After:      json.RawMessage(entry.After),
+				RequestID:  entry.RequestID,
+				CreatedAt:  entry.CreatedAt,
+			})
+		}
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+// ExportAuditLog returns http.HandlerFunc
+// @Summary export admin audit log
+// @Description returns all admin mutations matching filters (newest first) as CSV file, at most 10000 entries (narrow time range to export more)
+// @Tags admin
+// @Produce text/csv
+// @Param admin_id query int false "Admin's user ID"
+// @Param action query string false "Action, e.g. skill.approve"
+// @Param entity_type query string false "Entity type, e.g. skill"
+// @Param entity_id query int false "Entity ID"
+// @Param from query string false "From time inclusive (RFC3339)"
+// @Param to query string false "To time exclusive (RFC3339)"
+// @Success 200 {file} file
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/audit/export [get]
+// @Security     BearerAuth
+func (h *AdminHandlers) ExportAuditLog() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter, err := parseAuditLogFilter(r.URL.Query())
+		if err != nil {
+			httputils.RespondWith400(w, err.Error(), h.log)
+
+			return
+		}
+
+		entries, err := h.service.ExportAuditLog(r.Context(), filter)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorAuditExportTooLarge):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition",
+			fmt.Sprintf("attachment; filename=\"admin-audit-%s.csv\"", time.Now().UTC().Format("20060102-150405")))
+		w.WriteHeader(http.StatusOK)
+
+		writer := csv.NewWriter(w)
+		_ = writer.Write([]string{"id", "created_at", "admin_id", "action", "entity_type", "entity_id", "request_id", "before", "after"})
+
+		for _, entry := range entries {
+			entityID := ""
+			if entry.EntityID != nil {
+				entityID = strconv.Itoa(*entry.EntityID)
+			}
+
+			_ = writer.Write([]string{
+				strconv.Itoa(entry.ID),
+				entry.CreatedAt.UTC().Format(time.RFC3339),
+				strconv.Itoa(entry.AdminID),
+				string(entry.Action),
+				string(entry.EntityType),
+				entityID,
+				entry.RequestID,
+				string(entry.Before),
+				string(entry.After),
+			})
+		}
+
+		writer.Flush()
+
+		if err = writer.Error(); err != nil {
+			h.log.Error("failed to write audit log export", zap.Error(err))
+		}
+	}
+}
+
+// parseAuditLogFilter maps query params into filter, returns error with message for client.
+func parseAuditLogFilter(query url.Values) (*entities.AuditLogFilter, error) {
+	filter := &entities.AuditLogFilter{}
+
+	intParams := map[string]**int{
+		"admin_id":  &filter.AdminID,
+		"entity_id": &filter.EntityID,
+	}
+
+	for name, target := range intParams {
+		if value := query.Get(name); value != "" {
+			id, err := strconv.Atoi(value)
+			if err != nil || id <= 0 {
+				return nil, fmt.Errorf("%s must be positive number", name) //nolint:err113
+			}
+
+			*target = &id
+		}
+	}
+
+	if value := query.Get("action"); value != "" {
+		action := entities.AuditAction(value)
+		filter.Action = &action
+	}
+
+	if value := query.Get("entity_type"); value != "" {
+		entityType := entities.AuditEntityType(value)
+		filter.EntityType = &entityType
+	}
+
+	timeParams := map[string]**time.Time{
+		"from": &filter.From,
+		"to":   &filter.To,
+	}
+
+	for name, target := range timeParams {
+		if value := query.Get(name); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be time in RFC3339 format", name) //nolint:err113
+			}
+
+			*target = &t
+		}
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			return nil, errors.New("limit must be non-negative number")
+		}
+
+		filter.Limit = limit
+	}
+
+	return filter, nil
+}
+
+// auditSnapshot returns JSON snapshot of entity for audit log, nil if it can't be taken (error is only logged).
+func (h *AdminHandlers) auditSnapshot(ctx context.Context, entityType entities.AuditEntityType, entityID *int) []byte {
+	snapshot, err := h.service.SnapshotAuditEntity(ctx, entityType, entityID)
+	if err != nil {
+		h.log.Error("failed to take audit snapshot", zap.String("entity_type", string(entityType)), zap.Error(err))
+	}
+
+	return snapshot
+}
+
+// newAuditEntry returns entry of admin's mutation of entity with its snapshot before the mutation and id of request.
+// Entry is passed to the mutation and saved in the same transaction with it, so no mutation goes unaudited.
+func newAuditEntry(r *http.Request, adminID int, action entities.AuditAction,
+	entityType entities.AuditEntityType, entityID *int, before []byte) *entities.AdminAuditEntry {
+	return &entities.AdminAuditEntry{
+		AdminID:    adminID,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Before:     before,
+		RequestID:  middleware.GetReqID(r.Context()),
+	}
+}
+
+// completeAudit sets snapshot of entity after successful mutation (taken now) to its saved audit entry.
+func (h *AdminHandlers) completeAudit(ctx context.Context, entry *entities.AdminAuditEntry) {
+	h.setAuditAfter(ctx, entry, h.auditSnapshot(ctx, entry.EntityType, entry.EntityID))
+}
+
+// setAuditAfter sets after snapshot to saved audit entry of successful mutation.
+// Mutation is already applied and audited, so failure is only logged.
+func (h *AdminHandlers) setAuditAfter(ctx context.Context, entry *entities.AdminAuditEntry, after []byte) {
+	if err := h.service.CompleteAdminAction(ctx, entry, after); err != nil {
+		h.log.Error("failed to complete admin action audit",
+			zap.Int("audit_id", entry.ID),
+			zap.String("action", string(entry.Action)),
+			zap.Error(err),
+		)
+	}
+}
+
+type auditLogResponse struct {
+	Entries    []respAuditEntry `json:"entries"`
+	NextCursor string           `json:"next_cursor" example:"eyJpZCI6MTJ9"`
+}
+
+type respAuditEntry struct {
+	ID         int             `json:"id"                   example:"1"`
+	AdminID    int             `json:"admin_id"             example:"3"`
+	Action     string          `json:"action"               example:"skill.approve"`
+	EntityType string          `json:"entity_type"          example:"skill"`
+	EntityID   *int            `json:"entity_id,omitempty"  example:"12"`
+	Before     json.RawMessage `json:"before,omitempty"     swaggertype:"object"`
+	After      json.RawMessage `json:"after,omitempty"      swaggertype:"object"`
+	RequestID  string          `json:"request_id,omitempty" example:"host/abcdef-000001"`
+	CreatedAt  time.Time       `json:"created_at"           example:"2025-01-09T10:10:10Z"`
+}
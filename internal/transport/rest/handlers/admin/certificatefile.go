@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"go.uber.org/zap"
+)
+
+const getCertificateFileRoute = "/certificates/{id}/file"
+
+// GetCertificateFile returns http.HandlerFunc
+// @Summary get certificate file
+// @Description download file of any certificate, also not verified one (for verification)
+// @Tags admin
+// @Produce application/pdf,image/png,image/jpeg
+// @Param id path int true "Certificate ID"
+// @Success 200 {file} binary "Certificate file"
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/certificates/{id}/file [get]
+// @Security     BearerAuth
+func (h *AdminHandlers) GetCertificateFile() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		certificateID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		file, err := h.service.GetCertificateFileForReview(r.Context(), certificateID)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorCertificateNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		defer func() {
+			if closer, ok := file.FileReader.(io.Closer); ok {
+				if err := closer.Close(); err != nil {
+					h.log.Error("failed to close reader", zap.Error(err))
+				}
+			}
+		}()
+
+		contentType := "application/octet-stream"
+
+		for ct, extension := range entities.CertificateContentTypes {
+			if extension == file.Extension {
+				contentType = ct
+			}
+		}
+
+		if err = httputils.RespondWithFile(w, http.StatusOK, file.FileReader, contentType); err != nil {
+			h.log.Error("response error", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,445 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	complaintRoute           = "/complaints/{id}"
+	assignComplaintRoute     = "/complaints/{id}/assign"
+	complaintNotesRoute      = "/complaints/{id}/notes"
+	resolveComplaintRoute    = "/complaints/{id}/resolve"
+	complaintAttachmentRoute = "/complaints/{id}/attachments/{attachment_id}"
+)
+
+// GetComplaint returns http.HandlerFunc
+// @Summary get complaint
+// @Description get complaint with its state, assignee, resolution, evidence attachments and internal admin's notes. Complaint about lesson is returned with the lesson and its state transition history
+// @Tags admin
+// @Produce json
+// @Param id path int true "Complaint ID"
+// @Success 200 {object} getComplaintResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/complaints/{id} [get]
+// @Security     BearerAuth
+func (h *AdminHandlers) GetComplaint() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		complaintID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		details, err := h.service.GetComplaint(r.Context(), complaintID)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorComplaintNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		resp := getComplaintResponse{
+			Complaint:   newRespComplaint(details.Complaint),
+			Attachments: make([]respComplaintAttachment, 0, len(details.Complaint.Attachments)),
+			Notes:       make([]respComplaintNote, 0, len(details.Notes)),
+		}
+
+		for _, attachment := range details.Complaint.Attachments {
+			resp.Attachments = append(resp.Attachments, respComplaintAttachment{
+				AttachmentID: attachment.ID,
+				URL:          complaintAttachmentURL(attachment.ComplaintID, attachment.ID),
+				CreatedAt:    attachment.CreatedAt,
+			})
+		}
+
+		for _, note := range details.Notes {
+			resp.Notes = append(resp.Notes, respComplaintNote{
+				NoteID:    note.ID,
+				AuthorID:  note.AuthorID,
+				Text:      note.Text,
+				CreatedAt: note.CreatedAt,
+			})
+		}
+
+		if lesson := details.Lesson; lesson != nil {
+			resp.Lesson = &respComplaintLesson{
+				LessonID:     lesson.ID,
+				StudentID:    lesson.StudentID,
+				TeacherID:    lesson.TeacherID,
+				CategoryName: lesson.CategoryName,
+				Datetime:     lesson.ScheduleTimeDatetime,
+				Price:        lesson.Price,
+				History:      make([]respStateTransition, 0, len(details.LessonHistory)),
+			}
+
+			for _, transition := range details.LessonHistory {
+				resp.Lesson.History = append(resp.Lesson.History, respStateTransition{
+					FromState: (*string)(transition.FromState),
+					ToState:   string(transition.ToState),
+					ChangedAt: transition.ChangedAt,
+				})
+			}
+		}
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+// AssignComplaint returns http.HandlerFunc
+// @Summary assign complaint to yourself
+// @Description assign complaint to current admin. Open complaint is taken in review, complaint in review is reassigned
+// @Tags admin
+// @Produce json
+// @Param id path int true "Complaint ID"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 409 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/complaints/{id}/assign [put]
+// @Security     BearerAuth
+func (h *AdminHandlers) AssignComplaint() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		complaintID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		before := h.auditSnapshot(r.Context(), entities.AuditEntityComplaint, &complaintID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditComplaintAssign, entities.AuditEntityComplaint, &complaintID, before)
+
+		if err = h.service.AssignComplaint(r.Context(), userID, complaintID, auditEntry); err != nil {
+			h.respondComplaintChangeError(w, err)
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+// AddComplaintNote returns http.HandlerFunc
+// @Summary add note to complaint
+// @Description add internal admin's note to complaint, complainer never sees it
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Complaint ID"
+// @Param addComplaintNoteRequest body addComplaintNoteRequest true "Note"
+// @Success 201 {object} addComplaintNoteResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/complaints/{id}/notes [post]
+// @Security     BearerAuth
+func (h *AdminHandlers) AddComplaintNote() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		complaintID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		var req addComplaintNoteRequest
+		if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		if req.Text == "" {
+			httputils.RespondWith400(w, "text is empty", h.log)
+
+			return
+		}
+
+		note := &entities.ComplaintNote{
+			ComplaintID: complaintID,
+			AuthorID:    &userID,
+			Text:        req.Text,
+		}
+
+		auditEntry := newAuditEntry(r, userID, entities.AuditComplaintAddNote, entities.AuditEntityComplaint, &complaintID, nil)
+
+		id, err := h.service.AddComplaintNote(r.Context(), note, auditEntry)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorComplaintNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		note.ID = id
+
+		// complaint itself is not changed, so note is recorded as after snapshot
+		after, err := json.Marshal(note)
+		if err != nil {
+			h.log.Error("failed to marshal complaint note for audit", zap.Error(err))
+		}
+
+		h.setAuditAfter(r.Context(), auditEntry, after)
+
+		httputils.SuccessRespondWith201(w, addComplaintNoteResponse{ID: id}, h.log)
+	}
+}
+
+// ResolveComplaint returns http.HandlerFunc
+// @Summary resolve complaint
+// @Description close complaint as resolved (only in review) or dismissed with resolution. Complainer gets notification with resolution
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Complaint ID"
+// @Param resolveComplaintRequest body resolveComplaintRequest true "Resolution"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 409 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/complaints/{id}/resolve [put]
+// @Security     BearerAuth
+func (h *AdminHandlers) ResolveComplaint() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		complaintID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		var req resolveComplaintRequest
+		if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		if req.Resolution == "" {
+			httputils.RespondWith400(w, "resolution is empty", h.log)
+
+			return
+		}
+
+		state := entities.StateName(req.State)
+		if state != entities.Resolved && state != entities.Dismissed {
+			httputils.RespondWith400(w, "state must be resolved or dismissed", h.log)
+
+			return
+		}
+
+		resolution := &entities.ComplaintResolution{
+			ComplaintID: complaintID,
+			State:       state,
+			Resolution:  req.Resolution,
+			AdminID:     userID,
+		}
+
+		before := h.auditSnapshot(r.Context(), entities.AuditEntityComplaint, &complaintID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditComplaintResolve, entities.AuditEntityComplaint, &complaintID, before)
+
+		if err = h.service.ResolveComplaint(r.Context(), resolution, auditEntry); err != nil {
+			h.respondComplaintChangeError(w, err)
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+// GetComplaintAttachment returns http.HandlerFunc
+// @Summary get complaint attachment
+// @Description download evidence file attached to complaint
+// @Tags admin
+// @Produce application/pdf,image/png,image/jpeg,image/webp
+// @Param id path int true "Complaint ID"
+// @Param attachment_id path int true "Attachment ID"
+// @Success 200 {file} binary "Attachment file"
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/complaints/{id}/attachments/{attachment_id} [get]
+// @Security     BearerAuth
+func (h *AdminHandlers) GetComplaintAttachment() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		complaintID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		attachmentID, err := httputils.GetIntParamFromRequestPath(r, "attachment_id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {attachment_id} param in url path", h.log)
+
+			return
+		}
+
+		file, err := h.service.GetComplaintAttachmentFile(r.Context(), complaintID, attachmentID)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorComplaintAttachmentNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		defer func() {
+			if closer, ok := file.FileReader.(io.Closer); ok {
+				if err := closer.Close(); err != nil {
+					h.log.Error("failed to close reader", zap.Error(err))
+				}
+			}
+		}()
+
+		contentType := "application/octet-stream"
+
+		for ct, extension := range entities.ComplaintAttachmentContentTypes {
+			if extension == file.Extension {
+				contentType = ct
+			}
+		}
+
+		if err = httputils.RespondWithFile(w, http.StatusOK, file.FileReader, contentType); err != nil {
+			h.log.Error("response error", zap.Error(err))
+		}
+	}
+}
+
+func complaintAttachmentURL(complaintID, attachmentID int) string {
+	return fmt.Sprintf("/api/admin/complaints/%d/attachments/%d", complaintID, attachmentID)
+}
+
+func (h *AdminHandlers) respondComplaintChangeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, serviceErrors.ErrorComplaintNotFound):
+		httputils.RespondWith404(w, err.Error(), h.log)
+	case errors.Is(err, serviceErrors.ErrorUnavailableStateTransition):
+		httputils.RespondWith409(w, err.Error(), h.log)
+	default:
+		h.log.Error(err.Error())
+		httputils.RespondWith500(w, h.log)
+	}
+}
+
+type addComplaintNoteRequest struct {
+	Text string `json:"text" example:"asked reported user for explanation" binding:"required"`
+}
+
+type addComplaintNoteResponse struct {
+	ID int `json:"id" example:"7"`
+}
+
+type resolveComplaintRequest struct {
+	State      string `json:"state"      example:"resolved"        binding:"required"`
+	Resolution string `json:"resolution" example:"user was warned" binding:"required"`
+}
+
+type getComplaintResponse struct {
+	Complaint   respComplaint             `json:"complaint"`
+	Attachments []respComplaintAttachment `json:"attachments"`
+	Notes       []respComplaintNote       `json:"notes"`
+	Lesson      *respComplaintLesson      `json:"lesson,omitempty"`
+}
+
+type respComplaintAttachment struct {
+	AttachmentID int       `json:"attachment_id" example:"1"`
+	URL          string    `json:"url"           example:"/api/admin/complaints/1/attachments/1"`
+	CreatedAt    time.Time `json:"created_at"    example:"2025-01-09T10:10:10Z"`
+}
+
+type respComplaintLesson struct {
+	LessonID     int                   `json:"lesson_id"     example:"12"`
+	StudentID    int                   `json:"student_id"    example:"2"`
+	TeacherID    int                   `json:"teacher_id"    example:"1"`
+	CategoryName string                `json:"category_name" example:"Programming"`
+	Datetime     time.Time             `json:"datetime"      example:"2025-01-09T10:00:00Z"`
+	Price        int                   `json:"price"         example:"500"`
+	History      []respStateTransition `json:"history"`
+}
+
+type respStateTransition struct {
+	FromState *string   `json:"from_state,omitempty" example:"planned"` // empty for lesson creation
+	ToState   string    `json:"to_state"             example:"cancelled"`
+	ChangedAt time.Time `json:"changed_at"           example:"2025-01-09T09:00:00Z"`
+}
+
+type respComplaintNote struct {
+	NoteID    int       `json:"note_id"             example:"7"`
+	AuthorID  *int      `json:"author_id,omitempty" example:"3"`
+	Text      string    `json:"text"                example:"asked reported user for explanation"`
+	CreatedAt time.Time `json:"created_at"          example:"2025-01-09T10:10:10Z"`
+}
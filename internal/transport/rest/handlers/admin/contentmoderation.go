@@ -0,0 +1,186 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	moderationQueueRoute  = "/moderation"
+	decideModerationRoute = "/moderation/{id}/decide"
+)
+
+// GetModerationQueue returns http.HandlerFunc
+// @Summary get content moderation queue
+// @Description returns one page of pending user texts flagged by moderation (oldest first) with reasons. entity_id is id of review, complaint, skill, teacher, user, certificate or review report by kind. Use next_cursor from response as cursor param to get the next page (empty next_cursor means the last page)
+// @Tags admin
+// @Produce json
+// @Param kind query string false "Content kind" Enums(review, review_reply, complaint, skill_about, teacher_profile, user_name, certificate, review_report)
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor of the page"
+// @Success 200 {object} moderationQueueResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/moderation [get]
+// @Security     BearerAuth
+func (h *AdminHandlers) GetModerationQueue() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter := &entities.ModerationQueueFilter{}
+
+		if value := r.URL.Query().Get("kind"); value != "" {
+			kind := entities.ContentKind(value)
+			switch kind {
+			case entities.ContentReview, entities.ContentReviewReply, entities.ContentComplaint,
+				entities.ContentSkillAbout, entities.ContentTeacherProfile, entities.ContentUserName,
+				entities.ContentCertificate, entities.ContentReviewReport:
+			default:
+				httputils.RespondWith400(w, "kind must be review, review_reply, complaint, skill_about, teacher_profile, user_name, certificate or review_report", h.log)
+
+				return
+			}
+
+			filter.Kind = &kind
+		}
+
+		if value := r.URL.Query().Get("limit"); value != "" {
+			limit, err := strconv.Atoi(value)
+			if err != nil || limit < 0 {
+				httputils.RespondWith400(w, "limit must be non-negative number", h.log)
+
+				return
+			}
+
+			filter.Limit = limit
+		}
+
+		items, nextCursor, err := h.service.GetModerationQueue(r.Context(), filter, r.URL.Query().Get("cursor"))
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorInvalidCursor):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		resp := moderationQueueResponse{
+			Items:      make([]respModerationQueueItem, 0, len(items)),
+			NextCursor: nextCursor,
+		}
+
+		for _, item := range items {
+			resp.Items = append(resp.Items, respModerationQueueItem{
+				ID:        item.ID,
+				Kind:      string(item.Kind),
+				AuthorID:  item.AuthorID,
+				EntityID:  item.EntityID,
+				Text:      item.Text,
+				Reasons:   item.Reasons,
+				CreatedAt: item.CreatedAt,
+			})
+		}
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+// DecideModeration returns http.HandlerFunc
+// @Summary decide on flagged content
+// @Description closes pending item of moderation queue: approved (text is fine) or violation (text breaks rules; act on it with review moderation, complaints or suspensions)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Moderation queue item ID"
+// @Param decideModerationRequest body decideModerationRequest true "Decision"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 409 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/moderation/{id}/decide [post]
+// @Security     BearerAuth
+func (h *AdminHandlers) DecideModeration() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		itemID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		var req decideModerationRequest
+		if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		before := h.auditSnapshot(r.Context(), entities.AuditEntityModerationItem, &itemID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditModerationDecide, entities.AuditEntityModerationItem, &itemID, before)
+
+		err = h.service.DecideModerationQueueItem(r.Context(), userID, itemID, entities.ModerationDecision(req.Decision), auditEntry)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorUnknownModerationDecision):
+				httputils.RespondWith400(w, "decision must be approved or violation", h.log)
+			case errors.Is(err, serviceErrors.ErrorModerationItemNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorModerationItemDecided):
+				httputils.RespondWith409(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+type decideModerationRequest struct {
+	Decision string `json:"decision" example:"approved" binding:"required"`
+}
+
+type moderationQueueResponse struct {
+	Items      []respModerationQueueItem `json:"items"`
+	NextCursor string                    `json:"next_cursor" example:"eyJpZCI6MTJ9"`
+}
+
+type respModerationQueueItem struct {
+	ID        int       `json:"id"                  example:"1"`
+	Kind      string    `json:"kind"                example:"review"`
+	AuthorID  *int      `json:"author_id,omitempty" example:"2"`
+	EntityID  *int      `json:"entity_id,omitempty" example:"5"`
+	Text      string    `json:"text"                example:"write me at john@example.com"`
+	Reasons   []string  `json:"reasons"`
+	CreatedAt time.Time `json:"created_at"          example:"2025-01-09T10:10:10Z"`
+}
@@ -0,0 +1,103 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const createCategoryRoute = "/categories"
+
+// CreateCategory returns http.HandlerFunc
+// @Summary create category
+// @Description create category, it becomes subcategory if parent_id is set. New category is the last one among its siblings
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param createCategoryRequest body createCategoryRequest true "Category data"
+// @Success 201 {object} createCategoryResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 409 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/categories [post]
+// @Security     BearerAuth
+func (h *AdminHandlers) CreateCategory() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		var req createCategoryRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		req.Name = strings.TrimSpace(req.Name)
+		if req.Name == "" {
+			httputils.RespondWith400(w, "name is required", h.log)
+
+			return
+		}
+
+		if req.MinAge < 0 {
+			httputils.RespondWith400(w, "min_age must be not negative", h.log)
+
+			return
+		}
+
+		// id of category is set to entry on creation
+		auditEntry := newAuditEntry(r, userID, entities.AuditCategoryCreate, entities.AuditEntityCategory, nil, nil)
+
+		id, err := h.service.CreateCategory(r.Context(), &entities.Category{
+			Name:     req.Name,
+			MinAge:   req.MinAge,
+			ParentID: req.ParentID,
+		}, auditEntry)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorCategoryParentNotFound),
+				errors.Is(err, serviceErrors.ErrorCategoryParentArchived):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorCategoryExists):
+				httputils.RespondWith409(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith201(w, createCategoryResponse{ID: id}, h.log)
+	}
+}
+
+type createCategoryRequest struct {
+	Name     string `json:"name"      example:"Business English"`
+	MinAge   int    `json:"min_age"   example:"16"`
+	ParentID *int   `json:"parent_id" example:"5"`
+}
+
+// @Description id of created category.
+type createCategoryResponse struct {
+	ID int `json:"id" example:"13"`
+}
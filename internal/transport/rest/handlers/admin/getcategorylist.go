@@ -0,0 +1,64 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+)
+
+const getCategoryListRoute = "/categories"
+
+// GetCategoryList returns http.HandlerFunc
+// @Summary get all categories
+// @Description returns all categories including archived ones, sorted by parent and position
+// @Tags admin
+// @Produce json
+// @Success 200 {object} getAdminCategoriesResponse
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/categories [get]
+// @Security     BearerAuth
+func (h *AdminHandlers) GetCategoryList() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		categories, err := h.service.GetAllCategories(r.Context())
+		if err != nil {
+			h.log.Error(err.Error())
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		resp := &getAdminCategoriesResponse{
+			Categories: make([]respAdminCategory, 0, len(categories)),
+		}
+
+		for _, c := range categories {
+			resp.Categories = append(resp.Categories, respAdminCategory{
+				ID:         c.ID,
+				Name:       c.Name,
+				MinAge:     c.MinAge,
+				ParentID:   c.ParentID,
+				Position:   c.Position,
+				IsArchived: c.IsArchived,
+			})
+		}
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+// @Description all categories getAdminCategoriesResponse.
+type getAdminCategoriesResponse struct {
+	Categories []respAdminCategory `json:"categories"`
+}
+
+// @Description data of respAdminCategory.
+type respAdminCategory struct {
+	ID         int    `json:"id"                  example:"13"`
+	Name       string `json:"name"                example:"Business English"`
+	MinAge     int    `json:"min_age"             example:"16"`
+	ParentID   *int   `json:"parent_id,omitempty" example:"5"`
+	Position   int    `json:"position"            example:"0"`
+	IsArchived bool   `json:"is_archived"         example:"false"`
+}
@@ -0,0 +1,83 @@
+package admin
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+)
+
+const getCertificateListRoute = "/certificates"
+
+// GetUnverifiedCertificateList returns http.HandlerFunc
+// @Summary get unverified certificates (and their teachers sort data)
+// @Description returns certificates which wait for verification (+ certificate's teachers sort data as addtional list). File can be got by /api/admin/certificates/{certificate_id}/file
+// @Tags admin
+// @Produce json
+// @Success 200 {object} getCertificateListResponse
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/certificates [get]
+// @Security     BearerAuth
+func (h *AdminHandlers) GetUnverifiedCertificateList() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		certificates, err := h.service.GetUnverifiedCertificateList(r.Context())
+		if err != nil {
+			h.log.Error(err.Error())
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		teacherIDs := make([]int, 0, len(certificates))
+		for i := range certificates {
+			teacherIDs = append(teacherIDs, certificates[i].TeacherID)
+		}
+
+		teachersUserData, err := h.service.GetTeacherShortDataListByIDs(r.Context(), teacherIDs)
+		if err != nil {
+			h.log.Error(err.Error())
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		resp := getCertificateListResponse{
+			Certificates: make([]respCertificate, 0, len(certificates)),
+			Teachers:     make([]respTeacherShortData, 0, len(teachersUserData)),
+		}
+
+		for i := range certificates {
+			resp.Certificates = append(resp.Certificates, respCertificate{
+				CertificateID: certificates[i].ID,
+				TeacherID:     certificates[i].TeacherID,
+				Title:         certificates[i].Title,
+				CreatedAt:     certificates[i].CreatedAt,
+			})
+		}
+
+		for i := range teachersUserData {
+			resp.Teachers = append(resp.Teachers, respTeacherShortData{
+				TeacherID: teachersUserData[i].TeacherData.ID,
+				Name:      teachersUserData[i].Name,
+				Surname:   teachersUserData[i].Surname,
+				Avatar:    teachersUserData[i].Avatar,
+			})
+		}
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+type getCertificateListResponse struct {
+	Certificates []respCertificate      `json:"certificates"`
+	Teachers     []respTeacherShortData `json:"teachers"`
+}
+
+type respCertificate struct {
+	CertificateID int       `json:"certificate_id" example:"1"`
+	TeacherID     int       `json:"teacher_id"     example:"1"`
+	Title         string    `json:"title"          example:"IELTS 8.0"`
+	CreatedAt     time.Time `json:"created_at"     example:"2025-01-01T10:10:10Z"`
+}
@@ -1,9 +1,13 @@
 package admin
 
 import (
+	"errors"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
 	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
 	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
@@ -14,13 +18,17 @@ const getComplaintListRoute = "/complaints"
 
 // GetAllComplaintList returns http.HandlerFunc
 // @Summary get complaint's list
-// @Description returns the list of complaints
+// @Description returns one page of complaints (newest first) with their state, assignee and resolution. Use next_cursor from response as cursor param to get the next page (empty next_cursor means the last page)
 // @Tags admin
 // @Produce json
+// @Param state query string false "Complaint state" Enums(open, in_review, resolved, dismissed)
+// @Param assignee query string false "Assignee: admin's user ID or me"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor of the page"
 // @Success 200 {object} getComplaintListResponse
+// @Failure 400 {object} httputils.ErrorStruct
 // @Failure 401 {object} httputils.ErrorStruct
 // @Failure 403 {object} httputils.ErrorStruct
-// @Failure 409 {object} httputils.ErrorStruct
 // @Failure 500 {object} httputils.ErrorStruct
 // @Router /admin/complaints [get]
 // @Security     BearerAuth
@@ -35,24 +43,19 @@ func (h *AdminHandlers) GetAllComplaintList() http.HandlerFunc {
 			return
 		}
 
-		isAdmin, err := h.service.CheckUserOnAdminByID(r.Context(), userID)
+		filter, err := parseComplaintListFilter(r.URL.Query(), userID)
 		if err != nil {
-			h.log.Error("failed to check user on admin", zap.Error(err))
-			httputils.RespondWith500(w, h.log)
+			httputils.RespondWith400(w, err.Error(), h.log)
 
 			return
 		}
 
-		if !isAdmin {
-			httputils.RespondWith403(w, serviceErrors.ErrorNotAdmin.Error(), h.log)
-
-			return
-		}
-
-		complaints, err := h.service.GetComplaintList(r.Context())
+		complaints, nextCursor, err := h.service.GetComplaintList(r.Context(), filter, r.URL.Query().Get("cursor"))
 
 		if err != nil {
 			switch {
+			case errors.Is(err, serviceErrors.ErrorInvalidCursor):
+				httputils.RespondWith400(w, err.Error(), h.log)
 			default:
 				h.log.Error(err.Error())
 				httputils.RespondWith500(w, h.log)
@@ -63,33 +66,86 @@ func (h *AdminHandlers) GetAllComplaintList() http.HandlerFunc {
 
 		resp := getComplaintListResponse{
 			Complaints: make([]respComplaint, 0, len(complaints)),
+			NextCursor: nextCursor,
 		}
 
 		for i := range complaints {
-			resp.Complaints = append(resp.Complaints, respComplaint{
-				ComplaintID:       complaints[i].ID,
-				ComplainerID:      complaints[i].ComplainerID,
-				ComplainerName:    complaints[i].Complainer.Name,
-				ComplainerSurname: complaints[i].Complainer.Surname,
-				ComplainerEmail:   complaints[i].Complainer.Email,
-				ComplainerAvatar:  complaints[i].Complainer.Avatar,
-				ReportedID:        complaints[i].ReportedID,
-				ReportedName:      complaints[i].Reported.Name,
-				ReportedSurname:   complaints[i].Reported.Surname,
-				ReportedEmail:     complaints[i].Reported.Email,
-				ReportedAvatar:    complaints[i].Reported.Avatar,
-				Reason:            complaints[i].Reason,
-				Description:       complaints[i].Description,
-				Date:              complaints[i].CreatedAt,
-			})
+			resp.Complaints = append(resp.Complaints, newRespComplaint(complaints[i]))
 		}
 
 		httputils.SuccessRespondWith200(w, resp, h.log)
 	}
 }
 
+// parseComplaintListFilter maps query params into filter, returns error with message for client.
+func parseComplaintListFilter(query url.Values, userID int) (*entities.ComplaintListFilter, error) {
+	filter := &entities.ComplaintListFilter{}
+
+	if value := query.Get("state"); value != "" {
+		state := entities.StateName(value)
+		switch state {
+		case entities.Open, entities.InReview, entities.Resolved, entities.Dismissed:
+		default:
+			return nil, errors.New("state must be open, in_review, resolved or dismissed")
+		}
+
+		filter.State = &state
+	}
+
+	if value := query.Get("assignee"); value != "" {
+		assigneeID := userID
+		if value != "me" {
+			id, err := strconv.Atoi(value)
+			if err != nil || id <= 0 {
+				return nil, errors.New("assignee must be positive number or me")
+			}
+
+			assigneeID = id
+		}
+
+		filter.AssigneeID = &assigneeID
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			return nil, errors.New("limit must be non-negative number")
+		}
+
+		filter.Limit = limit
+	}
+
+	return filter, nil
+}
+
+func newRespComplaint(complaint *entities.Complaint) respComplaint {
+	return respComplaint{
+		ComplaintID:       complaint.ID,
+		ComplainerID:      complaint.ComplainerID,
+		ComplainerName:    complaint.Complainer.Name,
+		ComplainerSurname: complaint.Complainer.Surname,
+		ComplainerEmail:   complaint.Complainer.Email,
+		ComplainerAvatar:  complaint.Complainer.Avatar,
+		ReportedID:        complaint.ReportedID,
+		ReportedName:      complaint.Reported.Name,
+		ReportedSurname:   complaint.Reported.Surname,
+		ReportedEmail:     complaint.Reported.Email,
+		ReportedAvatar:    complaint.Reported.Avatar,
+		LessonID:          complaint.LessonID,
+		Reason:            complaint.Reason,
+		Description:       complaint.Description,
+		Date:              complaint.CreatedAt,
+		State:             string(complaint.StateName),
+		AssigneeID:        complaint.AssigneeID,
+		Resolution:        complaint.Resolution,
+		ResolvedBy:        complaint.ResolvedBy,
+		ResolvedAt:        complaint.ResolvedAt,
+	}
+}
+
 type getComplaintListResponse struct {
 	Complaints []respComplaint `json:"complaints"`
+	NextCursor string          `json:"next_cursor" example:"eyJpZCI6MTJ9"`
 }
 
 type respComplaint struct {
@@ -107,7 +163,15 @@ type respComplaint struct {
 	ReportedEmail   string `json:"reported_email"   example:"test@test.com"`
 	ReportedAvatar  string `json:"reported_avatar"  example:"uuid.png"`
 
+	LessonID *int `json:"lesson_id,omitempty" example:"12"`
+
 	Reason      string    `json:"reason"      example:"reason"`
 	Description string    `json:"description" example:"description"`
 	Date        time.Time `json:"date"        example:"2025-01-09T10:10:10+09:00"`
+
+	State      string     `json:"state"                 example:"in_review"`
+	AssigneeID *int       `json:"assignee_id,omitempty" example:"3"`
+	Resolution *string    `json:"resolution,omitempty"  example:"user was warned"`
+	ResolvedBy *int       `json:"resolved_by,omitempty" example:"3"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty" example:"2025-01-10T10:10:10+09:00"`
 }
@@ -1,14 +1,12 @@
 package admin
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
-	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
-	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
-	"go.uber.org/zap"
 )
 
 const getSkillListRoute = "/skills"
@@ -27,15 +25,6 @@ const getSkillListRoute = "/skills"
 // @Security     BearerAuth
 func (h *AdminHandlers) GetSkillList() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		userIDValue := r.Context().Value(jwt.UserIDKey)
-		userID, ok := userIDValue.(int)
-		if !ok || userID == 0 {
-			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
-			httputils.RespondWith500(w, h.log)
-
-			return
-		}
-
 		// get query flag
 		isUnactive := r.URL.Query().Get("unactive")
 		isUnactiveBool, err := strconv.ParseBool(isUnactive)
@@ -43,21 +32,6 @@ func (h *AdminHandlers) GetSkillList() http.HandlerFunc {
 			isUnactiveBool = false
 		}
 
-		isAdmin, err := h.service.CheckUserOnAdminByID(r.Context(), userID)
-
-		if err != nil {
-			h.log.Error("failed to check user on admin", zap.Error(err))
-			httputils.RespondWith500(w, h.log)
-
-			return
-		}
-
-		if !isAdmin {
-			httputils.RespondWith403(w, serviceErrors.ErrorNotAdmin.Error(), h.log)
-
-			return
-		}
-
 		var skills []entities.Skill
 
 		if isUnactiveBool {
@@ -93,14 +67,17 @@ func (h *AdminHandlers) GetSkillList() http.HandlerFunc {
 
 		for i := range skills {
 			resp.Skills = append(resp.Skills, respSkill{
-				SkillID:       skills[i].ID,
-				TeacherID:     skills[i].TeacherID,
-				CategoryID:    skills[i].CategoryID,
-				VideoCardLink: skills[i].VideoCardLink,
-				About:         skills[i].About,
-				Rate:          skills[i].Rate,
-				ReviewsCount:  skills[i].ReviewsCount,
-				IsActive:      skills[i].IsActive,
+				SkillID:           skills[i].ID,
+				TeacherID:         skills[i].TeacherID,
+				CategoryID:        skills[i].CategoryID,
+				VideoCardLink:     skills[i].VideoCardLink,
+				VideoCardURL:      videoCardURL(skills[i].ID, skills[i].VideoCardFile),
+				VideoCardDuration: skills[i].VideoCardDuration,
+				About:             skills[i].About,
+				Price:             skills[i].Price,
+				Rate:              skills[i].Rate,
+				ReviewsCount:      skills[i].ReviewsCount,
+				IsActive:          skills[i].IsActive,
 			})
 		}
 
@@ -123,14 +100,17 @@ type getSkillListResponse struct {
 }
 
 type respSkill struct {
-	SkillID       int     `json:"skill_id"        example:"1"`
-	TeacherID     int     `json:"teacher_id"      example:"1"`
-	CategoryID    int     `json:"category_id"     example:"1"`
-	VideoCardLink string  `json:"video_card_link" example:"https://youtu.be/HIcSWuKMwOw?si=FtxN1QJU9ZWnXy85"`
-	About         string  `json:"about"           example:"about me..."`
-	Rate          float32 `json:"rate"            example:"5"`
-	ReviewsCount  int     `json:"reviews_count"   example:"1"`
-	IsActive      bool    `json:"is_active"       example:"false"`
+	SkillID           int     `json:"skill_id"        example:"1"`
+	TeacherID         int     `json:"teacher_id"      example:"1"`
+	CategoryID        int     `json:"category_id"     example:"1"`
+	VideoCardLink     string  `json:"video_card_link" example:"https://youtu.be/HIcSWuKMwOw?si=FtxN1QJU9ZWnXy85"`
+	VideoCardURL      string  `json:"video_card_url"      example:"/api/admin/skills/1/video"` // for preview, empty if video isn't uploaded
+	VideoCardDuration int     `json:"video_card_duration" example:"60"`                        // seconds
+	About             string  `json:"about"           example:"about me..."`
+	Price             int     `json:"price"           example:"500"`
+	Rate              float32 `json:"rate"            example:"5"`
+	ReviewsCount      int     `json:"reviews_count"   example:"1"`
+	IsActive          bool    `json:"is_active"       example:"false"`
 }
 
 type respTeacherShortData struct {
@@ -139,3 +119,12 @@ type respTeacherShortData struct {
 	Surname   string `json:"surname"         example:"Smith"`
 	Avatar    string `json:"avatar"          example:"uuid.png"`
 }
+
+// videoCardURL returns path of uploaded video card, empty if video isn't uploaded.
+func videoCardURL(skillID int, videoCardFile string) string {
+	if videoCardFile == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("/api/admin/skills/%d/video", skillID)
+}
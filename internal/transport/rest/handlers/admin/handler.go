@@ -7,6 +7,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	"github.com/LearnShareApp/learn-share-backend/pkg/storage/object"
 	"github.com/go-chi/chi/v5"
 )
 
@@ -15,12 +16,49 @@ const (
 )
 
 type AdminService interface {
-	CheckUserOnAdminByID(ctx context.Context, id int) (bool, error)
-	ApproveTeacherSkill(ctx context.Context, skillID int) error
-	GetComplaintList(ctx context.Context) ([]*entities.Complaint, error)
+	ApproveTeacherSkill(ctx context.Context, skillID int, auditEntry *entities.AdminAuditEntry) error
+	GetComplaintList(ctx context.Context, filter *entities.ComplaintListFilter, cursor string) ([]*entities.Complaint, string, error)
+	GetComplaint(ctx context.Context, id int) (*entities.ComplaintDetails, error)
+	GetComplaintAttachmentFile(ctx context.Context, complaintID, attachmentID int) (*object.File, error)
+	AssignComplaint(ctx context.Context, adminID, complaintID int, auditEntry *entities.AdminAuditEntry) error
+	AddComplaintNote(ctx context.Context, note *entities.ComplaintNote, auditEntry *entities.AdminAuditEntry) (int, error)
+	ResolveComplaint(ctx context.Context, resolution *entities.ComplaintResolution, auditEntry *entities.AdminAuditEntry) error
+	SuspendUser(ctx context.Context, suspension *entities.UserSuspension, auditEntry *entities.AdminAuditEntry) error
+	LiftSuspension(ctx context.Context, adminID, userID int, auditEntry *entities.AdminAuditEntry) error
 	GetSkillList(ctx context.Context) ([]entities.Skill, error)
 	GetUnactiveSkillList(ctx context.Context) ([]entities.Skill, error)
+	GetSkillVideoCardForReview(ctx context.Context, skillID int) (*object.File, error)
 	GetTeacherShortDataListByIDs(ctx context.Context, TeacherIDs []int) ([]entities.User, error)
+	GetUnverifiedCertificateList(ctx context.Context) ([]entities.TeacherCertificate, error)
+	GetCertificateFileForReview(ctx context.Context, certificateID int) (*object.File, error)
+	VerifyTeacherCertificate(ctx context.Context, certificateID int, auditEntry *entities.AdminAuditEntry) error
+	GetAllCategories(ctx context.Context) ([]*entities.Category, error)
+	CreateCategory(ctx context.Context, category *entities.Category, auditEntry *entities.AdminAuditEntry) (int, error)
+	UpdateCategory(ctx context.Context, id int, name *string, minAge *int, parentID *int, auditEntry *entities.AdminAuditEntry) error
+	ArchiveCategory(ctx context.Context, id int, auditEntry *entities.AdminAuditEntry) error
+	RestoreCategory(ctx context.Context, id int, auditEntry *entities.AdminAuditEntry) error
+	ReorderCategories(ctx context.Context, parentID *int, ids []int, auditEntry *entities.AdminAuditEntry) error
+	GetAgeExceptions(ctx context.Context, categoryID int) ([]entities.CategoryAgeException, error)
+	GrantAgeException(ctx context.Context, categoryID, userID, adminID int, auditEntry *entities.AdminAuditEntry) error
+	RevokeAgeException(ctx context.Context, categoryID, userID int, auditEntry *entities.AdminAuditEntry) error
+	RepairRatingAggregates(ctx context.Context, auditEntry *entities.AdminAuditEntry) ([]entities.RatingDrift, error)
+	GetReviewModerationQueue(ctx context.Context) ([]*entities.ReviewModerationItem, error)
+	ModerateReview(ctx context.Context, moderation *entities.ReviewModeration, auditEntry *entities.AdminAuditEntry) error
+	GetModerationQueue(ctx context.Context, filter *entities.ModerationQueueFilter, cursor string) ([]*entities.ModerationQueueItem, string, error)
+	DecideModerationQueueItem(ctx context.Context, adminID, itemID int, decision entities.ModerationDecision, auditEntry *entities.AdminAuditEntry) error
+	CompleteAdminAction(ctx context.Context, entry *entities.AdminAuditEntry, after []byte) error
+	SnapshotAuditEntity(ctx context.Context, entityType entities.AuditEntityType, entityID *int) ([]byte, error)
+	GetAuditLog(ctx context.Context, filter *entities.AuditLogFilter, cursor string) ([]*entities.AdminAuditEntry, string, error)
+	ExportAuditLog(ctx context.Context, filter *entities.AuditLogFilter) ([]*entities.AdminAuditEntry, error)
+	GetRoles(ctx context.Context) ([]*entities.Role, error)
+	AssignRole(ctx context.Context, adminID, userID int, roleName entities.RoleName, auditEntry *entities.AdminAuditEntry) error
+	RevokeRole(ctx context.Context, userID int, roleName entities.RoleName, auditEntry *entities.AdminAuditEntry) error
+	SearchUsers(ctx context.Context, filter *entities.UserSearchFilter, cursor string) ([]*entities.User, string, error)
+	GetUserOverview(ctx context.Context, userID int) (*entities.UserOverview, error)
+	ResetUserAvatar(ctx context.Context, userID int, auditEntry *entities.AdminAuditEntry) error
+	RequireEmailVerification(ctx context.Context, userID int, auditEntry *entities.AdminAuditEntry) error
+	MergeUsers(ctx context.Context, targetID, sourceID int, auditEntry *entities.AdminAuditEntry) error
+	GetPlatformAnalytics(ctx context.Context, filter entities.PlatformAnalyticsFilter) (*entities.PlatformAnalytics, error)
 }
 
 type AdminHandlers struct {
@@ -35,15 +73,64 @@ func NewAdminHandlers(adminService AdminService, log *zap.Logger) *AdminHandlers
 	}
 }
 
-func (h *AdminHandlers) SetupAdminRoutes(router *chi.Mux, authMiddleware func(http.Handler) http.Handler) {
+// SetupAdminRoutes registers admin routes, each of them requires its permission.
+func (h *AdminHandlers) SetupAdminRoutes(router *chi.Mux, authMiddleware func(http.Handler) http.Handler,
+	permissionMiddleware func(permission entities.Permission) func(http.Handler) http.Handler) {
 	adminRouter := chi.NewRouter()
 
 	adminRouter.Group(func(r chi.Router) {
 		r.Use(authMiddleware)
 
-		r.Get(getComplaintListRoute, h.GetAllComplaintList())
-		r.Get(getSkillListRoute, h.GetSkillList())
-		r.Put(approveSkillRoute, h.ApproveSkill())
+		require := func(permission entities.Permission) chi.Router {
+			return r.With(permissionMiddleware(permission))
+		}
+
+		require(entities.PermissionComplaintsView).Get(getComplaintListRoute, h.GetAllComplaintList())
+		require(entities.PermissionComplaintsView).Get(complaintRoute, h.GetComplaint())
+		require(entities.PermissionComplaintsView).Get(complaintAttachmentRoute, h.GetComplaintAttachment())
+		require(entities.PermissionComplaintsManage).Put(assignComplaintRoute, h.AssignComplaint())
+		require(entities.PermissionComplaintsManage).Post(complaintNotesRoute, h.AddComplaintNote())
+		require(entities.PermissionComplaintsResolve).Put(resolveComplaintRoute, h.ResolveComplaint())
+
+		require(entities.PermissionSkillsView).Get(getSkillListRoute, h.GetSkillList())
+		require(entities.PermissionSkillsView).Get(skillVideoCardRoute, h.GetSkillVideoCard())
+		require(entities.PermissionSkillsApprove).Put(approveSkillRoute, h.ApproveSkill())
+		require(entities.PermissionCertificatesView).Get(getCertificateListRoute, h.GetUnverifiedCertificateList())
+		require(entities.PermissionCertificatesView).Get(getCertificateFileRoute, h.GetCertificateFile())
+		require(entities.PermissionCertificatesVerify).Put(verifyCertificateRoute, h.VerifyCertificate())
+
+		require(entities.PermissionCategoriesManage).Get(getCategoryListRoute, h.GetCategoryList())
+		require(entities.PermissionCategoriesManage).Post(createCategoryRoute, h.CreateCategory())
+		require(entities.PermissionCategoriesManage).Put(reorderCategoriesRoute, h.ReorderCategories())
+		require(entities.PermissionCategoriesManage).Patch(updateCategoryRoute, h.UpdateCategory())
+		require(entities.PermissionCategoriesManage).Put(archiveCategoryRoute, h.ArchiveCategory())
+		require(entities.PermissionCategoriesManage).Put(restoreCategoryRoute, h.RestoreCategory())
+		require(entities.PermissionCategoriesManage).Get(ageExceptionsRoute, h.GetAgeExceptionList())
+		require(entities.PermissionCategoriesManage).Post(ageExceptionsRoute, h.GrantAgeException())
+		require(entities.PermissionCategoriesManage).Delete(revokeAgeExceptionRoute, h.RevokeAgeException())
+
+		require(entities.PermissionRatingsRepair).Post(repairRatingsRoute, h.RepairRatings())
+		require(entities.PermissionReviewsModerate).Get(reviewModerationQueueRoute, h.GetReviewModerationQueue())
+		require(entities.PermissionReviewsModerate).Post(moderateReviewRoute, h.ModerateReview())
+		require(entities.PermissionContentModerate).Get(moderationQueueRoute, h.GetModerationQueue())
+		require(entities.PermissionContentModerate).Post(decideModerationRoute, h.DecideModeration())
+
+		require(entities.PermissionUsersView).Get(usersRoute, h.SearchUsers())
+		require(entities.PermissionUsersView).Get(userRoute, h.GetUserOverview())
+		require(entities.PermissionUsersManage).Delete(userAvatarRoute, h.ResetUserAvatar())
+		require(entities.PermissionUsersManage).Post(userEmailVerificationRoute, h.RequireEmailVerification())
+		require(entities.PermissionUsersMerge).Post(mergeUserRoute, h.MergeUser())
+		require(entities.PermissionUsersSuspend).Post(suspendUserRoute, h.SuspendUser())
+		require(entities.PermissionUsersSuspend).Delete(userSuspensionRoute, h.LiftUserSuspension())
+
+		require(entities.PermissionAnalyticsView).Get(analyticsRoute, h.GetPlatformAnalytics())
+
+		require(entities.PermissionAuditView).Get(auditLogRoute, h.GetAuditLog())
+		require(entities.PermissionAuditView).Get(exportAuditLogRoute, h.ExportAuditLog())
+
+		require(entities.PermissionRolesManage).Get(rolesRoute, h.GetRoles())
+		require(entities.PermissionRolesManage).Post(userRolesRoute, h.AssignUserRole())
+		require(entities.PermissionRolesManage).Delete(userRoleRoute, h.RevokeUserRole())
 	})
 
 	router.Mount(adminRoute, adminRouter)
@@ -0,0 +1,80 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const reorderCategoriesRoute = "/categories/reorder"
+
+// ReorderCategories returns http.HandlerFunc
+// @Summary reorder categories
+// @Description set order of subcategories of parent_id (root categories if parent_id is omitted). category_ids must contain all of them in new order
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param reorderCategoriesRequest body reorderCategoriesRequest true "New order"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/categories/reorder [put]
+// @Security     BearerAuth
+func (h *AdminHandlers) ReorderCategories() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		var req reorderCategoriesRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		if len(req.CategoryIDs) == 0 {
+			httputils.RespondWith400(w, "category_ids are required", h.log)
+
+			return
+		}
+
+		before := h.auditSnapshot(r.Context(), entities.AuditEntityCategoryOrder, req.ParentID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditCategoryReorder, entities.AuditEntityCategoryOrder, req.ParentID, before)
+
+		if err := h.service.ReorderCategories(r.Context(), req.ParentID, req.CategoryIDs, auditEntry); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorCategoryReorderMismatch):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+type reorderCategoriesRequest struct {
+	ParentID    *int  `json:"parent_id"    example:"5"`
+	CategoryIDs []int `json:"category_ids" example:"14,13,15"`
+}
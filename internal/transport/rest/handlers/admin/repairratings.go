@@ -0,0 +1,95 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const repairRatingsRoute = "/reviews/repair-ratings"
+
+// RepairRatings returns http.HandlerFunc
+// @Summary repair rating aggregates
+// @Description recompute reviews count, total score and rate of all skills and teachers from reviews, returns every fixed drift (old and new values)
+// @Tags admin
+// @Produce json
+// @Success 200 {object} repairRatingsResponse
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/reviews/repair-ratings [post]
+// @Security     BearerAuth
+func (h *AdminHandlers) RepairRatings() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		auditEntry := newAuditEntry(r, userID, entities.AuditRatingsRepair, entities.AuditEntityRatings, nil, nil)
+
+		drifts, err := h.service.RepairRatingAggregates(r.Context(), auditEntry)
+		if err != nil {
+			h.log.Error(err.Error())
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		if len(drifts) > 0 {
+			h.log.Warn("rating aggregates drift was repaired", zap.Int("count", len(drifts)))
+		}
+
+		resp := repairRatingsResponse{
+			Drifts: make([]respRatingDrift, 0, len(drifts)),
+		}
+
+		for _, drift := range drifts {
+			resp.Drifts = append(resp.Drifts, respRatingDrift{
+				Entity:      drift.Entity,
+				ID:          drift.ID,
+				StoredCount: drift.StoredCount,
+				ActualCount: drift.ActualCount,
+				StoredScore: drift.StoredScore,
+				ActualScore: drift.ActualScore,
+				StoredRate:  drift.StoredRate,
+				ActualRate:  drift.ActualRate,
+			})
+		}
+
+		// fixed drifts contain both stored (before) and actual (after) aggregates
+		after, err := json.Marshal(resp.Drifts)
+		if err != nil {
+			h.log.Error("failed to marshal rating drifts for audit", zap.Error(err))
+		}
+
+		h.setAuditAfter(r.Context(), auditEntry, after)
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+// @Description fixed drifts repairRatingsResponse.
+type repairRatingsResponse struct {
+	Drifts []respRatingDrift `json:"drifts"`
+}
+
+// @Description stored and actual (computed from reviews) rating aggregates respRatingDrift.
+type respRatingDrift struct {
+	Entity      string  `json:"entity"       example:"teacher"`
+	ID          int     `json:"id"           example:"1"`
+	StoredCount int     `json:"stored_count" example:"3"`
+	ActualCount int     `json:"actual_count" example:"2"`
+	StoredScore int     `json:"stored_score" example:"12"`
+	ActualScore int     `json:"actual_score" example:"9"`
+	StoredRate  float64 `json:"stored_rate"  example:"4"`
+	ActualRate  float64 `json:"actual_rate"  example:"4.5"`
+}
@@ -0,0 +1,70 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const restoreCategoryRoute = "/categories/{id}/restore"
+
+// RestoreCategory returns http.HandlerFunc
+// @Summary restore category
+// @Description restore archived category with all its subcategories, parent category must not be archived
+// @Tags admin
+// @Produce json
+// @Param id path int true "categoryID"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 409 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/categories/{id}/restore [put]
+// @Security     BearerAuth
+func (h *AdminHandlers) RestoreCategory() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		categoryID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		before := h.auditSnapshot(r.Context(), entities.AuditEntityCategory, &categoryID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditCategoryRestore, entities.AuditEntityCategory, &categoryID, before)
+
+		if err = h.service.RestoreCategory(r.Context(), categoryID, auditEntry); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorCategoryNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorCategoryParentArchived):
+				httputils.RespondWith409(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
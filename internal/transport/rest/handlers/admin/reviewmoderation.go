@@ -0,0 +1,189 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	reviewModerationQueueRoute = "/reviews/moderation"
+	moderateReviewRoute        = "/reviews/{id}/moderate"
+)
+
+// GetReviewModerationQueue returns http.HandlerFunc
+// @Summary get review moderation queue
+// @Description get reviews and teacher replies (target) with pending reports, most reported first
+// @Tags admin
+// @Produce json
+// @Success 200 {object} reviewModerationQueueResponse
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/reviews/moderation [get]
+// @Security     BearerAuth
+func (h *AdminHandlers) GetReviewModerationQueue() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		items, err := h.service.GetReviewModerationQueue(r.Context())
+		if err != nil {
+			h.log.Error(err.Error())
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		resp := reviewModerationQueueResponse{
+			Items: make([]respReviewModerationItem, 0, len(items)),
+		}
+
+		for _, item := range items {
+			resp.Items = append(resp.Items, respReviewModerationItem{
+				ReviewID:        item.ReviewID,
+				Target:          string(item.Target),
+				TeacherID:       item.TeacherID,
+				StudentID:       item.StudentID,
+				Rate:            item.Rate,
+				Comment:         item.Comment,
+				IsHidden:        item.IsHidden,
+				ReportsCount:    item.ReportsCount,
+				FirstReportedAt: item.FirstReportedAt,
+				Reasons:         item.Reasons,
+			})
+		}
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+// ModerateReview returns http.HandlerFunc
+// @Summary moderate review
+// @Description hide, restore or delete review or teacher reply (target: review (default) or reply) with reason. Pending reports are resolved, author gets notification with reason
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param moderateReviewRequest body moderateReviewRequest true "Moderation decision"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/reviews/{id}/moderate [post]
+// @Security     BearerAuth
+func (h *AdminHandlers) ModerateReview() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		reviewID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		var req moderateReviewRequest
+		if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		if req.Reason == "" {
+			httputils.RespondWith400(w, "reason is empty", h.log)
+
+			return
+		}
+
+		action := entities.ReviewModerationAction(req.Action)
+		switch action {
+		case entities.ReviewModerationHide, entities.ReviewModerationRestore, entities.ReviewModerationDelete:
+		default:
+			httputils.RespondWith400(w, "action must be hide, restore or delete", h.log)
+
+			return
+		}
+
+		target := entities.ReviewReportTarget(req.Target)
+		if target == "" {
+			target = entities.ReviewReportTargetReview
+		}
+
+		if target != entities.ReviewReportTargetReview && target != entities.ReviewReportTargetReply {
+			httputils.RespondWith400(w, "target must be review or reply", h.log)
+
+			return
+		}
+
+		moderation := &entities.ReviewModeration{
+			ReviewID: reviewID,
+			Target:   target,
+			Action:   action,
+			Reason:   req.Reason,
+			AdminID:  userID,
+		}
+
+		auditEntity := entities.AuditEntityReview
+		if target == entities.ReviewReportTargetReply {
+			auditEntity = entities.AuditEntityReviewReply
+		}
+
+		before := h.auditSnapshot(r.Context(), auditEntity, &reviewID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditReviewModerate, auditEntity, &reviewID, before)
+
+		if err = h.service.ModerateReview(r.Context(), moderation, auditEntry); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorReviewNotFound),
+				errors.Is(err, serviceErrors.ErrorReviewReplyNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+type moderateReviewRequest struct {
+	Action string `json:"action" example:"hide"               binding:"required"`
+	Target string `json:"target" example:"review"`
+	Reason string `json:"reason" example:"offensive language" binding:"required"`
+}
+
+// @Description reported reviews and replies reviewModerationQueueResponse.
+type reviewModerationQueueResponse struct {
+	Items []respReviewModerationItem `json:"items"`
+}
+
+type respReviewModerationItem struct {
+	ReviewID        int       `json:"review_id"         example:"1"`
+	Target          string    `json:"target"            example:"review"`
+	TeacherID       int       `json:"teacher_id"        example:"1"`
+	StudentID       int       `json:"student_id"        example:"2"`
+	Rate            int       `json:"rate"              example:"1"`
+	Comment         string    `json:"comment"           example:"some comment"`
+	IsHidden        bool      `json:"is_hidden"         example:"false"`
+	ReportsCount    int       `json:"reports_count"     example:"3"`
+	FirstReportedAt time.Time `json:"first_reported_at" example:"2025-01-09T10:10:10Z"`
+	Reasons         []string  `json:"reasons"`
+}
@@ -0,0 +1,219 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	rolesRoute     = "/roles"
+	userRolesRoute = "/users/{id}/roles"
+	userRoleRoute  = "/users/{id}/roles/{role}"
+)
+
+// GetRoles returns http.HandlerFunc
+// @Summary get roles
+// @Description returns all admin roles with permissions given by them
+// @Tags admin
+// @Produce json
+// @Success 200 {object} getRolesResponse
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/roles [get]
+// @Security     BearerAuth
+func (h *AdminHandlers) GetRoles() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		roles, err := h.service.GetRoles(r.Context())
+		if err != nil {
+			h.log.Error(err.Error())
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		resp := getRolesResponse{
+			Roles: make([]respRole, 0, len(roles)),
+		}
+
+		for _, role := range roles {
+			resp.Roles = append(resp.Roles, respRole{
+				ID:          role.ID,
+				Name:        string(role.Name),
+				Description: role.Description,
+				Permissions: permissionNames(role.Permissions),
+			})
+		}
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+// AssignUserRole returns http.HandlerFunc
+// @Summary assign role to user
+// @Description give admin role to user, user gets access to admin routes allowed by role's permissions
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Param assignRoleRequest body assignRoleRequest true "Role"
+// @Success 201
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 409 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/users/{id}/roles [post]
+// @Security     BearerAuth
+func (h *AdminHandlers) AssignUserRole() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		targetID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		var req assignRoleRequest
+		if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		if req.Role == "" {
+			httputils.RespondWith400(w, "role is empty", h.log)
+
+			return
+		}
+
+		before := h.auditSnapshot(r.Context(), entities.AuditEntityUserRoles, &targetID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditRoleAssign, entities.AuditEntityUserRoles, &targetID, before)
+
+		if err = h.service.AssignRole(r.Context(), userID, targetID, entities.RoleName(req.Role), auditEntry); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorUserNotFound),
+				errors.Is(err, serviceErrors.ErrorRoleNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorRoleAlreadyAssigned):
+				httputils.RespondWith409(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith201(w, struct{}{}, h.log)
+	}
+}
+
+// RevokeUserRole returns http.HandlerFunc
+// @Summary revoke role from user
+// @Description take admin role from user. The last superadmin can't lose superadmin role
+// @Tags admin
+// @Produce json
+// @Param id path int true "User ID"
+// @Param role path string true "Role name"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 409 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/users/{id}/roles/{role} [delete]
+// @Security     BearerAuth
+func (h *AdminHandlers) RevokeUserRole() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		targetID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		roleName := chi.URLParam(r, "role")
+		if roleName == "" {
+			httputils.RespondWith400(w, "missed {role} param in url path", h.log)
+
+			return
+		}
+
+		before := h.auditSnapshot(r.Context(), entities.AuditEntityUserRoles, &targetID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditRoleRevoke, entities.AuditEntityUserRoles, &targetID, before)
+
+		if err = h.service.RevokeRole(r.Context(), targetID, entities.RoleName(roleName), auditEntry); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorRoleNotFound),
+				errors.Is(err, serviceErrors.ErrorRoleNotAssigned):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorLastSuperadmin):
+				httputils.RespondWith409(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+func permissionNames(permissions []entities.Permission) []string {
+	names := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		names = append(names, string(permission))
+	}
+
+	return names
+}
+
+type getRolesResponse struct {
+	Roles []respRole `json:"roles"`
+}
+
+type respRole struct {
+	ID          int      `json:"id"          example:"1"`
+	Name        string   `json:"name"        example:"moderator"`
+	Description string   `json:"description" example:"moderates user content, skills, certificates and users"`
+	Permissions []string `json:"permissions" example:"skills.view,skills.approve"`
+}
+
+type assignRoleRequest struct {
+	Role string `json:"role" example:"support" binding:"required"`
+}
@@ -0,0 +1,163 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	suspendUserRoute    = "/users/{id}/suspend"
+	userSuspensionRoute = "/users/{id}/suspension"
+)
+
+// SuspendUser returns http.HandlerFunc
+// @Summary suspend or ban user
+// @Description suspend user until date or ban permanently with reason, current suspension is replaced. Tokens of suspended user are rejected immediately. Teacher's skills are hidden from catalogue, his future lessons are cancelled (pending ones are rejected) and students are notified
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Param suspendUserRequest body suspendUserRequest true "Suspension (until or permanent)"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/users/{id}/suspend [post]
+// @Security     BearerAuth
+func (h *AdminHandlers) SuspendUser() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		suspendedID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		var req suspendUserRequest
+		if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		if req.Reason == "" {
+			httputils.RespondWith400(w, "reason is empty", h.log)
+
+			return
+		}
+
+		if req.Permanent == (req.Until != nil) {
+			httputils.RespondWith400(w, "either until or permanent must be set", h.log)
+
+			return
+		}
+
+		suspension := &entities.UserSuspension{
+			UserID:  suspendedID,
+			AdminID: &userID,
+			Reason:  req.Reason,
+			Until:   req.Until,
+		}
+
+		before := h.auditSnapshot(r.Context(), entities.AuditEntityUserSuspension, &suspendedID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditUserSuspend, entities.AuditEntityUserSuspension, &suspendedID, before)
+
+		if err = h.service.SuspendUser(r.Context(), suspension, auditEntry); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorUserNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorSuspendAdmin):
+				httputils.RespondWith403(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorSuspensionUntilPast):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+// LiftUserSuspension returns http.HandlerFunc
+// @Summary lift user suspension
+// @Description lift current suspension or ban of user. Cancelled lessons are not restored
+// @Tags admin
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/users/{id}/suspension [delete]
+// @Security     BearerAuth
+func (h *AdminHandlers) LiftUserSuspension() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		suspendedID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		before := h.auditSnapshot(r.Context(), entities.AuditEntityUserSuspension, &suspendedID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditUserLiftSuspension, entities.AuditEntityUserSuspension, &suspendedID, before)
+
+		if err = h.service.LiftSuspension(r.Context(), userID, suspendedID, auditEntry); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorUserNotSuspended):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+type suspendUserRequest struct {
+	Reason    string     `json:"reason"    example:"spam in reviews"      binding:"required"`
+	Until     *time.Time `json:"until"     example:"2025-02-01T00:00:00Z"`
+	Permanent bool       `json:"permanent" example:"false"`
+}
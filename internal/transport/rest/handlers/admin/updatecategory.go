@@ -0,0 +1,108 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const updateCategoryRoute = "/categories/{id}"
+
+// UpdateCategory returns http.HandlerFunc
+// @Summary update category
+// @Description rename category, change its min_age or move it to another parent (parent_id 0 makes category root). Omitted fields are not changed, moved category becomes the last one among new siblings
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "categoryID"
+// @Param updateCategoryRequest body updateCategoryRequest true "Changed category data"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 409 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/categories/{id} [patch]
+// @Security     BearerAuth
+func (h *AdminHandlers) UpdateCategory() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		categoryID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		var req updateCategoryRequest
+
+		if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		if req.Name != nil {
+			*req.Name = strings.TrimSpace(*req.Name)
+			if *req.Name == "" {
+				httputils.RespondWith400(w, "name can not be empty", h.log)
+
+				return
+			}
+		}
+
+		if req.MinAge != nil && *req.MinAge < 0 {
+			httputils.RespondWith400(w, "min_age must be not negative", h.log)
+
+			return
+		}
+
+		before := h.auditSnapshot(r.Context(), entities.AuditEntityCategory, &categoryID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditCategoryUpdate, entities.AuditEntityCategory, &categoryID, before)
+
+		err = h.service.UpdateCategory(r.Context(), categoryID, req.Name, req.MinAge, req.ParentID, auditEntry)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorCategoryNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorCategoryParentNotFound),
+				errors.Is(err, serviceErrors.ErrorCategoryParentArchived),
+				errors.Is(err, serviceErrors.ErrorCategoryCycle):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorCategoryExists):
+				httputils.RespondWith409(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+type updateCategoryRequest struct {
+	Name     *string `json:"name"      example:"Business English"`
+	MinAge   *int    `json:"min_age"   example:"16"`
+	ParentID *int    `json:"parent_id" example:"5"`
+}
@@ -0,0 +1,440 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	usersRoute                 = "/users"
+	userRoute                  = "/users/{id}"
+	userAvatarRoute            = "/users/{id}/avatar"
+	userEmailVerificationRoute = "/users/{id}/email-verification"
+	mergeUserRoute             = "/users/{id}/merge"
+)
+
+// SearchUsers returns http.HandlerFunc
+// @Summary search users
+// @Description returns one page of users (newest first) whose id equals query or whose email, name or surname contain it, empty query returns all users. Use next_cursor from response as cursor param to get the next page (empty next_cursor means the last page)
+// @Tags admin
+// @Produce json
+// @Param query query string false "ID, part of email, name or surname"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor of the page"
+// @Success 200 {object} searchUsersResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/users [get]
+// @Security     BearerAuth
+func (h *AdminHandlers) SearchUsers() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter := &entities.UserSearchFilter{
+			Query: r.URL.Query().Get("query"),
+		}
+
+		if value := r.URL.Query().Get("limit"); value != "" {
+			limit, err := strconv.Atoi(value)
+			if err != nil || limit < 0 {
+				httputils.RespondWith400(w, "limit must be non-negative number", h.log)
+
+				return
+			}
+
+			filter.Limit = limit
+		}
+
+		users, nextCursor, err := h.service.SearchUsers(r.Context(), filter, r.URL.Query().Get("cursor"))
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorInvalidCursor):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		resp := searchUsersResponse{
+			Users:      make([]respAdminUser, 0, len(users)),
+			NextCursor: nextCursor,
+		}
+
+		for _, user := range users {
+			resp.Users = append(resp.Users, newRespAdminUser(user))
+		}
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+// GetUserOverview returns http.HandlerFunc
+// @Summary get user for admin
+// @Description returns user with his roles, lessons (as student and as teacher, newest first), skills, complaints filed and received and all suspensions and bans including lifted ones
+// @Tags admin
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200 {object} userOverviewResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/users/{id} [get]
+// @Security     BearerAuth
+func (h *AdminHandlers) GetUserOverview() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		overview, err := h.service.GetUserOverview(r.Context(), userID)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorUserNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		resp := userOverviewResponse{
+			User:               newRespAdminUser(overview.User),
+			Roles:              make([]string, 0, len(overview.Access.Roles)),
+			StudentLessons:     newRespAdminLessons(overview.StudentLessons),
+			TeacherLessons:     newRespAdminLessons(overview.TeacherLessons),
+			Skills:             make([]respSkill, 0, len(overview.Skills)),
+			ComplaintsFiled:    make([]respComplaint, 0, len(overview.ComplaintsFiled)),
+			ComplaintsReceived: make([]respComplaint, 0, len(overview.ComplaintsReceived)),
+			Suspensions:        make([]respUserSuspension, 0, len(overview.Suspensions)),
+		}
+
+		for _, role := range overview.Access.Roles {
+			resp.Roles = append(resp.Roles, string(role))
+		}
+
+		for _, skill := range overview.Skills {
+			resp.Skills = append(resp.Skills, respSkill{
+				SkillID:           skill.ID,
+				TeacherID:         skill.TeacherID,
+				CategoryID:        skill.CategoryID,
+				VideoCardLink:     skill.VideoCardLink,
+				VideoCardURL:      videoCardURL(skill.ID, skill.VideoCardFile),
+				VideoCardDuration: skill.VideoCardDuration,
+				About:             skill.About,
+				Price:             skill.Price,
+				Rate:              skill.Rate,
+				ReviewsCount:      skill.ReviewsCount,
+				IsActive:          skill.IsActive,
+			})
+		}
+
+		for _, complaint := range overview.ComplaintsFiled {
+			resp.ComplaintsFiled = append(resp.ComplaintsFiled, newRespComplaint(complaint))
+		}
+
+		for _, complaint := range overview.ComplaintsReceived {
+			resp.ComplaintsReceived = append(resp.ComplaintsReceived, newRespComplaint(complaint))
+		}
+
+		for _, suspension := range overview.Suspensions {
+			resp.Suspensions = append(resp.Suspensions, respUserSuspension{
+				ID:        suspension.ID,
+				AdminID:   suspension.AdminID,
+				Reason:    suspension.Reason,
+				Until:     suspension.Until,
+				CreatedAt: suspension.CreatedAt,
+				LiftedAt:  suspension.LiftedAt,
+				LiftedBy:  suspension.LiftedBy,
+			})
+		}
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+// ResetUserAvatar returns http.HandlerFunc
+// @Summary reset user's avatar
+// @Description replace user's avatar with default one, uploaded avatar is deleted
+// @Tags admin
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/users/{id}/avatar [delete]
+// @Security     BearerAuth
+func (h *AdminHandlers) ResetUserAvatar() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		targetID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		before := h.auditSnapshot(r.Context(), entities.AuditEntityUser, &targetID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditUserResetAvatar, entities.AuditEntityUser, &targetID, before)
+
+		if err = h.service.ResetUserAvatar(r.Context(), targetID, auditEntry); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorUserNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+// RequireEmailVerification returns http.HandlerFunc
+// @Summary force email re-verification
+// @Description make user confirm his email again: he gets letter with verification token (see /auth/email/confirm), his sessions are revoked and he can't log in until he confirms email. email_verification_required flag is shown in his profile
+// @Tags admin
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/users/{id}/email-verification [post]
+// @Security     BearerAuth
+func (h *AdminHandlers) RequireEmailVerification() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		targetID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		before := h.auditSnapshot(r.Context(), entities.AuditEntityUser, &targetID)
+		auditEntry := newAuditEntry(r, userID, entities.AuditUserRequireEmail, entities.AuditEntityUser, &targetID, before)
+
+		if err = h.service.RequireEmailVerification(r.Context(), targetID, auditEntry); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorUserNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.completeAudit(r.Context(), auditEntry)
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+// MergeUser returns http.HandlerFunc
+// @Summary merge duplicate account into user
+// @Description move lessons, reviews, complaints, favourites, notifications, sanctions and teacher profile of duplicate account to user and delete duplicate account. Duplicate favourites of the same teacher are dropped. Admins, suspended users, two teachers and users having lessons with each other can't be merged
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID (kept account)"
+// @Param mergeUserRequest body mergeUserRequest true "Duplicate account"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 409 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/users/{id}/merge [post]
+// @Security     BearerAuth
+func (h *AdminHandlers) MergeUser() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		targetID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		var req mergeUserRequest
+		if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		if req.SourceUserID <= 0 {
+			httputils.RespondWith400(w, "source_user_id is required", h.log)
+
+			return
+		}
+
+		before := h.auditSnapshot(r.Context(), entities.AuditEntityUser, &req.SourceUserID)
+
+		auditEntry := newAuditEntry(r, userID, entities.AuditUserMerge, entities.AuditEntityUser, &req.SourceUserID, before)
+
+		if err = h.service.MergeUsers(r.Context(), targetID, req.SourceUserID, auditEntry); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorMergeSameUser):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorUserNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorMergeTeachers),
+				errors.Is(err, serviceErrors.ErrorMergeAdmin),
+				errors.Is(err, serviceErrors.ErrorMergeSuspendedUser),
+				errors.Is(err, serviceErrors.ErrorMergeSharedLessons):
+				httputils.RespondWith409(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		// merged user doesn't exist anymore, so the kept one is snapshotted as after
+		h.setAuditAfter(r.Context(), auditEntry, h.auditSnapshot(r.Context(), entities.AuditEntityUser, &targetID))
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+func newRespAdminUser(user *entities.User) respAdminUser {
+	return respAdminUser{
+		ID:                        user.ID,
+		Email:                     user.Email,
+		Name:                      user.Name,
+		Surname:                   user.Surname,
+		RegistrationDate:          user.RegistrationDate,
+		Birthdate:                 user.Birthdate,
+		Avatar:                    user.Avatar,
+		EmailVerificationRequired: user.EmailVerificationRequired,
+	}
+}
+
+func newRespAdminLessons(lessons []*entities.Lesson) []respAdminLesson {
+	resp := make([]respAdminLesson, 0, len(lessons))
+
+	for _, lesson := range lessons {
+		resp = append(resp, respAdminLesson{
+			LessonID:     lesson.ID,
+			StudentID:    lesson.StudentID,
+			TeacherID:    lesson.TeacherID,
+			CategoryID:   lesson.CategoryID,
+			CategoryName: lesson.CategoryName,
+			Status:       lesson.StatusName,
+			Price:        lesson.Price,
+			Datetime:     lesson.ScheduleTimeDatetime,
+		})
+	}
+
+	return resp
+}
+
+type searchUsersResponse struct {
+	Users      []respAdminUser `json:"users"`
+	NextCursor string          `json:"next_cursor" example:"eyJpZCI6MTJ9"`
+}
+
+type userOverviewResponse struct {
+	User               respAdminUser        `json:"user"`
+	Roles              []string             `json:"roles"               example:"support"`
+	StudentLessons     []respAdminLesson    `json:"student_lessons"`
+	TeacherLessons     []respAdminLesson    `json:"teacher_lessons"`
+	Skills             []respSkill          `json:"skills"`
+	ComplaintsFiled    []respComplaint      `json:"complaints_filed"`
+	ComplaintsReceived []respComplaint      `json:"complaints_received"`
+	Suspensions        []respUserSuspension `json:"suspensions"`
+}
+
+type respAdminUser struct {
+	ID                        int       `json:"id"                          example:"1"`
+	Email                     string    `json:"email"                       example:"qwerty@example.com"`
+	Name                      string    `json:"name"                        example:"John"`
+	Surname                   string    `json:"surname"                     example:"Smith"`
+	RegistrationDate          time.Time `json:"registration_date"           example:"2022-09-09T10:10:10+09:00"`
+	Birthdate                 time.Time `json:"birthdate"                   example:"2002-09-09T10:10:10+09:00"`
+	Avatar                    string    `json:"avatar"                      example:"uuid.png"`
+	EmailVerificationRequired bool      `json:"email_verification_required" example:"false"`
+}
+
+type respAdminLesson struct {
+	LessonID     int       `json:"lesson_id"     example:"1"`
+	StudentID    int       `json:"student_id"    example:"1"`
+	TeacherID    int       `json:"teacher_id"    example:"1"`
+	CategoryID   int       `json:"category_id"   example:"1"`
+	CategoryName string    `json:"category_name" example:"Programming"`
+	Status       string    `json:"status"        example:"finished"`
+	Price        int       `json:"price"         example:"500"`
+	Datetime     time.Time `json:"datetime"      example:"2025-02-01T09:00:00Z"`
+}
+
+type respUserSuspension struct {
+	ID        int        `json:"id"                  example:"1"`
+	AdminID   *int       `json:"admin_id,omitempty"  example:"3"`
+	Reason    string     `json:"reason"              example:"spam in reviews"`
+	Until     *time.Time `json:"until,omitempty"     example:"2025-02-01T00:00:00Z"` // empty for permanent ban
+	CreatedAt time.Time  `json:"created_at"          example:"2025-01-09T10:10:10+09:00"`
+	LiftedAt  *time.Time `json:"lifted_at,omitempty" example:"2025-01-10T10:10:10+09:00"`
+	LiftedBy  *int       `json:"lifted_by,omitempty" example:"3"`
+}
+
+type mergeUserRequest struct {
+	SourceUserID int `json:"source_user_id" example:"42" binding:"required"` // duplicate account, it is deleted
+}
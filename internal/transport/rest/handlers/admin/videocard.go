@@ -0,0 +1,89 @@
+package admin
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/internal/videoutils"
+	"go.uber.org/zap"
+)
+
+const (
+	skillVideoCardRoute = "/skills/{id}/video"
+
+	// default server write timeout is too short for big files
+	videoStreamingTimeout = 30 * time.Minute
+)
+
+// GetSkillVideoCard returns http.HandlerFunc
+// @Summary preview skill's video card
+// @Description stream uploaded video card of any skill, also not approved one (for approval). Supports HTTP range requests for seeking
+// @Tags admin
+// @Produce video/mp4,video/quicktime,video/webm
+// @Param id path int true "Skill ID"
+// @Param Range header string false "Range of bytes, e.g. bytes=0-1023"
+// @Success 200 {file} binary "Video card"
+// @Success 206 {file} binary "Part of video card"
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 416
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /admin/skills/{id}/video [get]
+// @Security     BearerAuth
+func (h *AdminHandlers) GetSkillVideoCard() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		skillID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		file, err := h.service.GetSkillVideoCardForReview(r.Context(), skillID)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorSkillNotFound),
+				errors.Is(err, serviceErrors.ErrorVideoCardNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		defer func() {
+			if closer, ok := file.FileReader.(io.Closer); ok {
+				if err := closer.Close(); err != nil {
+					h.log.Error("failed to close reader", zap.Error(err))
+				}
+			}
+		}()
+
+		content, ok := file.FileReader.(io.ReadSeeker)
+		if !ok {
+			h.log.Error("video card reader is not seekable")
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		controller := http.NewResponseController(w)
+		if err = controller.SetWriteDeadline(time.Now().Add(videoStreamingTimeout)); err != nil {
+			h.log.Warn("failed to extend write deadline", zap.Error(err))
+		}
+
+		w.Header().Set("Content-Type", videoutils.Container(file.Extension).ContentType())
+		w.Header().Set("Cache-Control", "private, no-cache")
+
+		// handles Range, If-Range and HEAD requests
+		http.ServeContent(w, r, file.Name, time.Time{}, content)
+	}
+}
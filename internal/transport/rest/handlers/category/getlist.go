@@ -3,22 +3,36 @@ package category
 import (
 	"net/http"
 
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
 )
 
 const Route = "/categories"
 
 // GetCategoryList returns http.HandlerFunc
 // @Summary Get categories
-// @Description Get list of all categories
+// @Description Get list of not archived categories, subcategories have parent_id, categories are sorted by position. Token is optional, with token categories its user is too young for are marked by is_too_young
 // @Tags categories
 // @Produce json
 // @Success 200 {object} getCategoriesResponse
 // @Failure 500 {object} httputils.ErrorStruct
 // @Router /categories [get]
+// @Security     BearerAuth
 func (h *CategoryHandlers) GetCategoryList() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		categories, err := h.categoryService.GetCategories(r.Context())
+		var (
+			categories []*entities.Category
+			err        error
+		)
+
+		// token is optional for this route
+		if userID, ok := r.Context().Value(jwt.UserIDKey).(int); ok && userID != 0 {
+			categories, err = h.categoryService.GetCategoriesForUser(r.Context(), userID)
+		} else {
+			categories, err = h.categoryService.GetCategories(r.Context())
+		}
+
 		if err != nil {
 			h.log.Error(err.Error())
 			httputils.RespondWith500(w, h.log)
@@ -30,9 +44,12 @@ func (h *CategoryHandlers) GetCategoryList() http.HandlerFunc {
 		}
 		for _, c := range categories {
 			resp.Categories = append(resp.Categories, respCategory{
-				ID:     c.ID,
-				Name:   c.Name,
-				MinAge: c.MinAge,
+				ID:         c.ID,
+				Name:       c.Name,
+				MinAge:     c.MinAge,
+				ParentID:   c.ParentID,
+				Position:   c.Position,
+				IsTooYoung: c.IsTooYoung,
 			})
 		}
 
@@ -47,7 +64,10 @@ type getCategoriesResponse struct {
 
 // @Description data of respCategory.
 type respCategory struct {
-	ID     int    `json:"id"      example:"1"`
-	Name   string `json:"name"    example:"Programing"`
-	MinAge int    `json:"min_age" example:"12"`
+	ID         int    `json:"id"                  example:"1"`
+	Name       string `json:"name"                example:"Programing"`
+	MinAge     int    `json:"min_age"             example:"12"`
+	ParentID   *int   `json:"parent_id,omitempty" example:"5"`
+	Position   int    `json:"position"            example:"0"`
+	IsTooYoung bool   `json:"is_too_young"        example:"false"`
 }
@@ -2,6 +2,7 @@ package category
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
 	"github.com/go-chi/chi/v5"
@@ -10,6 +11,7 @@ import (
 
 type CategoryService interface {
 	GetCategories(ctx context.Context) ([]*entities.Category, error)
+	GetCategoriesForUser(ctx context.Context, userID int) ([]*entities.Category, error)
 }
 
 type CategoryHandlers struct {
@@ -24,6 +26,6 @@ func NewCategoryHandlers(categoryService CategoryService, log *zap.Logger) *Cate
 	}
 }
 
-func (h *CategoryHandlers) SetupCategoryRoutes(router *chi.Mux) {
-	router.Get(Route, h.GetCategoryList())
+func (h *CategoryHandlers) SetupCategoryRoutes(router *chi.Mux, optionalAuthMiddleware func(http.Handler) http.Handler) {
+	router.With(optionalAuthMiddleware).Get(Route, h.GetCategoryList())
 }
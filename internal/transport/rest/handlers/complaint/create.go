@@ -1,8 +1,11 @@
 package complaint
 
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 
 	"go.uber.org/zap"
@@ -11,23 +14,31 @@ import (
 	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
 	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"github.com/LearnShareApp/learn-share-backend/pkg/storage/object"
 )
 
 const (
 	createRoute = "/"
+
+	maxAttachmentsCount = 5
+	maxAttachmentSize   = 5 << 20
+	// base64 encoded attachments and the rest of json
+	maxCreateRequestSize = maxAttachmentsCount*maxAttachmentSize*4/3 + 64<<10
 )
 
 // CreateComplaint returns http.HandlerFunc
 // @Summary Create a new complaint
-// @Description Creating a new complaint to user (reported_id => user_id which you would like to report)
+// @Description Creating a new complaint to user (reported_id => user_id which you would like to report). Complaint can be about a lesson (lesson_id) you took part in, then reported_id may be omitted (the other participant is reported). Up to 5 evidence attachments (base64 encoded pdf, png, jpeg or webp, max 5MB each)
 // @Tags complaint
 // @Accept json
 // @Produce json
 // @Param createComplaintRequest body createComplaintRequest true "ComplaintData"
-// @Success 201
+// @Success 201 {object} createComplaintResponse
 // @Failure 400 {object} httputils.ErrorStruct
 // @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
 // @Failure 404 {object} httputils.ErrorStruct
+// @Failure 413 {object} httputils.ErrorStruct
 // @Failure 500 {object} httputils.ErrorStruct
 // @Router /complaint [post]
 // @Security     BearerAuth
@@ -42,16 +53,32 @@ func (h *ComplaintHandlers) CreateComplaint() http.HandlerFunc {
 			return
 		}
 
+		r.Body = http.MaxBytesReader(w, r.Body, maxCreateRequestSize)
+
 		var req createComplaintRequest
 
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				httputils.RespondWith413(w, "attachments too large", h.log)
+
+				return
+			}
+
 			httputils.RespondWith400(w, "failed to decode body", h.log)
 
 			return
 		}
 
-		if req.ReportedID == 0 || req.Reason == "" || req.Description == "" {
-			httputils.RespondWith400(w, "reported_id, reason or description is empty (required)", h.log)
+		if (req.ReportedID == 0 && req.LessonID == nil) || req.Reason == "" || req.Description == "" {
+			httputils.RespondWith400(w, "reported_id (or lesson_id), reason or description is empty (required)", h.log)
+
+			return
+		}
+
+		files, err := decodeAttachments(req.Attachments)
+		if err != nil {
+			httputils.RespondWith400(w, err.Error(), h.log)
 
 			return
 		}
@@ -59,19 +86,25 @@ func (h *ComplaintHandlers) CreateComplaint() http.HandlerFunc {
 		complaint := entities.Complaint{
 			ComplainerID: userID,
 			ReportedID:   req.ReportedID,
+			LessonID:     req.LessonID,
 			Reason:       req.Reason,
 			Description:  req.Description,
 		}
 
-		err := h.service.CreateComplaint(r.Context(), &complaint)
+		id, err := h.service.CreateComplaint(r.Context(), &complaint, files)
 
 		if err != nil {
 			switch {
 			case errors.Is(err, serviceErrors.ErrorUserNotFound):
 				httputils.RespondWith401(w, err.Error(), h.log)
-			case errors.Is(err, serviceErrors.ErrorReportedUserNotFound):
+			case errors.Is(err, serviceErrors.ErrorReportedUserNotFound),
+				errors.Is(err, serviceErrors.ErrorLessonNotFound):
 				httputils.RespondWith404(w, err.Error(), h.log)
-			case errors.Is(err, serviceErrors.ErrorComplainerAndReportedSame):
+			case errors.Is(err, serviceErrors.ErrorNotRelatedUserToLesson):
+				httputils.RespondWith403(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorComplainerAndReportedSame),
+				errors.Is(err, serviceErrors.ErrorReportedNotRelatedToLesson),
+				errors.Is(err, serviceErrors.ErrorContentRejected):
 				httputils.RespondWith400(w, err.Error(), h.log)
 			default:
 				h.log.Error(err.Error())
@@ -81,12 +114,51 @@ func (h *ComplaintHandlers) CreateComplaint() http.HandlerFunc {
 			return
 		}
 
-		httputils.SuccessRespondWith201(w, struct{}{}, h.log)
+		httputils.SuccessRespondWith201(w, createComplaintResponse{ID: id}, h.log)
+	}
+}
+
+// decodeAttachments validates base64 encoded attachments, returns error with message for client.
+func decodeAttachments(attachments []string) ([]*object.File, error) {
+	if len(attachments) > maxAttachmentsCount {
+		return nil, fmt.Errorf("too many attachments (max %d)", maxAttachmentsCount)
+	}
+
+	files := make([]*object.File, 0, len(attachments))
+
+	for i, attachment := range attachments {
+		fileBytes, err := base64.StdEncoding.DecodeString(attachment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid format of attachment %d", i)
+		}
+
+		if len(fileBytes) > maxAttachmentSize {
+			return nil, fmt.Errorf("attachment %d is too large", i)
+		}
+
+		extension, ok := entities.ComplaintAttachmentContentTypes[http.DetectContentType(fileBytes)]
+		if !ok {
+			return nil, fmt.Errorf("attachment %d must be pdf, png, jpeg or webp", i)
+		}
+
+		files = append(files, &object.File{
+			Extension:  extension,
+			FileReader: bytes.NewReader(fileBytes),
+			Size:       int64(len(fileBytes)),
+		})
 	}
+
+	return files, nil
 }
 
 type createComplaintRequest struct {
-	ReportedID  int    `json:"reported_id" example:"1"                   binding:"required"`
-	Reason      string `json:"reason"      example:"Rude attitude"       binding:"required"`
-	Description string `json:"description" example:"your description..." binding:"required"`
+	ReportedID  int      `json:"reported_id" example:"1"`
+	LessonID    *int     `json:"lesson_id"   example:"12"`
+	Reason      string   `json:"reason"      example:"Rude attitude"       binding:"required"`
+	Description string   `json:"description" example:"your description..." binding:"required"`
+	Attachments []string `json:"attachments" example:"base64 encoded file"`
+}
+
+type createComplaintResponse struct {
+	ID int `json:"complaint_id" example:"1"`
 }
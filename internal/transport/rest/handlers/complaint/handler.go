@@ -7,6 +7,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	"github.com/LearnShareApp/learn-share-backend/pkg/storage/object"
 	"github.com/go-chi/chi/v5"
 )
 
@@ -15,7 +16,7 @@ const (
 )
 
 type ComplaintService interface {
-	CreateComplaint(ctx context.Context, complaint *entities.Complaint) error
+	CreateComplaint(ctx context.Context, complaint *entities.Complaint, files []*object.File) (int, error)
 }
 
 type ComplaintHandlers struct {
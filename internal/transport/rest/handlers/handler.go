@@ -3,12 +3,14 @@ package handlers
 import (
 	"net/http"
 
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/handlers/admin"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/handlers/complaint"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/handlers/category"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/handlers/image"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/handlers/lesson"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/handlers/notification"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/handlers/review"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/handlers/schedule"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/handlers/teacher"
@@ -18,7 +20,7 @@ import (
 )
 
 type Services interface {
-	user.JwtService
+	user.SessionService
 	user.UserService
 	teacher.TeacherService
 	schedule.ScheduleService
@@ -28,6 +30,7 @@ type Services interface {
 	category.CategoryService
 	complaint.ComplaintService
 	admin.AdminService
+	notification.NotificationService
 }
 
 type Handlers struct {
@@ -42,17 +45,18 @@ func NewHandlers(services Services, log *zap.Logger) *Handlers {
 	}
 }
 
-func (h *Handlers) SetupRoutes(router *chi.Mux, authMiddleware func(http.Handler) http.Handler) {
+func (h *Handlers) SetupRoutes(router *chi.Mux, authMiddleware, optionalAuthMiddleware func(http.Handler) http.Handler,
+	permissionMiddleware func(permission entities.Permission) func(http.Handler) http.Handler) {
 	//recomendation from AI about downcast to certain interfaces (ISP)
 
 	var userService user.UserService = h.services
-	var jwtService user.JwtService = h.services
-	userHandlers := user.NewUserHandlers(userService, jwtService, h.log)
+	var sessionService user.SessionService = h.services
+	userHandlers := user.NewUserHandlers(userService, sessionService, h.log)
 	userHandlers.SetupUserRoutes(router, authMiddleware)
 
 	var teacherService teacher.TeacherService = h.services
 	teacherHandlers := teacher.NewTeacherHandlers(teacherService, h.log)
-	teacherHandlers.SetupTeacherRoutes(router, authMiddleware)
+	teacherHandlers.SetupTeacherRoutes(router, authMiddleware, optionalAuthMiddleware)
 
 	var scheduleService schedule.ScheduleService = h.services
 	scheduleHandlers := schedule.NewScheduleHandlers(scheduleService, h.log)
@@ -72,7 +76,7 @@ func (h *Handlers) SetupRoutes(router *chi.Mux, authMiddleware func(http.Handler
 
 	var categoryService category.CategoryService = h.services
 	categoryHandlers := category.NewCategoryHandlers(categoryService, h.log)
-	categoryHandlers.SetupCategoryRoutes(router)
+	categoryHandlers.SetupCategoryRoutes(router, optionalAuthMiddleware)
 
 	var complaintService complaint.ComplaintService = h.services
 	complaintHandlers := complaint.NewComplaintHandlers(complaintService, h.log)
@@ -80,5 +84,9 @@ func (h *Handlers) SetupRoutes(router *chi.Mux, authMiddleware func(http.Handler
 
 	var adminService admin.AdminService = h.services
 	adminHandlers := admin.NewAdminHandlers(adminService, h.log)
-	adminHandlers.SetupAdminRoutes(router, authMiddleware)
+	adminHandlers.SetupAdminRoutes(router, authMiddleware, permissionMiddleware)
+
+	var notificationService notification.NotificationService = h.services
+	notificationHandlers := notification.NewNotificationHandlers(notificationService, h.log)
+	notificationHandlers.SetupNotificationRoutes(router, authMiddleware)
 }
@@ -27,6 +27,7 @@ const (
 // @Success 201
 // @Failure 400 {object} httputils.ErrorStruct
 // @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
 // @Failure 404 {object} httputils.ErrorStruct
 // @Failure 409 {object} httputils.ErrorStruct
 // @Failure 500 {object} httputils.ErrorStruct
@@ -76,10 +77,14 @@ func (h *LessonHandlers) BookLesson() http.HandlerFunc {
 				httputils.RespondWith400(w, err.Error(), h.log)
 			case errors.Is(err, serviceErrors.ErrorCategoryNotFound):
 				httputils.RespondWith404(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorStudentTooYoung):
+				httputils.RespondWith403(w, err.Error(), h.log)
 			case errors.Is(err, serviceErrors.ErrorSkillUnregistered):
 				httputils.RespondWith404(w, err.Error(), h.log)
 			case errors.Is(err, serviceErrors.ErrorSkillInactive):
 				httputils.RespondWith404(w, "teacher's skill is inactive", h.log)
+			case errors.Is(err, serviceErrors.ErrorTeacherSuspended):
+				httputils.RespondWith403(w, err.Error(), h.log)
 			case errors.Is(err, serviceErrors.ErrorScheduleTimeNotFound):
 				httputils.RespondWith404(w, err.Error(), h.log)
 			case errors.Is(err, serviceErrors.ErrorScheduleTimeForAnotherTeacher):
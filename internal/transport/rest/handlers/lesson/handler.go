@@ -3,6 +3,7 @@ package lesson
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
 	"github.com/go-chi/chi/v5"
@@ -25,6 +26,7 @@ type LessonService interface {
 	GetLessonShortData(ctx context.Context, lessonID int) (*entities.Lesson, error)
 	GetStudentLessonList(ctx context.Context, userID int) ([]*entities.Lesson, error)
 	GetTeacherLessonList(ctx context.Context, userID int) ([]*entities.Lesson, error)
+	GetStudentProgress(ctx context.Context, userID int, from, to time.Time) (*entities.StudentProgress, error)
 
 	GetTeacherByID(ctx context.Context, id int) (*entities.Teacher, error)
 	GetTeacherByUserID(ctx context.Context, userID int) (*entities.Teacher, error)
@@ -68,6 +70,7 @@ func (h *LessonHandlers) SetupLessonRoutes(router *chi.Mux, authMiddleware func(
 		r.Post(bookRoute, h.BookLesson())
 		r.Get(getForStudentListRoute, h.GetForStudentList())
 		r.Get(getForTeacherListRoute, h.GetForTeacherList())
+		r.Get(getStudentProgressRoute, h.GetStudentProgress())
 	})
 
 }
@@ -0,0 +1,206 @@
+package lesson
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	getStudentProgressRoute = "/student/progress"
+
+	progressDateLayout       = time.DateOnly
+	defaultProgressRangeDays = 12 * 7
+	maxProgressRangeDays     = 731
+)
+
+// GetStudentProgress returns http.HandlerFunc
+// @Summary Get student's learning progress
+// @Description Timeline of studied hours by weeks (UTC, weeks start on monday) and categories for days range, and for all time: totals, streaks (weeks in a row with finished lessons), teachers and reached milestones. Hours are counted by actual lesson duration (1 hour if unknown)
+// @Tags lessons
+// @Produce json
+// @Param from query string false "First day of range, YYYY-MM-DD (default 83 days before to)"
+// @Param to query string false "Last day of range, YYYY-MM-DD (default today)"
+// @Success 200 {object} getStudentProgressResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /student/progress [get]
+// @Security     BearerAuth
+func (h *LessonHandlers) GetStudentProgress() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		from, to, err := parseProgressRange(r.URL.Query())
+		if err != nil {
+			httputils.RespondWith400(w, err.Error(), h.log)
+
+			return
+		}
+
+		progress, err := h.lessonService.GetStudentProgress(r.Context(), userID, from, to)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorUserNotFound):
+				httputils.RespondWith401(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		resp := getStudentProgressResponse{
+			From:               progress.From.Format(progressDateLayout),
+			To:                 progress.To.Format(progressDateLayout),
+			TotalLessons:       progress.TotalLessons,
+			TotalHours:         minutesToHours(progress.TotalMinutes),
+			CurrentStreakWeeks: progress.CurrentStreakWeeks,
+			LongestStreakWeeks: progress.LongestStreakWeeks,
+			Weeks:              make([]respProgressWeek, 0, len(progress.Weeks)),
+			Teachers:           make([]respProgressTeacher, 0, len(progress.Teachers)),
+			Milestones:         make([]respProgressMilestone, 0, len(progress.Milestones)),
+		}
+
+		for _, week := range progress.Weeks {
+			respWeek := respProgressWeek{
+				WeekStart:  week.WeekStart.Format(progressDateLayout),
+				Hours:      minutesToHours(week.Minutes),
+				Categories: make([]respProgressCategory, 0, len(week.Categories)),
+			}
+
+			for _, category := range week.Categories {
+				respWeek.Categories = append(respWeek.Categories, respProgressCategory{
+					CategoryID:   category.CategoryID,
+					CategoryName: category.CategoryName,
+					LessonsCount: category.LessonsCount,
+					Hours:        minutesToHours(category.Minutes),
+				})
+			}
+
+			resp.Weeks = append(resp.Weeks, respWeek)
+		}
+
+		for _, teacher := range progress.Teachers {
+			resp.Teachers = append(resp.Teachers, respProgressTeacher{
+				TeacherID:    teacher.Teacher.TeacherData.ID,
+				UserID:       teacher.Teacher.ID,
+				Name:         teacher.Teacher.Name,
+				Surname:      teacher.Teacher.Surname,
+				Avatar:       teacher.Teacher.Avatar,
+				LessonsCount: teacher.LessonsCount,
+				Hours:        minutesToHours(teacher.Minutes),
+				FirstLesson:  teacher.FirstLesson,
+				LastLesson:   teacher.LastLesson,
+			})
+		}
+
+		for _, milestone := range progress.Milestones {
+			resp.Milestones = append(resp.Milestones, respProgressMilestone{
+				Code:      milestone.Code,
+				Title:     milestone.Title,
+				ReachedAt: milestone.ReachedAt,
+			})
+		}
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+func parseProgressRange(query url.Values) (time.Time, time.Time, error) {
+	now := time.Now().UTC()
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	var err error
+
+	if value := query.Get("to"); value != "" {
+		if to, err = time.Parse(progressDateLayout, value); err != nil {
+			return time.Time{}, time.Time{}, errors.New("to must be date in YYYY-MM-DD format")
+		}
+	}
+
+	from := to.AddDate(0, 0, -(defaultProgressRangeDays - 1))
+
+	if value := query.Get("from"); value != "" {
+		if from, err = time.Parse(progressDateLayout, value); err != nil {
+			return time.Time{}, time.Time{}, errors.New("from must be date in YYYY-MM-DD format")
+		}
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, errors.New("from must be less or equal than to")
+	}
+
+	if to.Sub(from) >= maxProgressRangeDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("range must be not longer than %d days", maxProgressRangeDays)
+	}
+
+	return from, to, nil
+}
+
+func minutesToHours(minutes int) float64 {
+	return float64(minutes) / 60
+}
+
+// @Description student's learning progress getStudentProgressResponse.
+type getStudentProgressResponse struct {
+	From               string                  `json:"from"                 example:"2025-01-06"`
+	To                 string                  `json:"to"                   example:"2025-03-31"`
+	TotalLessons       int                     `json:"total_lessons"        example:"27"`
+	TotalHours         float64                 `json:"total_hours"          example:"30.5"`
+	CurrentStreakWeeks int                     `json:"current_streak_weeks" example:"3"`
+	LongestStreakWeeks int                     `json:"longest_streak_weeks" example:"8"`
+	Weeks              []respProgressWeek      `json:"weeks"`
+	Teachers           []respProgressTeacher   `json:"teachers"`
+	Milestones         []respProgressMilestone `json:"milestones"`
+}
+
+// @Description studied hours of one week respProgressWeek.
+type respProgressWeek struct {
+	WeekStart  string                 `json:"week_start" example:"2025-01-06"`
+	Hours      float64                `json:"hours"      example:"2.5"`
+	Categories []respProgressCategory `json:"categories"`
+}
+
+// @Description studied hours of one category respProgressCategory.
+type respProgressCategory struct {
+	CategoryID   int     `json:"category_id"   example:"1"`
+	CategoryName string  `json:"category_name" example:"Programming"`
+	LessonsCount int     `json:"lessons_count" example:"2"`
+	Hours        float64 `json:"hours"         example:"1.5"`
+}
+
+// @Description teacher worked with respProgressTeacher.
+type respProgressTeacher struct {
+	TeacherID    int       `json:"teacher_id"    example:"3"`
+	UserID       int       `json:"user_id"       example:"7"`
+	Name         string    `json:"name"          example:"Ivan"`
+	Surname      string    `json:"surname"       example:"Ivanov"`
+	Avatar       string    `json:"avatar"        example:"uuid.png"`
+	LessonsCount int       `json:"lessons_count" example:"10"`
+	Hours        float64   `json:"hours"         example:"11"`
+	FirstLesson  time.Time `json:"first_lesson"  example:"2025-01-09T10:00:00Z"`
+	LastLesson   time.Time `json:"last_lesson"   example:"2025-03-27T10:00:00Z"`
+}
+
+// @Description reached milestone respProgressMilestone.
+type respProgressMilestone struct {
+	Code      string    `json:"code"       example:"lessons_10"`
+	Title     string    `json:"title"      example:"10 lessons finished"`
+	ReachedAt time.Time `json:"reached_at" example:"2025-02-13T10:00:00Z"`
+}
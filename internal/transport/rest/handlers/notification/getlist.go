@@ -0,0 +1,78 @@
+package notification
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	getListRoute = "/"
+)
+
+// GetNotificationList returns http.HandlerFunc
+// @Summary Get notifications
+// @Description Get notifications of user (id from token) from newest to oldest. Types: review_replied, review_reply_updated, review_hidden, review_restored, review_deleted, review_reply_hidden, review_reply_restored, review_reply_deleted (entity_id is review id, message is moderation reason)
+// @Tags notifications
+// @Produce json
+// @Param unread query bool false "Return only unread notifications"
+// @Success 200 {object} getNotificationsResponse
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /notifications [get]
+// @Security     BearerAuth
+func (h *NotificationHandlers) GetNotificationList() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		unreadOnly := r.URL.Query().Get("unread") == "true"
+
+		notifications, err := h.service.GetNotifications(r.Context(), userID, unreadOnly)
+		if err != nil {
+			h.log.Error(err.Error())
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		resp := getNotificationsResponse{
+			Notifications: make([]respNotification, 0, len(notifications)),
+		}
+
+		for _, notification := range notifications {
+			resp.Notifications = append(resp.Notifications, respNotification{
+				NotificationID: notification.ID,
+				Type:           string(notification.Type),
+				EntityID:       notification.EntityID,
+				Message:        notification.Message,
+				IsRead:         notification.IsRead,
+				CreatedAt:      notification.CreatedAt,
+			})
+		}
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+type getNotificationsResponse struct {
+	Notifications []respNotification `json:"notifications"`
+}
+
+type respNotification struct {
+	NotificationID int       `json:"notification_id"     example:"1"`
+	Type           string    `json:"type"                example:"review_replied"`
+	EntityID       *int      `json:"entity_id,omitempty" example:"1"`
+	Message        *string   `json:"message,omitempty"   example:"offensive language"`
+	IsRead         bool      `json:"is_read"             example:"false"`
+	CreatedAt      time.Time `json:"created_at"          example:"2025-01-09T10:10:10Z"`
+}
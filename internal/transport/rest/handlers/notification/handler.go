@@ -0,0 +1,44 @@
+package notification
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	notificationRoute = "/notifications"
+)
+
+type NotificationService interface {
+	GetNotifications(ctx context.Context, userID int, unreadOnly bool) ([]*entities.Notification, error)
+	MarkNotificationRead(ctx context.Context, userID, notificationID int) error
+}
+
+type NotificationHandlers struct {
+	service NotificationService
+	log     *zap.Logger
+}
+
+func NewNotificationHandlers(service NotificationService, log *zap.Logger) *NotificationHandlers {
+	return &NotificationHandlers{
+		service: service,
+		log:     log,
+	}
+}
+
+func (h *NotificationHandlers) SetupNotificationRoutes(router *chi.Mux, authMiddleware func(http.Handler) http.Handler) {
+	notificationRouter := chi.NewRouter()
+
+	notificationRouter.Group(func(r chi.Router) {
+		r.Use(authMiddleware)
+
+		r.Get(getListRoute, h.GetNotificationList())
+		r.Post(markReadRoute, h.MarkNotificationRead())
+	})
+
+	router.Mount(notificationRoute, notificationRouter)
+}
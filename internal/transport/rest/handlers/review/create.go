@@ -18,7 +18,7 @@ const (
 
 // CreateReview returns http.HandlerFunc
 // @Summary Create review
-// @Description Create review if authorized user (student) had lesson with this teacher and this category
+// @Description Create review about finished lesson of authorized user (student), only one review per lesson
 // @Tags reviews
 // @Accept json
 // @Produce json
@@ -50,24 +50,23 @@ func (h *ReviewHandlers) CreateReview() http.HandlerFunc {
 			return
 		}
 
-		if req.TeacherID == 0 || req.CategoryID == 0 || req.Rate == 0 || req.Comment == "" {
-			httputils.RespondWith400(w, "teacher_id, category_id, rate or comment are empty", h.log)
+		if req.LessonID == 0 || req.Rate == 0 || req.Comment == "" {
+			httputils.RespondWith400(w, "lesson_id, rate or comment are empty", h.log)
 
 			return
 		}
 
-		if req.Rate > 5 {
-			httputils.RespondWith400(w, "rate must be less or equal than 5", h.log)
+		if req.Rate < 1 || req.Rate > 5 {
+			httputils.RespondWith400(w, "rate must be from 1 to 5", h.log)
 
 			return
 		}
 
 		review := &entities.Review{
-			TeacherID:  req.TeacherID,
-			StudentID:  userID,
-			CategoryID: req.CategoryID,
-			Rate:       req.Rate,
-			Comment:    req.Comment,
+			StudentID: userID,
+			LessonID:  &req.LessonID,
+			Rate:      req.Rate,
+			Comment:   req.Comment,
 		}
 
 		err := h.reviewService.CreateReview(r.Context(), review)
@@ -75,18 +74,18 @@ func (h *ReviewHandlers) CreateReview() http.HandlerFunc {
 			switch {
 			case errors.Is(err, serviceErrors.ErrorUserNotFound):
 				httputils.RespondWith401(w, err.Error(), h.log)
-			case errors.Is(err, serviceErrors.ErrorTeacherNotFound):
-				httputils.RespondWith404(w, err.Error(), h.log)
-			case errors.Is(err, serviceErrors.ErrorStudentAndTeacherSame):
-				httputils.RespondWith403(w, "teacher can not review himself", h.log)
-			case errors.Is(err, serviceErrors.ErrorCategoryNotFound):
+			case errors.Is(err, serviceErrors.ErrorLessonNotFound):
 				httputils.RespondWith404(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorNotRelatedUserToLesson):
+				httputils.RespondWith403(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorLessonNotFinished):
+				httputils.RespondWith403(w, err.Error(), h.log)
 			case errors.Is(err, serviceErrors.ErrorSkillUnregistered):
 				httputils.RespondWith404(w, err.Error(), h.log)
-			case errors.Is(err, serviceErrors.ErrorFinishedLessonNotFound):
-				httputils.RespondWith403(w, "You have not finished lesson with this teacher and this category", h.log)
 			case errors.Is(err, serviceErrors.ErrorReviewExists):
 				httputils.RespondWith409(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorContentRejected):
+				httputils.RespondWith400(w, err.Error(), h.log)
 			default:
 				h.log.Error(err.Error())
 				httputils.RespondWith500(w, h.log)
@@ -100,8 +99,7 @@ func (h *ReviewHandlers) CreateReview() http.HandlerFunc {
 }
 
 type addReviewRequest struct {
-	TeacherID  int    `json:"teacher_id"  example:"1"            binding:"required"`
-	CategoryID int    `json:"category_id" example:"1"            binding:"required"`
-	Rate       int    `json:"rate"        example:"1"            binding:"required"`
-	Comment    string `json:"comment"     example:"some comment" binding:"required"`
+	LessonID int    `json:"lesson_id" example:"1"            binding:"required"`
+	Rate     int    `json:"rate"      example:"1"            binding:"required"`
+	Comment  string `json:"comment"   example:"some comment" binding:"required"`
 }
@@ -0,0 +1,141 @@
+package review
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	reviewRoute = "/review/{id}"
+)
+
+// UpdateReview returns http.HandlerFunc
+// @Summary Edit review
+// @Description Change rate and comment of own review, it's possible within 14 days since review creation
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param updateReviewRequest body updateReviewRequest true "New review data"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /review/{id} [put]
+// @Security     BearerAuth
+func (h *ReviewHandlers) UpdateReview() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		reviewID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		var req updateReviewRequest
+		if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		if req.Rate == 0 || req.Comment == "" {
+			httputils.RespondWith400(w, "rate or comment are empty", h.log)
+
+			return
+		}
+
+		if req.Rate < 1 || req.Rate > 5 {
+			httputils.RespondWith400(w, "rate must be from 1 to 5", h.log)
+
+			return
+		}
+
+		if err = h.reviewService.UpdateReview(r.Context(), userID, reviewID, req.Rate, req.Comment); err != nil {
+			h.respondReviewChangeError(w, err)
+
+			return
+		}
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+// DeleteReview returns http.HandlerFunc
+// @Summary Delete review
+// @Description Delete own review, it's possible within 14 days since review creation
+// @Tags reviews
+// @Produce json
+// @Param id path int true "Review ID"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /review/{id} [delete]
+// @Security     BearerAuth
+func (h *ReviewHandlers) DeleteReview() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		reviewID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		if err = h.reviewService.DeleteReview(r.Context(), userID, reviewID); err != nil {
+			h.respondReviewChangeError(w, err)
+
+			return
+		}
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+func (h *ReviewHandlers) respondReviewChangeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, serviceErrors.ErrorReviewNotFound):
+		httputils.RespondWith404(w, err.Error(), h.log)
+	case errors.Is(err, serviceErrors.ErrorNotReviewAuthor),
+		errors.Is(err, serviceErrors.ErrorReviewEditWindowExpired):
+		httputils.RespondWith403(w, err.Error(), h.log)
+	case errors.Is(err, serviceErrors.ErrorContentRejected):
+		httputils.RespondWith400(w, err.Error(), h.log)
+	default:
+		h.log.Error(err.Error())
+		httputils.RespondWith500(w, h.log)
+	}
+}
+
+type updateReviewRequest struct {
+	Rate    int    `json:"rate"    example:"4"                binding:"required"`
+	Comment string `json:"comment" example:"some new comment" binding:"required"`
+}
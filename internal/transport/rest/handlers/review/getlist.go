@@ -3,7 +3,11 @@ package review
 import (
 	"errors"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
 	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
 	"go.uber.org/zap"
@@ -15,10 +19,14 @@ const (
 
 // GetReviewList returns http.HandlerFunc
 // @Summary Get reviews
-// @Description Get all reviews about teacher
+// @Description Get one page of reviews about teacher with teacher's replies and reviewed lesson time. Use next_cursor from response as cursor param to get the next page (empty next_cursor means the last page). Summary (rates distribution and averages by categories) is about all teacher's reviews. If rating weighting is enabled, weighted_rate is teacher rate where reviews of recent lessons weigh more
 // @Tags teachers
 // @Produce json
 // @Param id path int true "Teacher ID"
+// @Param category_id query int false "Filter by category"
+// @Param sort query string false "Sorting (default newest), reviews with the same rate are ordered from newest" Enums(newest, highest, lowest)
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor of the page"
 // @Success 200 {object} getReviewResponse
 // @Failure 400 {object} httputils.ErrorStruct
 // @Failure 404 {object} httputils.ErrorStruct
@@ -34,11 +42,22 @@ func (h *ReviewHandlers) GetReviewList() http.HandlerFunc {
 			return
 		}
 
-		reviews, err := h.reviewService.GetReviews(r.Context(), teacherID)
+		filter, err := parseReviewListFilter(r.URL.Query())
+		if err != nil {
+			httputils.RespondWith400(w, err.Error(), h.log)
+
+			return
+		}
+
+		filter.TeacherID = teacherID
+
+		reviews, nextCursor, err := h.reviewService.GetReviews(r.Context(), filter, r.URL.Query().Get("cursor"))
 		if err != nil {
 			switch {
 			case errors.Is(err, serviceErrors.ErrorTeacherNotFound):
 				httputils.RespondWith404(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorInvalidCursor):
+				httputils.RespondWith400(w, err.Error(), h.log)
 			default:
 				h.log.Error(err.Error())
 				httputils.RespondWith500(w, h.log)
@@ -47,8 +66,27 @@ func (h *ReviewHandlers) GetReviewList() http.HandlerFunc {
 			return
 		}
 
+		summary, err := h.reviewService.GetReviewSummary(r.Context(), teacherID)
+		if err != nil {
+			h.log.Error(err.Error())
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		weightedRate, err := h.reviewService.GetWeightedRate(r.Context(), teacherID)
+		if err != nil {
+			h.log.Error(err.Error())
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
 		resp := &getReviewResponse{
-			Reviews: make([]respReview, 0, len(reviews)),
+			Reviews:      make([]respReview, 0, len(reviews)),
+			NextCursor:   nextCursor,
+			Summary:      newRespReviewSummary(summary),
+			WeightedRate: weightedRate,
 		}
 
 		for _, review := range reviews {
@@ -57,6 +95,8 @@ func (h *ReviewHandlers) GetReviewList() http.HandlerFunc {
 				TeacherID:      review.TeacherID,
 				SkillID:        review.SkillID,
 				CategoryID:     review.CategoryID,
+				LessonID:       review.LessonID,
+				LessonDatetime: review.LessonDatetime,
 				Rate:           review.Rate,
 				Comment:        review.Comment,
 				StudentID:      review.StudentID,
@@ -64,6 +104,9 @@ func (h *ReviewHandlers) GetReviewList() http.HandlerFunc {
 				StudentName:    review.StudentData.Name,
 				StudentSurname: review.StudentData.Surname,
 				StudentAvatar:  review.StudentData.Avatar,
+				CreatedAt:      review.CreatedAt,
+				UpdatedAt:      review.UpdatedAt,
+				Reply:          newRespReviewReply(review.Reply),
 			})
 		}
 
@@ -72,20 +115,126 @@ func (h *ReviewHandlers) GetReviewList() http.HandlerFunc {
 	}
 }
 
+// parseReviewListFilter maps query params into filter, returns error with message for client.
+func parseReviewListFilter(query url.Values) (*entities.ReviewListFilter, error) {
+	filter := &entities.ReviewListFilter{}
+
+	if value := query.Get("category_id"); value != "" {
+		categoryID, err := strconv.Atoi(value)
+		if err != nil || categoryID <= 0 {
+			return nil, errors.New("category_id must be positive number")
+		}
+
+		filter.CategoryID = &categoryID
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			return nil, errors.New("limit must be non-negative number")
+		}
+
+		filter.Limit = limit
+	}
+
+	filter.SortBy = entities.ReviewSortField(query.Get("sort"))
+	switch filter.SortBy {
+	case "":
+		filter.SortBy = entities.ReviewSortByNewest
+	case entities.ReviewSortByNewest, entities.ReviewSortByHighest, entities.ReviewSortByLowest:
+	default:
+		return nil, errors.New("unknown sort field")
+	}
+
+	return filter, nil
+}
+
+func newRespReviewSummary(summary *entities.ReviewSummary) respReviewSummary {
+	resp := respReviewSummary{
+		ReviewsCount: summary.ReviewsCount,
+		AverageRate:  summary.AverageRate,
+		Distribution: make([]respRateCount, 0, 5),
+		Categories:   make([]respCategoryRate, 0, len(summary.Categories)),
+	}
+
+	for rate := 5; rate >= 1; rate-- {
+		resp.Distribution = append(resp.Distribution, respRateCount{
+			Rate:         rate,
+			ReviewsCount: summary.Distribution[rate],
+		})
+	}
+
+	for _, category := range summary.Categories {
+		resp.Categories = append(resp.Categories, respCategoryRate{
+			CategoryID:   category.CategoryID,
+			CategoryName: category.CategoryName,
+			ReviewsCount: category.ReviewsCount,
+			AverageRate:  category.AverageRate,
+		})
+	}
+
+	return resp
+}
+
 type getReviewResponse struct {
-	Reviews []respReview `json:"reviews"`
+	Reviews      []respReview      `json:"reviews"`
+	NextCursor   string            `json:"next_cursor"             example:"eyJzIjoibmV3ZXN0IiwiciI6NSwidCI6IjIwMjUtMDEtMDlUMTA6MTA6MTBaIiwiaWQiOjEyfQ"`
+	Summary      respReviewSummary `json:"summary"`
+	WeightedRate *float64          `json:"weighted_rate,omitempty" example:"4.3"`
+}
+
+type respReviewSummary struct {
+	ReviewsCount int                `json:"reviews_count" example:"10"`
+	AverageRate  float64            `json:"average_rate"  example:"4.5"`
+	Distribution []respRateCount    `json:"distribution"` // from 5 to 1 stars
+	Categories   []respCategoryRate `json:"categories"`
+}
+
+type respRateCount struct {
+	Rate         int `json:"rate"          example:"5"`
+	ReviewsCount int `json:"reviews_count" example:"7"`
+}
+
+type respCategoryRate struct {
+	CategoryID   int     `json:"category_id"   example:"1"`
+	CategoryName string  `json:"category_name" example:"Programming"`
+	ReviewsCount int     `json:"reviews_count" example:"4"`
+	AverageRate  float64 `json:"average_rate"  example:"4.75"`
 }
 
 type respReview struct {
-	ReviewID       int    `json:"review_id"   example:"1"`
-	TeacherID      int    `json:"teacher_id"  example:"1"`
-	SkillID        int    `json:"skill_id"    example:"1"`
-	CategoryID     int    `json:"category_id" example:"1"`
-	Rate           int    `json:"rate"        example:"5"`
-	Comment        string `json:"comment"     example:"This is a comment"`
-	StudentID      int    `json:"student_id"      example:"1"`
-	StudentEmail   string `json:"student_email"   example:"qwerty@example.com"`
-	StudentName    string `json:"student_name"    example:"John"`
-	StudentSurname string `json:"student_surname" example:"Smith"`
-	StudentAvatar  string `json:"student_avatar"  example:"uuid.png"`
+	ReviewID       int              `json:"review_id"                 example:"1"`
+	TeacherID      int              `json:"teacher_id"                example:"1"`
+	SkillID        int              `json:"skill_id"                  example:"1"`
+	CategoryID     int              `json:"category_id"               example:"1"`
+	LessonID       *int             `json:"lesson_id,omitempty"       example:"1"`
+	LessonDatetime *time.Time       `json:"lesson_datetime,omitempty" example:"2025-01-08T10:00:00Z"`
+	Rate           int              `json:"rate"                      example:"5"`
+	Comment        string           `json:"comment"                   example:"This is a comment"`
+	StudentID      int              `json:"student_id"                example:"1"`
+	StudentEmail   string           `json:"student_email"             example:"qwerty@example.com"`
+	StudentName    string           `json:"student_name"              example:"John"`
+	StudentSurname string           `json:"student_surname"           example:"Smith"`
+	StudentAvatar  string           `json:"student_avatar"            example:"uuid.png"`
+	CreatedAt      time.Time        `json:"created_at"                example:"2025-01-09T10:10:10Z"`
+	UpdatedAt      *time.Time       `json:"updated_at,omitempty"      example:"2025-01-10T10:10:10Z"`
+	Reply          *respReviewReply `json:"reply,omitempty"`
+}
+
+type respReviewReply struct {
+	Comment   string     `json:"comment"              example:"Thank you for feedback!"`
+	CreatedAt time.Time  `json:"created_at"           example:"2025-01-11T10:10:10Z"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" example:"2025-01-12T10:10:10Z"`
+}
+
+func newRespReviewReply(reply *entities.ReviewReply) *respReviewReply {
+	if reply == nil {
+		return nil
+	}
+
+	return &respReviewReply{
+		Comment:   reply.Comment,
+		CreatedAt: reply.CreatedAt,
+		UpdatedAt: reply.UpdatedAt,
+	}
 }
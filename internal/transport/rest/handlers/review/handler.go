@@ -11,7 +11,14 @@ import (
 
 type ReviewService interface {
 	CreateReview(ctx context.Context, review *entities.Review) error
-	GetReviews(ctx context.Context, teacherID int) ([]*entities.Review, error)
+	GetReviews(ctx context.Context, filter *entities.ReviewListFilter, cursor string) ([]*entities.Review, string, error)
+	GetReviewSummary(ctx context.Context, teacherID int) (*entities.ReviewSummary, error)
+	GetWeightedRate(ctx context.Context, teacherID int) (*float64, error)
+	UpdateReview(ctx context.Context, userID, reviewID, rate int, comment string) error
+	DeleteReview(ctx context.Context, userID, reviewID int) error
+	CreateReviewReply(ctx context.Context, userID, reviewID int, comment string) error
+	UpdateReviewReply(ctx context.Context, userID, reviewID int, comment string) error
+	ReportReview(ctx context.Context, report *entities.ReviewReport) error
 }
 
 type ReviewHandlers struct {
@@ -30,6 +37,11 @@ func (h *ReviewHandlers) SetupReviewRoutes(router *chi.Mux, authMiddleware func(
 	router.Group(func(r chi.Router) {
 		r.Use(authMiddleware)
 		r.Post(createRoute, h.CreateReview())
+		r.Put(reviewRoute, h.UpdateReview())
+		r.Delete(reviewRoute, h.DeleteReview())
+		r.Post(replyRoute, h.CreateReviewReply())
+		r.Put(replyRoute, h.UpdateReviewReply())
+		r.Post(reportRoute, h.ReportReview())
 	})
 
 	router.Get(getListRoute, h.GetReviewList())
@@ -0,0 +1,140 @@
+package review
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	replyRoute = "/review/{id}/reply"
+)
+
+// CreateReviewReply returns http.HandlerFunc
+// @Summary Reply to review
+// @Description Teacher (user id from token) posts public reply to review about him, only one reply per review. Review author gets notification
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param reviewReplyRequest body reviewReplyRequest true "Reply data"
+// @Success 201
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 409 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /review/{id}/reply [post]
+// @Security     BearerAuth
+func (h *ReviewHandlers) CreateReviewReply() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, reviewID, req, ok := h.parseReviewReplyRequest(w, r)
+		if !ok {
+			return
+		}
+
+		if err := h.reviewService.CreateReviewReply(r.Context(), userID, reviewID, req.Comment); err != nil {
+			h.respondReviewReplyError(w, err)
+
+			return
+		}
+
+		httputils.SuccessRespondWith201(w, struct{}{}, h.log)
+	}
+}
+
+// UpdateReviewReply returns http.HandlerFunc
+// @Summary Edit reply to review
+// @Description Teacher (user id from token) changes his reply to review about him. Review author gets notification
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param reviewReplyRequest body reviewReplyRequest true "New reply data"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /review/{id}/reply [put]
+// @Security     BearerAuth
+func (h *ReviewHandlers) UpdateReviewReply() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, reviewID, req, ok := h.parseReviewReplyRequest(w, r)
+		if !ok {
+			return
+		}
+
+		if err := h.reviewService.UpdateReviewReply(r.Context(), userID, reviewID, req.Comment); err != nil {
+			h.respondReviewReplyError(w, err)
+
+			return
+		}
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+// parseReviewReplyRequest extracts user id, review id and body, responds with error if something is wrong.
+func (h *ReviewHandlers) parseReviewReplyRequest(w http.ResponseWriter, r *http.Request) (int, int, reviewReplyRequest, bool) {
+	var req reviewReplyRequest
+
+	userIDValue := r.Context().Value(jwt.UserIDKey)
+	userID, ok := userIDValue.(int)
+	if !ok || userID == 0 {
+		h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+		httputils.RespondWith500(w, h.log)
+
+		return 0, 0, req, false
+	}
+
+	reviewID, err := httputils.GetIntParamFromRequestPath(r, "id")
+	if err != nil {
+		httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+		return 0, 0, req, false
+	}
+
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.RespondWith400(w, "failed to decode body", h.log)
+
+		return 0, 0, req, false
+	}
+
+	if req.Comment == "" {
+		httputils.RespondWith400(w, "comment is empty", h.log)
+
+		return 0, 0, req, false
+	}
+
+	return userID, reviewID, req, true
+}
+
+func (h *ReviewHandlers) respondReviewReplyError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, serviceErrors.ErrorReviewNotFound),
+		errors.Is(err, serviceErrors.ErrorReviewReplyNotFound):
+		httputils.RespondWith404(w, err.Error(), h.log)
+	case errors.Is(err, serviceErrors.ErrorUserIsNotTeacher),
+		errors.Is(err, serviceErrors.ErrorNotReviewedTeacher):
+		httputils.RespondWith403(w, err.Error(), h.log)
+	case errors.Is(err, serviceErrors.ErrorReviewReplyExists):
+		httputils.RespondWith409(w, err.Error(), h.log)
+	case errors.Is(err, serviceErrors.ErrorContentRejected):
+		httputils.RespondWith400(w, err.Error(), h.log)
+	default:
+		h.log.Error(err.Error())
+		httputils.RespondWith500(w, h.log)
+	}
+}
+
+type reviewReplyRequest struct {
+	Comment string `json:"comment" example:"Thank you for feedback!" binding:"required"`
+}
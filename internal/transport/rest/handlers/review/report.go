@@ -0,0 +1,109 @@
+package review
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	reportRoute = "/review/{id}/report"
+)
+
+// ReportReview returns http.HandlerFunc
+// @Summary Report review
+// @Description Report review or teacher's reply to it (target: review (default) or reply) for moderation, one report per user
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param reportReviewRequest body reportReviewRequest true "Report data"
+// @Success 201
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 409 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /review/{id}/report [post]
+// @Security     BearerAuth
+func (h *ReviewHandlers) ReportReview() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		reviewID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed {id} param in url path", h.log)
+
+			return
+		}
+
+		var req reportReviewRequest
+		if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		if req.Reason == "" {
+			httputils.RespondWith400(w, "reason is empty", h.log)
+
+			return
+		}
+
+		target := entities.ReviewReportTarget(req.Target)
+		if target == "" {
+			target = entities.ReviewReportTargetReview
+		}
+
+		if target != entities.ReviewReportTargetReview && target != entities.ReviewReportTargetReply {
+			httputils.RespondWith400(w, "target must be review or reply", h.log)
+
+			return
+		}
+
+		report := &entities.ReviewReport{
+			ReviewID:   reviewID,
+			Target:     target,
+			ReporterID: userID,
+			Reason:     req.Reason,
+		}
+
+		if err = h.reviewService.ReportReview(r.Context(), report); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorReviewNotFound),
+				errors.Is(err, serviceErrors.ErrorReviewReplyNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorReportOwnReview),
+				errors.Is(err, serviceErrors.ErrorContentRejected):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorReviewAlreadyReported):
+				httputils.RespondWith409(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		httputils.SuccessRespondWith201(w, struct{}{}, h.log)
+	}
+}
+
+type reportReviewRequest struct {
+	Target string `json:"target" example:"review"`
+	Reason string `json:"reason" example:"offensive language" binding:"required"`
+}
@@ -17,7 +17,7 @@ const (
 
 // AddSkill returns http.HandlerFunc
 // @Summary Registrate new skill
-// @Description Registrate new skill for teacher (if he not exists create and registrate skill)
+// @Description Registrate new skill for teacher (if he not exists create and registrate skill). video_card_link is optional (deprecated), upload video card file by /api/teacher/skills/{id}/video
 // @Tags teachers
 // @Accept json
 // @Produce json
@@ -25,6 +25,7 @@ const (
 // @Success 201
 // @Failure 400 {object} httputils.ErrorStruct
 // @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
 // @Failure 409 {object} httputils.ErrorStruct
 // @Failure 500 {object} httputils.ErrorStruct
 // @Router /teacher/skill [post]
@@ -48,26 +49,34 @@ func (h *TeacherHandlers) AddSkill() http.HandlerFunc {
 			return
 		}
 
-		if req.CategoryID == 0 || req.About == "" || req.VideoCardLink == "" {
+		if req.CategoryID == 0 || req.About == "" {
 			switch {
 			case req.CategoryID == 0:
 				httputils.RespondWith400(w, "category_id is empty (required)", h.log)
 			case req.About == "":
 				httputils.RespondWith400(w, "about is empty (required)", h.log)
-			case req.VideoCardLink == "":
-				httputils.RespondWith400(w, "video_card_link is empty (required)", h.log)
 			}
 
 			return
 		}
 
-		err := h.teacherService.AddSkill(r.Context(), userID, req.CategoryID, req.VideoCardLink, req.About)
+		if req.Price < 0 {
+			httputils.RespondWith400(w, "price must not be negative", h.log)
+
+			return
+		}
+
+		err := h.teacherService.AddSkill(r.Context(), userID, req.CategoryID, req.VideoCardLink, req.About, req.Price)
 		if err != nil {
 			switch {
 			case errors.Is(err, serviceErrors.ErrorUserNotFound):
 				httputils.RespondWith401(w, err.Error(), h.log)
-			case errors.Is(err, serviceErrors.ErrorCategoryNotFound):
+			case errors.Is(err, serviceErrors.ErrorCategoryNotFound),
+				errors.Is(err, serviceErrors.ErrorCategoryArchived),
+				errors.Is(err, serviceErrors.ErrorContentRejected):
 				httputils.RespondWith400(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorTeacherTooYoung):
+				httputils.RespondWith403(w, err.Error(), h.log)
 			case errors.Is(err, serviceErrors.ErrorSkillRegistered):
 				httputils.RespondWith409(w, err.Error(), h.log)
 			default:
@@ -86,4 +95,5 @@ type addSkillRequest struct {
 	CategoryID    int    `json:"category_id"     example:"1"                                                binding:"required"`
 	VideoCardLink string `json:"video_card_link" example:"https://youtu.be/HIcSWuKMwOw?si=FtxN1QJU9ZWnXy85"`
 	About         string `json:"about"           example:"I am Groot"`
+	Price         int    `json:"price"           example:"500"`
 }
@@ -0,0 +1,201 @@
+package teacher
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	getAnalyticsRoute = "/analytics"
+
+	analyticsDateLayout       = time.DateOnly
+	defaultAnalyticsRangeDays = 90
+	maxAnalyticsRangeDays     = 731
+)
+
+// GetAnalytics returns http.HandlerFunc
+// @Summary Get teacher's analytics dashboard
+// @Description Lessons, earnings, cancellation/rejection/no-show rates, repeat students and average rating of teacher (user id from token) for days range: total, by weeks or months and by categories. Lessons are counted by day (UTC) of their time, reviews by day of creation. No-show is a planned lesson which was never started or was cancelled after its start. Data is refreshed every few minutes
+// @Tags teachers
+// @Produce json
+// @Param from query string false "First day of range, YYYY-MM-DD (default 89 days before to)"
+// @Param to query string false "Last day of range, YYYY-MM-DD (default today)"
+// @Param period query string false "Grouping period (default week)" Enums(week, month)
+// @Success 200 {object} getAnalyticsResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /teacher/analytics [get]
+// @Security     BearerAuth
+func (h *TeacherHandlers) GetAnalytics() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		filter, err := parseAnalyticsFilter(r.URL.Query())
+		if err != nil {
+			httputils.RespondWith400(w, err.Error(), h.log)
+
+			return
+		}
+
+		analytics, err := h.teacherService.GetTeacherAnalytics(r.Context(), userID, *filter)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorUserIsNotTeacher):
+				httputils.RespondWith403(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		resp := getAnalyticsResponse{
+			From:                analytics.Filter.From.Format(analyticsDateLayout),
+			To:                  analytics.Filter.To.Format(analyticsDateLayout),
+			Period:              string(analytics.Filter.Period),
+			Total:               mapStatsBucket(&analytics.Total),
+			StudentsCount:       analytics.StudentsCount,
+			RepeatStudentsCount: analytics.RepeatStudentsCount,
+			RepeatStudentRate:   analytics.RepeatStudentRate,
+			Periods:             make([]respAnalyticsPeriod, 0, len(analytics.Periods)),
+			Categories:          make([]respAnalyticsCategory, 0, len(analytics.Categories)),
+		}
+
+		for i := range analytics.Periods {
+			resp.Periods = append(resp.Periods, respAnalyticsPeriod{
+				PeriodStart:        analytics.Periods[i].PeriodStart.Format(analyticsDateLayout),
+				respAnalyticsStats: mapStatsBucket(&analytics.Periods[i]),
+			})
+		}
+
+		for i := range analytics.Categories {
+			resp.Categories = append(resp.Categories, respAnalyticsCategory{
+				CategoryID:         analytics.Categories[i].CategoryID,
+				CategoryName:       analytics.Categories[i].CategoryName,
+				respAnalyticsStats: mapStatsBucket(&analytics.Categories[i]),
+			})
+		}
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+func parseAnalyticsFilter(query url.Values) (*entities.TeacherAnalyticsFilter, error) {
+	now := time.Now().UTC()
+
+	filter := &entities.TeacherAnalyticsFilter{
+		To:     time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC),
+		Period: entities.AnalyticsPeriodWeek,
+	}
+
+	var err error
+
+	if value := query.Get("to"); value != "" {
+		if filter.To, err = time.Parse(analyticsDateLayout, value); err != nil {
+			return nil, errors.New("to must be date in YYYY-MM-DD format")
+		}
+	}
+
+	filter.From = filter.To.AddDate(0, 0, -(defaultAnalyticsRangeDays - 1))
+
+	if value := query.Get("from"); value != "" {
+		if filter.From, err = time.Parse(analyticsDateLayout, value); err != nil {
+			return nil, errors.New("from must be date in YYYY-MM-DD format")
+		}
+	}
+
+	if filter.From.After(filter.To) {
+		return nil, errors.New("from must be less or equal than to")
+	}
+
+	if filter.To.Sub(filter.From) >= maxAnalyticsRangeDays*24*time.Hour {
+		return nil, fmt.Errorf("range must be not longer than %d days", maxAnalyticsRangeDays)
+	}
+
+	if value := query.Get("period"); value != "" {
+		switch entities.AnalyticsPeriod(value) {
+		case entities.AnalyticsPeriodWeek, entities.AnalyticsPeriodMonth:
+			filter.Period = entities.AnalyticsPeriod(value)
+		default:
+			return nil, errors.New("period must be one of: week, month")
+		}
+	}
+
+	return filter, nil
+}
+
+func mapStatsBucket(bucket *entities.TeacherStatsBucket) respAnalyticsStats {
+	return respAnalyticsStats{
+		LessonsCount:     bucket.LessonsCount,
+		FinishedCount:    bucket.FinishedCount,
+		CancelledCount:   bucket.CancelledCount,
+		RejectedCount:    bucket.RejectedCount,
+		NoShowCount:      bucket.NoShowCount,
+		Earnings:         bucket.Earnings,
+		ReviewsCount:     bucket.ReviewsCount,
+		AverageRate:      bucket.AverageRate,
+		CancellationRate: bucket.CancellationRate,
+		RejectionRate:    bucket.RejectionRate,
+		NoShowRate:       bucket.NoShowRate,
+	}
+}
+
+// @Description teacher's dashboard getAnalyticsResponse.
+type getAnalyticsResponse struct {
+	From                string                  `json:"from"                  example:"2025-01-01"`
+	To                  string                  `json:"to"                    example:"2025-03-31"`
+	Period              string                  `json:"period"                example:"week"`
+	Total               respAnalyticsStats      `json:"total"`
+	StudentsCount       int                     `json:"students_count"        example:"12"`
+	RepeatStudentsCount int                     `json:"repeat_students_count" example:"5"`
+	RepeatStudentRate   float64                 `json:"repeat_student_rate"   example:"0.42"`
+	Periods             []respAnalyticsPeriod   `json:"periods"`
+	Categories          []respAnalyticsCategory `json:"categories"`
+}
+
+// @Description aggregated stats respAnalyticsStats, rates are from 0 to 1.
+type respAnalyticsStats struct {
+	LessonsCount     int     `json:"lessons_count"     example:"30"`
+	FinishedCount    int     `json:"finished_count"    example:"24"`
+	CancelledCount   int     `json:"cancelled_count"   example:"3"`
+	RejectedCount    int     `json:"rejected_count"    example:"1"`
+	NoShowCount      int     `json:"no_show_count"     example:"2"`
+	Earnings         int64   `json:"earnings"          example:"12000"`
+	ReviewsCount     int     `json:"reviews_count"     example:"10"`
+	AverageRate      float64 `json:"average_rate"      example:"4.7"`
+	CancellationRate float64 `json:"cancellation_rate" example:"0.1"`
+	RejectionRate    float64 `json:"rejection_rate"    example:"0.03"`
+	NoShowRate       float64 `json:"no_show_rate"      example:"0.07"`
+}
+
+// @Description stats of one week or month respAnalyticsPeriod.
+type respAnalyticsPeriod struct {
+	PeriodStart string `json:"period_start" example:"2025-01-06"`
+	respAnalyticsStats
+}
+
+// @Description stats of one category respAnalyticsCategory.
+type respAnalyticsCategory struct {
+	CategoryID   int    `json:"category_id"   example:"1"`
+	CategoryName string `json:"category_name" example:"Programming"`
+	respAnalyticsStats
+}
@@ -0,0 +1,300 @@
+package teacher
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"github.com/LearnShareApp/learn-share-backend/pkg/storage/object"
+	"go.uber.org/zap"
+)
+
+const (
+	addCertificateRoute        = "/certificates"
+	deleteCertificateRoute     = "/certificates/{id}"
+	getCertificateFileRoute    = "/certificates/{id}/file"
+	getOwnCertificateFileRoute = "/certificates/{id}/file"
+
+	maxCertificateSize        = 5 << 20
+	maxCertificateTitleLength = 200
+	// base64 encoded file and the rest of json
+	maxCertificateRequestSize = maxCertificateSize*4/3 + 64<<10
+)
+
+// AddCertificate returns http.HandlerFunc which handle upload of teacher's certificate
+// @Summary Add certificate
+// @Description Upload certificate file (base64 encoded pdf, png or jpeg, max 5MB) of teacher (user id from token). Certificate is unverified until admin verifies it
+// @Tags teachers
+// @Accept json
+// @Produce json
+// @Param addCertificateRequest body addCertificateRequest true "Certificate data"
+// @Success 201 {object} addCertificateResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 413 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /teacher/certificates [post]
+// @Security     BearerAuth
+func (h *TeacherHandlers) AddCertificate() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxCertificateRequestSize)
+
+		var req addCertificateRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				httputils.RespondWith413(w, "file too large", h.log)
+
+				return
+			}
+
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		req.Title = strings.TrimSpace(req.Title)
+		if req.Title == "" || req.File == "" {
+			httputils.RespondWith400(w, "title or file is empty (required)", h.log)
+
+			return
+		}
+
+		if utf8.RuneCountInString(req.Title) > maxCertificateTitleLength {
+			httputils.RespondWith400(w, fmt.Sprintf("title must be not longer than %d symbols", maxCertificateTitleLength), h.log)
+
+			return
+		}
+
+		fileBytes, err := base64.StdEncoding.DecodeString(req.File)
+		if err != nil {
+			httputils.RespondWith400(w, "invalid file format", h.log)
+
+			return
+		}
+
+		if len(fileBytes) > maxCertificateSize {
+			httputils.RespondWith400(w, "file too large", h.log)
+
+			return
+		}
+
+		contentType := http.DetectContentType(fileBytes)
+
+		extension, ok := entities.CertificateContentTypes[contentType]
+		if !ok {
+			httputils.RespondWith400(w, "file must be pdf, png or jpeg", h.log)
+
+			return
+		}
+
+		id, err := h.teacherService.AddTeacherCertificate(r.Context(), userID, req.Title,
+			bytes.NewReader(fileBytes), int64(len(fileBytes)), extension)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorUserIsNotTeacher):
+				httputils.RespondWith403(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorContentRejected):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		httputils.SuccessRespondWith201(w, addCertificateResponse{ID: id}, h.log)
+	}
+}
+
+// DeleteCertificate returns http.HandlerFunc which handle deleting of teacher's certificate
+// @Summary Delete certificate
+// @Description Delete certificate (and its file) of teacher (user id from token)
+// @Tags teachers
+// @Produce json
+// @Param id path int true "Certificate ID"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /teacher/certificates/{id} [delete]
+// @Security     BearerAuth
+func (h *TeacherHandlers) DeleteCertificate() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		certificateID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed or not-number {id} param in url path", h.log)
+
+			return
+		}
+
+		if err = h.teacherService.DeleteTeacherCertificate(r.Context(), userID, certificateID); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorUserIsNotTeacher):
+				httputils.RespondWith403(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorCertificateNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+// GetCertificateFile returns http.HandlerFunc which handle download of certificate file
+// @Summary Get certificate file
+// @Description Get file of teacher's verified certificate by certificate ID
+// @Tags teachers
+// @Produce application/pdf,image/png,image/jpeg
+// @Param id path int true "Certificate ID"
+// @Success 200 {file} binary "Certificate file"
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /teachers/certificates/{id}/file [get]
+func (h *TeacherHandlers) GetCertificateFile() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		certificateID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed or not-number {id} param in url path", h.log)
+
+			return
+		}
+
+		file, err := h.teacherService.GetCertificateFile(r.Context(), certificateID)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorCertificateNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.respondWithCertificateFile(w, file)
+	}
+}
+
+// GetOwnCertificateFile returns http.HandlerFunc which handle download of teacher's own certificate file
+// @Summary Get own certificate file
+// @Description Get file of certificate (also not verified yet) of teacher (user id from token)
+// @Tags teachers
+// @Produce application/pdf,image/png,image/jpeg
+// @Param id path int true "Certificate ID"
+// @Success 200 {file} binary "Certificate file"
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /teacher/certificates/{id}/file [get]
+// @Security     BearerAuth
+func (h *TeacherHandlers) GetOwnCertificateFile() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		certificateID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed or not-number {id} param in url path", h.log)
+
+			return
+		}
+
+		file, err := h.teacherService.GetOwnCertificateFile(r.Context(), userID, certificateID)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorUserIsNotTeacher):
+				httputils.RespondWith403(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorCertificateNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.respondWithCertificateFile(w, file)
+	}
+}
+
+// respondWithCertificateFile writes certificate file into response and closes it.
+func (h *TeacherHandlers) respondWithCertificateFile(w http.ResponseWriter, file *object.File) {
+	defer func() {
+		if closer, ok := file.FileReader.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				h.log.Error("failed to close reader", zap.Error(err))
+			}
+		}
+	}()
+
+	contentType := "application/octet-stream"
+
+	for ct, extension := range entities.CertificateContentTypes {
+		if extension == file.Extension {
+			contentType = ct
+		}
+	}
+
+	if err := httputils.RespondWithFile(w, http.StatusOK, file.FileReader, contentType); err != nil {
+		h.log.Error("response error", zap.Error(err))
+	}
+}
+
+type addCertificateRequest struct {
+	Title string `json:"title" example:"IELTS 8.0"            binding:"required"`
+	File  string `json:"file"  example:"base64 encoded file" binding:"required"`
+}
+
+type addCertificateResponse struct {
+	ID int `json:"certificate_id" example:"1"`
+}
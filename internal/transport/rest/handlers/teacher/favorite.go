@@ -0,0 +1,166 @@
+package teacher
+
+import (
+	"errors"
+	"net/http"
+
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	favoriteTeacherRoute     = "/{id}/favorite"
+	getFavoriteTeachersRoute = "/favorites"
+)
+
+// AddFavoriteTeacher returns http.HandlerFunc which handle adding teacher to favorites
+// @Summary Add teacher to favorites
+// @Description Bookmark teacher by TeacherID for user in token (adding already favorite teacher is not an error)
+// @Tags teachers
+// @Produce json
+// @Param id path int true "Teacher's ID"
+// @Success 201
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /teachers/{id}/favorite [post]
+// @Security     BearerAuth
+func (h *TeacherHandlers) AddFavoriteTeacher() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		teacherID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed or not-number {id} param in url path", h.log)
+
+			return
+		}
+
+		if err = h.teacherService.AddFavoriteTeacher(r.Context(), userID, teacherID); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorTeacherNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorFavoriteYourself):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		httputils.SuccessRespondWith201(w, struct{}{}, h.log)
+	}
+}
+
+// RemoveFavoriteTeacher returns http.HandlerFunc which handle removing teacher from favorites
+// @Summary Remove teacher from favorites
+// @Description Remove bookmark of teacher by TeacherID for user in token (removing not favorite teacher is not an error)
+// @Tags teachers
+// @Produce json
+// @Param id path int true "Teacher's ID"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /teachers/{id}/favorite [delete]
+// @Security     BearerAuth
+func (h *TeacherHandlers) RemoveFavoriteTeacher() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		teacherID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed or not-number {id} param in url path", h.log)
+
+			return
+		}
+
+		if err = h.teacherService.RemoveFavoriteTeacher(r.Context(), userID, teacherID); err != nil {
+			h.log.Error(err.Error())
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+// GetFavoriteTeachers returns http.HandlerFunc which handle get favorite teachers
+// @Summary Get favorite teachers
+// @Description Get one page of favorite teachers of user in token. Supports the same filters, sorting and cursor pagination as teachers catalogue
+// @Tags teachers
+// @Produce json
+// @Param category query []string false "Filter categories (can be repeated)" collectionFormat(multi)
+// @Param min_rating query number false "Minimal teacher's rating"
+// @Param min_price query int false "Minimal skill price"
+// @Param max_price query int false "Maximal skill price"
+// @Param available_within query int false "Has an available schedule time within the next N hours"
+// @Param sort query string false "Sorting field (default ranking: Bayesian average of reviews rate, rating is raw average)" Enums(ranking, rating, reviews, lessons, price, newest)
+// @Param order query string false "Sorting order (default: asc for price, desc for others)" Enums(asc, desc)
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor of the page"
+// @Success 200 {object} getTeachersResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /teachers/favorites [get]
+// @Security     BearerAuth
+func (h *TeacherHandlers) GetFavoriteTeachers() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		filter, err := parseTeacherListFilter(r.URL.Query())
+		if err != nil {
+			httputils.RespondWith400(w, err.Error(), h.log)
+
+			return
+		}
+
+		filter.UserID = userID
+
+		teachers, nextCursor, err := h.teacherService.GetFavoriteTeachers(r.Context(), filter, r.URL.Query().Get("cursor"))
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorInvalidCursor):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		resp := mappingResponse(teachers)
+		resp.NextCursor = nextCursor
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
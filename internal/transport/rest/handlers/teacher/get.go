@@ -61,6 +61,7 @@ func (h *TeacherHandlers) GetTeacherProtected() http.HandlerFunc {
 		}
 
 		resp := mappingToResponse(teacherAllData)
+		resp.FavoritesCount = &teacherAllData.TeacherData.TeacherStat.CountOfFavorites
 
 		httputils.SuccessRespondWith200(w, resp, h.log)
 	}
@@ -68,7 +69,7 @@ func (h *TeacherHandlers) GetTeacherProtected() http.HandlerFunc {
 
 // GetTeacherPublic returns http.HandlerFunc which handle get teacher, get user id from http param
 // @Summary Get teacher data
-// @Description Get all info about teacher (user info + teacher + his skills) by his TeacherID in route (/api/teachers/{id})
+// @Description Get all info about teacher (user info + teacher + his skills) by his TeacherID in route (/api/teachers/{id}). Token is optional, with token is_favorite is filled for its user
 // @Tags teachers
 // @Produce json
 // @Param id path int true "Teacher's ID"
@@ -76,6 +77,7 @@ func (h *TeacherHandlers) GetTeacherProtected() http.HandlerFunc {
 // @Failure 404 {object} httputils.ErrorStruct
 // @Failure 500 {object} httputils.ErrorStruct
 // @Router /teachers/{id} [get]
+// @Security     BearerAuth
 func (h *TeacherHandlers) GetTeacherPublic() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		teacherID, err := httputils.GetIntParamFromRequestPath(r, "id")
@@ -101,6 +103,17 @@ func (h *TeacherHandlers) GetTeacherPublic() http.HandlerFunc {
 
 		resp := mappingToResponse(teacherAllData)
 
+		// token is optional for this route
+		if userID, ok := r.Context().Value(jwt.UserIDKey).(int); ok && userID != 0 {
+			resp.IsFavorite, err = h.teacherService.IsFavoriteTeacher(r.Context(), userID, teacher.ID)
+			if err != nil {
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+
+				return
+			}
+		}
+
 		httputils.SuccessRespondWith200(w, resp, h.log)
 	}
 }
@@ -129,21 +142,54 @@ func mappingToResponse(user *entities.User) *getTeacherResponse {
 		CountOfStudents:    user.TeacherData.TeacherStat.CountOfStudents,
 		CommonRate:         user.TeacherData.Rate,
 		CommonReviewsCount: user.TeacherData.ReviewsCount,
+		Headline:           user.TeacherData.Headline,
+		Bio:                user.TeacherData.Bio,
+
+		Skills:       make([]respSkill, 0, len(user.TeacherData.Skills)),
+		Languages:    make([]respLanguage, 0, len(user.TeacherData.Languages)),
+		Education:    make([]respEducation, 0, len(user.TeacherData.Education)),
+		Certificates: make([]respCertificate, 0, len(user.TeacherData.Certificates)),
+	}
 
-		Skills: make([]respSkill, 0, len(user.TeacherData.Skills)),
+	for _, language := range user.TeacherData.Languages {
+		resp.Languages = append(resp.Languages, respLanguage{
+			Language: language.Language,
+			Level:    language.Level,
+		})
+	}
+
+	for _, education := range user.TeacherData.Education {
+		resp.Education = append(resp.Education, respEducation{
+			Institution:  education.Institution,
+			Degree:       education.Degree,
+			FieldOfStudy: education.FieldOfStudy,
+			StartYear:    education.StartYear,
+			EndYear:      education.EndYear,
+		})
+	}
+
+	for _, certificate := range user.TeacherData.Certificates {
+		resp.Certificates = append(resp.Certificates, respCertificate{
+			CertificateID: certificate.ID,
+			Title:         certificate.Title,
+			IsVerified:    certificate.IsVerified,
+		})
 	}
 
 	// remap entity respSkill to getTeacherResponse respSkill-type
 	for _, sk := range user.TeacherData.Skills {
 		resp.Skills = append(resp.Skills, respSkill{
-			SkillID:       sk.ID,
-			CategoryID:    sk.CategoryID,
-			CategoryName:  sk.CategoryName,
-			VideoCardLink: sk.VideoCardLink,
-			About:         sk.About,
-			Rate:          sk.Rate,
-			ReviewsCount:  sk.ReviewsCount,
-			IsActive:      sk.IsActive,
+			SkillID:           sk.ID,
+			CategoryID:        sk.CategoryID,
+			CategoryName:      sk.CategoryName,
+			VideoCardLink:     sk.VideoCardLink,
+			VideoCardURL:      videoCardURL(sk),
+			VideoCardDuration: sk.VideoCardDuration,
+			About:             sk.About,
+			Price:             sk.Price,
+			Rate:              sk.Rate,
+			ReviewsCount:      sk.ReviewsCount,
+			IsActive:          sk.IsActive,
 		})
 	}
 
@@ -163,16 +209,48 @@ type getTeacherResponse struct {
 	CountOfStudents    int         `json:"count_of_students"    example:"0"`
 	CommonRate         float32     `json:"common_rate"          example:"0"`
 	CommonReviewsCount int         `json:"common_reviews_count" example:"0"`
+	IsFavorite         bool        `json:"is_favorite"          example:"false"`
+	FavoritesCount     *int        `json:"favorites_count,omitempty" example:"3"` // only for teacher himself
 	Skills             []respSkill `json:"skills"`
+
+	// profile (only in teacher's profile, not in lists)
+	Headline     string            `json:"headline,omitempty"     example:"Math teacher with 10 years of experience"`
+	Bio          string            `json:"bio,omitempty"          example:"long story about me..."`
+	Languages    []respLanguage    `json:"languages,omitempty"`
+	Education    []respEducation   `json:"education,omitempty"`
+	Certificates []respCertificate `json:"certificates,omitempty"`
+}
+
+type respLanguage struct {
+	Language string `json:"language" example:"English"`
+	Level    string `json:"level"    example:"C1"`
+}
+
+type respEducation struct {
+	Institution  string `json:"institution"    example:"MIT"`
+	Degree       string `json:"degree"         example:"Master"`
+	FieldOfStudy string `json:"field_of_study" example:"Applied Mathematics"`
+	StartYear    *int   `json:"start_year"     example:"2010"`
+	EndYear      *int   `json:"end_year"       example:"2015"`
+}
+
+// file of certificate can be got by /api/teachers/certificates/{certificate_id}/file.
+type respCertificate struct {
+	CertificateID int    `json:"certificate_id" example:"1"`
+	Title         string `json:"title"          example:"IELTS 8.0"`
+	IsVerified    bool   `json:"is_verified"    example:"false"`
 }
 
 type respSkill struct {
-	SkillID       int     `json:"skill_id"        example:"1"`
-	CategoryID    int     `json:"category_id"     example:"1"`
-	CategoryName  string  `json:"category_name"   example:"Category"`
-	VideoCardLink string  `json:"video_card_link" example:"https://youtu.be/HIcSWuKMwOw?si=FtxN1QJU9ZWnXy85"`
-	About         string  `json:"about"           example:"about me..."`
-	Rate          float32 `json:"rate"            example:"5"`
-	ReviewsCount  int     `json:"reviews_count"   example:"1"`
-	IsActive      bool    `json:"is_active"       example:"false"`
+	SkillID           int     `json:"skill_id"        example:"1"`
+	CategoryID        int     `json:"category_id"     example:"1"`
+	CategoryName      string  `json:"category_name"   example:"Category"`
+	VideoCardLink     string  `json:"video_card_link" example:"https://youtu.be/HIcSWuKMwOw?si=FtxN1QJU9ZWnXy85"`
+	VideoCardURL      string  `json:"video_card_url"      example:"/api/teachers/skills/1/video"` // empty if video isn't uploaded, teacher's own route if skill isn't approved
+	VideoCardDuration int     `json:"video_card_duration" example:"60"`                           // seconds
+	About             string  `json:"about"           example:"about me..."`
+	Price             int     `json:"price"           example:"500"`
+	Rate              float32 `json:"rate"            example:"5"`
+	ReviewsCount      int     `json:"reviews_count"   example:"1"`
+	IsActive          bool    `json:"is_active"       example:"false"`
 }
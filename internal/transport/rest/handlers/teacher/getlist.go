@@ -1,10 +1,14 @@
 package teacher
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
 	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
 	"go.uber.org/zap"
@@ -16,12 +20,21 @@ const (
 
 // GetTeacherList returns http.HandlerFunc which handle get teachers
 // @Summary Get full teachers data
-// @Description Get full teachers data (their user data, teacher data and skills)
+// @Description Get one page of teachers data (their user data, teacher data and skills matched by filters). Use next_cursor from response as cursor param to get the next page (empty next_cursor means the last page)
 // @Tags teachers
 // @Produce json
 // @Param is_mine query boolean false "Filter my teachers"
-// @Param category query string false "Filter category"
+// @Param category query []string false "Filter categories (can be repeated)" collectionFormat(multi)
+// @Param min_rating query number false "Minimal teacher's rating"
+// @Param min_price query int false "Minimal skill price"
+// @Param max_price query int false "Maximal skill price"
+// @Param available_within query int false "Has an available schedule time within the next N hours"
+// @Param sort query string false "Sorting field (default ranking: Bayesian average of reviews rate, of the best matched skill if categories are chosen; rating is raw average)" Enums(ranking, rating, reviews, lessons, price, newest)
+// @Param order query string false "Sorting order (default: asc for price, desc for others)" Enums(asc, desc)
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor of the page"
 // @Success 200 {object} getTeachersResponse
+// @Failure 400 {object} httputils.ErrorStruct
 // @Failure 401 {object} httputils.ErrorStruct
 // @Failure 500 {object} httputils.ErrorStruct
 // @Router /teachers [get]
@@ -37,34 +50,128 @@ func (h *TeacherHandlers) GetTeacherList() http.HandlerFunc {
 			return
 		}
 
-		// get query as filters
-		isMyTeachers := r.URL.Query().Get("is_mine")
-		isMyTeachersBool, err := strconv.ParseBool(isMyTeachers)
+		filter, err := parseTeacherListFilter(r.URL.Query())
 		if err != nil {
-			isMyTeachersBool = false
-		}
+			httputils.RespondWith400(w, err.Error(), h.log)
 
-		category := r.URL.Query().Get("category")
-		isFilterByCategory := true
-		if category == "" {
-			isFilterByCategory = false
+			return
 		}
 
-		teachers, err := h.teacherService.GetTeacherList(r.Context(), id, isMyTeachersBool, category, isFilterByCategory)
+		filter.UserID = id
+
+		teachers, nextCursor, err := h.teacherService.GetTeacherList(r.Context(), filter, r.URL.Query().Get("cursor"))
 
 		if err != nil {
-			h.log.Error(err.Error())
-			httputils.RespondWith500(w, h.log)
+			switch {
+			case errors.Is(err, serviceErrors.ErrorInvalidCursor):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
 
 			return
 		}
 
 		resp := mappingResponse(teachers)
+		resp.NextCursor = nextCursor
 
 		httputils.SuccessRespondWith200(w, resp, h.log)
 	}
 }
 
+// parseTeacherListFilter maps query params into filter, returns error with message for client.
+func parseTeacherListFilter(query url.Values) (*entities.TeacherListFilter, error) {
+	filter := &entities.TeacherListFilter{}
+
+	// "is_mine" was always optional and ignored if invalid
+	filter.IsMyTeachers, _ = strconv.ParseBool(query.Get("is_mine"))
+
+	for _, category := range query["category"] {
+		if category != "" {
+			filter.Categories = append(filter.Categories, category)
+		}
+	}
+
+	if value := query.Get("min_rating"); value != "" {
+		minRating, err := strconv.ParseFloat(value, 64)
+		if err != nil || minRating < 0 || minRating > 5 {
+			return nil, errors.New("min_rating must be number from 0 to 5")
+		}
+
+		filter.MinRating = minRating
+	}
+
+	var err error
+
+	if filter.MinPrice, err = parseOptionalNonNegativeInt(query, "min_price"); err != nil {
+		return nil, err
+	}
+
+	if filter.MaxPrice, err = parseOptionalNonNegativeInt(query, "max_price"); err != nil {
+		return nil, err
+	}
+
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return nil, errors.New("min_price must be less or equal than max_price")
+	}
+
+	availableWithin, err := parseOptionalNonNegativeInt(query, "available_within")
+	if err != nil {
+		return nil, err
+	}
+
+	if availableWithin != nil {
+		filter.AvailableWithinHours = *availableWithin
+	}
+
+	limit, err := parseOptionalNonNegativeInt(query, "limit")
+	if err != nil {
+		return nil, err
+	}
+
+	if limit != nil {
+		filter.Limit = *limit
+	}
+
+	filter.SortBy = entities.TeacherSortField(query.Get("sort"))
+	switch filter.SortBy {
+	case "":
+		filter.SortBy = entities.SortByRanking
+	case entities.SortByRanking, entities.SortByRating, entities.SortByReviewsCount, entities.SortByFinishedLessons,
+		entities.SortByPrice, entities.SortByNewest:
+	default:
+		return nil, errors.New("unknown sort field")
+	}
+
+	switch query.Get("order") {
+	case "":
+		filter.Desc = filter.SortBy != entities.SortByPrice
+	case "asc":
+		filter.Desc = false
+	case "desc":
+		filter.Desc = true
+	default:
+		return nil, errors.New("order must be asc or desc")
+	}
+
+	return filter, nil
+}
+
+func parseOptionalNonNegativeInt(query url.Values, name string) (*int, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 0 {
+		return nil, fmt.Errorf("%s must be non-negative number", name)
+	}
+
+	return &number, nil
+}
+
 func mappingResponse(users []entities.User) *getTeachersResponse {
 	resp := &getTeachersResponse{
 		Teachers: make([]getTeacherResponse, 0, len(users)),
@@ -75,14 +182,17 @@ func mappingResponse(users []entities.User) *getTeachersResponse {
 		// Transform skills slice in one go
 		for j, sk := range users[i].TeacherData.Skills {
 			skills[j] = respSkill{
-				SkillID:       sk.ID,
-				CategoryID:    sk.CategoryID,
-				CategoryName:  sk.CategoryName,
-				VideoCardLink: sk.VideoCardLink,
-				About:         sk.About,
-				Rate:          sk.Rate,
-				ReviewsCount:  sk.ReviewsCount,
-				IsActive:      sk.IsActive,
+				SkillID:           sk.ID,
+				CategoryID:        sk.CategoryID,
+				CategoryName:      sk.CategoryName,
+				VideoCardLink:     sk.VideoCardLink,
+				VideoCardURL:      videoCardURL(sk),
+				VideoCardDuration: sk.VideoCardDuration,
+				About:             sk.About,
+				Price:             sk.Price,
+				Rate:              sk.Rate,
+				ReviewsCount:      sk.ReviewsCount,
+				IsActive:          sk.IsActive,
 			}
 		}
 
@@ -99,6 +209,7 @@ func mappingResponse(users []entities.User) *getTeachersResponse {
 			CountOfStudents:    users[i].TeacherData.TeacherStat.CountOfStudents,
 			CommonRate:         users[i].TeacherData.Rate,
 			CommonReviewsCount: users[i].TeacherData.ReviewsCount,
+			IsFavorite:         users[i].TeacherData.IsFavorite,
 			Skills:             skills,
 		})
 	}
@@ -107,5 +218,6 @@ func mappingResponse(users []entities.User) *getTeachersResponse {
 }
 
 type getTeachersResponse struct {
-	Teachers []getTeacherResponse `json:"teachers"`
+	Teachers   []getTeacherResponse `json:"teachers"`
+	NextCursor string               `json:"next_cursor" example:"eyJzIjoicmF0aW5nIiwiZCI6dHJ1ZSwidiI6NC41LCJpZCI6MTJ9"`
 }
@@ -2,9 +2,12 @@ package teacher
 
 import (
 	"context"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	"github.com/LearnShareApp/learn-share-backend/pkg/storage/object"
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
 )
@@ -15,10 +18,29 @@ const (
 )
 
 type TeacherService interface {
-	AddSkill(ctx context.Context, userID, categoryID int, videoCardLink string, about string) error
+	AddSkill(ctx context.Context, userID, categoryID int, videoCardLink string, about string, price int) error
 	BecomeTeacher(ctx context.Context, userID int) error
 	GetTeacher(ctx context.Context, teacher *entities.Teacher) (*entities.User, error)
-	GetTeacherList(ctx context.Context, userID int, isMyTeachers bool, category string, isFilteredByCategory bool) ([]entities.User, error)
+	GetTeacherList(ctx context.Context, filter *entities.TeacherListFilter, cursor string) ([]entities.User, string, error)
+	SearchTeachers(ctx context.Context, query string, limit int) ([]entities.TeacherSearchResult, error)
+	RecommendTeachers(ctx context.Context, userID int, limit int) ([]entities.TeacherRecommendation, error)
+
+	AddFavoriteTeacher(ctx context.Context, userID, teacherID int) error
+	RemoveFavoriteTeacher(ctx context.Context, userID, teacherID int) error
+	GetFavoriteTeachers(ctx context.Context, filter *entities.TeacherListFilter, cursor string) ([]entities.User, string, error)
+	IsFavoriteTeacher(ctx context.Context, userID, teacherID int) (bool, error)
+
+	UpdateTeacherProfile(ctx context.Context, userID int, profile *entities.TeacherProfile) error
+	AddTeacherCertificate(ctx context.Context, userID int, title string, fileReader io.Reader, fileSize int64, extension string) (int, error)
+	DeleteTeacherCertificate(ctx context.Context, userID, certificateID int) error
+	GetCertificateFile(ctx context.Context, certificateID int) (*object.File, error)
+	GetOwnCertificateFile(ctx context.Context, userID, certificateID int) (*object.File, error)
+
+	UploadSkillVideoCard(ctx context.Context, userID, skillID int, video *object.File, duration time.Duration) error
+	GetSkillVideoCard(ctx context.Context, skillID int) (*object.File, error)
+	GetOwnSkillVideoCard(ctx context.Context, userID, skillID int) (*object.File, error)
+
+	GetTeacherAnalytics(ctx context.Context, userID int, filter entities.TeacherAnalyticsFilter) (*entities.TeacherAnalytics, error)
 
 	GetTeacherByID(ctx context.Context, id int) (*entities.Teacher, error)
 	GetTeacherByUserID(ctx context.Context, userID int) (*entities.Teacher, error)
@@ -36,16 +58,22 @@ func NewTeacherHandlers(teacherService TeacherService, log *zap.Logger) *Teacher
 	}
 }
 
-func (h *TeacherHandlers) SetupTeacherRoutes(router *chi.Mux, authMiddleware func(http.Handler) http.Handler) {
+func (h *TeacherHandlers) SetupTeacherRoutes(router *chi.Mux, authMiddleware, optionalAuthMiddleware func(http.Handler) http.Handler) {
 	teachersRouter := chi.NewRouter()
 
-	teachersRouter.Get(getTeacherPublicRoute, h.GetTeacherPublic())
+	teachersRouter.Get(searchTeachersRoute, h.SearchTeachers())
+	teachersRouter.Get(getCertificateFileRoute, h.GetCertificateFile())
+	teachersRouter.Get(getVideoCardRoute, h.GetVideoCard())
+	teachersRouter.With(optionalAuthMiddleware).Get(getTeacherPublicRoute, h.GetTeacherPublic())
 
 	teachersRouter.Group(func(r chi.Router) {
 		r.Use(authMiddleware)
 
 		r.Get(getTeacherListRoute, h.GetTeacherList())
-
+		r.Get(recommendTeachersRoute, h.RecommendTeachers())
+		r.Get(getFavoriteTeachersRoute, h.GetFavoriteTeachers())
+		r.Post(favoriteTeacherRoute, h.AddFavoriteTeacher())
+		r.Delete(favoriteTeacherRoute, h.RemoveFavoriteTeacher())
 	})
 	router.Mount(teachersRoute, teachersRouter)
 
@@ -57,6 +85,13 @@ func (h *TeacherHandlers) SetupTeacherRoutes(router *chi.Mux, authMiddleware fun
 		r.Post(addSkillRoute, h.AddSkill())
 		r.Post(becomeRoute, h.BecomeTeacher())
 		r.Get(getTeacherProtectedRoute, h.GetTeacherProtected())
+		r.Put(updateProfileRoute, h.UpdateTeacherProfile())
+		r.Post(addCertificateRoute, h.AddCertificate())
+		r.Delete(deleteCertificateRoute, h.DeleteCertificate())
+		r.Get(getOwnCertificateFileRoute, h.GetOwnCertificateFile())
+		r.Put(uploadVideoCardRoute, h.UploadVideoCard())
+		r.Get(getOwnVideoCardRoute, h.GetOwnVideoCard())
+		r.Get(getAnalyticsRoute, h.GetAnalytics())
 	})
 
 	router.Mount(teacherRoute, teacherRouter)
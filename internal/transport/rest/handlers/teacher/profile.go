@@ -0,0 +1,183 @@
+package teacher
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	updateProfileRoute = "/profile"
+
+	maxHeadlineLength     = 150
+	maxBioLength          = 5000
+	maxLanguagesCount     = 20
+	maxEducationsCount    = 20
+	maxLanguageLength     = 50
+	maxInstitutionLength  = 200
+	maxDegreeLength       = 100
+	maxFieldOfStudyLength = 200
+)
+
+// UpdateTeacherProfile returns http.HandlerFunc which handle update of teacher's profile
+// @Summary Update teacher's profile
+// @Description Replace headline, bio, languages (level is one of A1, A2, B1, B2, C1, C2, native) and education of teacher (user id from token)
+// @Tags teachers
+// @Accept json
+// @Produce json
+// @Param updateProfileRequest body updateProfileRequest true "Profile data"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /teacher/profile [put]
+// @Security     BearerAuth
+func (h *TeacherHandlers) UpdateTeacherProfile() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		var req updateProfileRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		profile, err := req.toEntity()
+		if err != nil {
+			httputils.RespondWith400(w, err.Error(), h.log)
+
+			return
+		}
+
+		if err = h.teacherService.UpdateTeacherProfile(r.Context(), userID, profile); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorUserIsNotTeacher):
+				httputils.RespondWith403(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorInvalidLanguageLevel),
+				errors.Is(err, serviceErrors.ErrorDuplicateLanguage),
+				errors.Is(err, serviceErrors.ErrorContentRejected):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+func (req *updateProfileRequest) toEntity() (*entities.TeacherProfile, error) {
+	profile := &entities.TeacherProfile{
+		Headline:  strings.TrimSpace(req.Headline),
+		Bio:       strings.TrimSpace(req.Bio),
+		Languages: make([]entities.TeacherLanguage, 0, len(req.Languages)),
+		Education: make([]entities.TeacherEducation, 0, len(req.Education)),
+	}
+
+	if utf8.RuneCountInString(profile.Headline) > maxHeadlineLength {
+		return nil, fmt.Errorf("headline must be not longer than %d symbols", maxHeadlineLength)
+	}
+
+	if utf8.RuneCountInString(profile.Bio) > maxBioLength {
+		return nil, fmt.Errorf("bio must be not longer than %d symbols", maxBioLength)
+	}
+
+	if len(req.Languages) > maxLanguagesCount {
+		return nil, fmt.Errorf("too many languages (max %d)", maxLanguagesCount)
+	}
+
+	if len(req.Education) > maxEducationsCount {
+		return nil, fmt.Errorf("too many education entries (max %d)", maxEducationsCount)
+	}
+
+	for _, language := range req.Languages {
+		name := strings.TrimSpace(language.Language)
+		if name == "" || language.Level == "" {
+			return nil, errors.New("language and level are required for every language")
+		}
+
+		if utf8.RuneCountInString(name) > maxLanguageLength {
+			return nil, fmt.Errorf("language must be not longer than %d symbols", maxLanguageLength)
+		}
+
+		profile.Languages = append(profile.Languages, entities.TeacherLanguage{
+			Language: name,
+			Level:    language.Level,
+		})
+	}
+
+	for _, education := range req.Education {
+		institution := strings.TrimSpace(education.Institution)
+		if institution == "" {
+			return nil, errors.New("institution is required for every education entry")
+		}
+
+		degree := strings.TrimSpace(education.Degree)
+		fieldOfStudy := strings.TrimSpace(education.FieldOfStudy)
+
+		switch {
+		case utf8.RuneCountInString(institution) > maxInstitutionLength:
+			return nil, fmt.Errorf("institution must be not longer than %d symbols", maxInstitutionLength)
+		case utf8.RuneCountInString(degree) > maxDegreeLength:
+			return nil, fmt.Errorf("degree must be not longer than %d symbols", maxDegreeLength)
+		case utf8.RuneCountInString(fieldOfStudy) > maxFieldOfStudyLength:
+			return nil, fmt.Errorf("field_of_study must be not longer than %d symbols", maxFieldOfStudyLength)
+		}
+
+		if education.StartYear != nil && education.EndYear != nil && *education.EndYear < *education.StartYear {
+			return nil, errors.New("end_year must be greater or equal than start_year")
+		}
+
+		profile.Education = append(profile.Education, entities.TeacherEducation{
+			Institution:  institution,
+			Degree:       degree,
+			FieldOfStudy: fieldOfStudy,
+			StartYear:    education.StartYear,
+			EndYear:      education.EndYear,
+		})
+	}
+
+	return profile, nil
+}
+
+type updateProfileRequest struct {
+	Headline  string             `json:"headline"  example:"Math teacher with 10 years of experience"`
+	Bio       string             `json:"bio"       example:"long story about me..."`
+	Languages []profileLanguage  `json:"languages"`
+	Education []profileEducation `json:"education"`
+}
+
+type profileLanguage struct {
+	Language string `json:"language" example:"English"`
+	Level    string `json:"level"    example:"C1"`
+}
+
+type profileEducation struct {
+	Institution  string `json:"institution"    example:"MIT"`
+	Degree       string `json:"degree"         example:"Master"`
+	FieldOfStudy string `json:"field_of_study" example:"Applied Mathematics"`
+	StartYear    *int   `json:"start_year"     example:"2010"`
+	EndYear      *int   `json:"end_year"       example:"2015"`
+}
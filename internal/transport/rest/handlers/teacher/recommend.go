@@ -0,0 +1,100 @@
+package teacher
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	recommendTeachersRoute = "/recommendations"
+)
+
+// RecommendTeachers returns http.HandlerFunc which handle personalized teachers recommendations
+// @Summary Recommended teachers
+// @Description Teachers ranked for current student by categories he booked or reviewed, teachers of students with similar lessons, rating and free time in the near future. Teachers with finished lessons are excluded. Reason explains the main signal (e.g. "because you studied Maths")
+// @Tags teachers
+// @Produce json
+// @Param limit query int false "Max count of teachers (default 10, max 50)"
+// @Success 200 {object} recommendTeachersResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /teachers/recommendations [get]
+// @Security     BearerAuth
+func (h *TeacherHandlers) RecommendTeachers() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		limit := 0
+		if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+			var err error
+
+			limit, err = strconv.Atoi(limitParam)
+			if err != nil || limit <= 0 {
+				httputils.RespondWith400(w, "limit must be positive number", h.log)
+
+				return
+			}
+		}
+
+		recommendations, err := h.teacherService.RecommendTeachers(r.Context(), userID, limit)
+		if err != nil {
+			h.log.Error(err.Error())
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		httputils.SuccessRespondWith200(w, mappingRecommendResponse(recommendations), h.log)
+	}
+}
+
+func mappingRecommendResponse(recommendations []entities.TeacherRecommendation) *recommendTeachersResponse {
+	resp := &recommendTeachersResponse{
+		Teachers: make([]respRecommendedTeacher, 0, len(recommendations)),
+	}
+
+	for _, rec := range recommendations {
+		resp.Teachers = append(resp.Teachers, respRecommendedTeacher{
+			TeacherID:          rec.Teacher.TeacherData.ID,
+			UserID:             rec.Teacher.ID,
+			Name:               rec.Teacher.Name,
+			Surname:            rec.Teacher.Surname,
+			Avatar:             rec.Teacher.Avatar,
+			CommonRate:         rec.Teacher.TeacherData.Rate,
+			CommonReviewsCount: rec.Teacher.TeacherData.ReviewsCount,
+			Score:              rec.Score,
+			Reason:             rec.Reason,
+		})
+	}
+
+	return resp
+}
+
+type recommendTeachersResponse struct {
+	Teachers []respRecommendedTeacher `json:"teachers"`
+}
+
+type respRecommendedTeacher struct {
+	TeacherID          int     `json:"teacher_id"           example:"1"`
+	UserID             int     `json:"user_id"              example:"1"`
+	Name               string  `json:"name"                 example:"John"`
+	Surname            string  `json:"surname"              example:"Smith"`
+	Avatar             string  `json:"avatar"               example:"uuid.png"`
+	CommonRate         float32 `json:"common_rate"          example:"4.5"`
+	CommonReviewsCount int     `json:"common_reviews_count" example:"10"`
+	Score              float64 `json:"score"                example:"0.72"`
+	Reason             string  `json:"reason"               example:"because you studied Maths"`
+}
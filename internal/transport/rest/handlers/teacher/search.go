@@ -0,0 +1,100 @@
+package teacher
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+)
+
+const (
+	searchTeachersRoute = "/search"
+)
+
+// SearchTeachers returns http.HandlerFunc which handle full-text search of teachers
+// @Summary Search teachers
+// @Description Full-text search of teachers by name, surname, skills description and categories. Every word is matched as prefix (can be used for autocomplete). Results are ranked, headline contains matched fragments highlighted with <b></b>
+// @Tags teachers
+// @Produce json
+// @Param q query string true "Search query"
+// @Param limit query int false "Max count of results (default 20, max 50)"
+// @Success 200 {object} searchTeachersResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /teachers/search [get]
+func (h *TeacherHandlers) SearchTeachers() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := strings.TrimSpace(r.URL.Query().Get("q"))
+		if query == "" {
+			httputils.RespondWith400(w, "missed q query param (required)", h.log)
+
+			return
+		}
+
+		limit := 0
+		if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+			var err error
+
+			limit, err = strconv.Atoi(limitParam)
+			if err != nil || limit <= 0 {
+				httputils.RespondWith400(w, "limit must be positive number", h.log)
+
+				return
+			}
+		}
+
+		results, err := h.teacherService.SearchTeachers(r.Context(), query, limit)
+		if err != nil {
+			h.log.Error(err.Error())
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		resp := mappingSearchResponse(results)
+
+		httputils.SuccessRespondWith200(w, resp, h.log)
+	}
+}
+
+func mappingSearchResponse(results []entities.TeacherSearchResult) *searchTeachersResponse {
+	resp := &searchTeachersResponse{
+		Teachers: make([]respSearchTeacher, 0, len(results)),
+	}
+
+	for i := range results {
+		resp.Teachers = append(resp.Teachers, respSearchTeacher{
+			TeacherID:          results[i].TeacherData.ID,
+			UserID:             results[i].ID,
+			Name:               results[i].Name,
+			Surname:            results[i].Surname,
+			Avatar:             results[i].Avatar,
+			CommonRate:         results[i].TeacherData.Rate,
+			CommonReviewsCount: results[i].TeacherData.ReviewsCount,
+			Categories:         results[i].Categories,
+			Rank:               results[i].Rank,
+			Headline:           results[i].Headline,
+		})
+	}
+
+	return resp
+}
+
+type searchTeachersResponse struct {
+	Teachers []respSearchTeacher `json:"teachers"`
+}
+
+type respSearchTeacher struct {
+	TeacherID          int      `json:"teacher_id"           example:"1"`
+	UserID             int      `json:"user_id"              example:"1"`
+	Name               string   `json:"name"                 example:"John"`
+	Surname            string   `json:"surname"              example:"Smith"`
+	Avatar             string   `json:"avatar"               example:"uuid.png"`
+	CommonRate         float32  `json:"common_rate"          example:"4.5"`
+	CommonReviewsCount int      `json:"common_reviews_count" example:"10"`
+	Categories         []string `json:"categories"`
+	Rank               float32  `json:"rank"                 example:"0.6"`
+	Headline           string   `json:"headline"             example:"<b>John</b> Smith Programming..."`
+}
@@ -0,0 +1,292 @@
+package teacher
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+	"github.com/LearnShareApp/learn-share-backend/internal/videoutils"
+	"github.com/LearnShareApp/learn-share-backend/pkg/jwt"
+	"github.com/LearnShareApp/learn-share-backend/pkg/storage/object"
+	"go.uber.org/zap"
+)
+
+const (
+	uploadVideoCardRoute = "/skills/{id}/video"
+	getVideoCardRoute    = "/skills/{id}/video"
+	getOwnVideoCardRoute = "/skills/{id}/video"
+
+	videoCardFormField = "video"
+
+	// default server timeouts are too short for big files
+	videoUploadTimeout    = 10 * time.Minute
+	videoStreamingTimeout = 30 * time.Minute
+	multipartMemoryLimit  = 8 << 20 // bigger files are stored in temporary files
+)
+
+// UploadVideoCard returns http.HandlerFunc which handle upload of skill's video card
+// @Summary Upload skill's video card
+// @Description Upload video card file (mp4, mov or webm, max 100MB, from 5 seconds to 3 minutes) of teacher's skill. Skill becomes inactive until admin approves it again
+// @Tags teachers
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Skill ID"
+// @Param video formData file true "Video card file"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 413 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /teacher/skills/{id}/video [put]
+// @Security     BearerAuth
+func (h *TeacherHandlers) UploadVideoCard() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		skillID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed or not-number {id} param in url path", h.log)
+
+			return
+		}
+
+		controller := http.NewResponseController(w)
+		if err = controller.SetReadDeadline(time.Now().Add(videoUploadTimeout)); err != nil {
+			h.log.Warn("failed to extend read deadline", zap.Error(err))
+		}
+
+		// reserve for multipart headers
+		r.Body = http.MaxBytesReader(w, r.Body, videoutils.MaxSize+1<<20)
+
+		if err = r.ParseMultipartForm(multipartMemoryLimit); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				httputils.RespondWith413(w, "video too large", h.log)
+
+				return
+			}
+
+			httputils.RespondWith400(w, "failed to parse multipart form", h.log)
+
+			return
+		}
+
+		defer func() {
+			if err := r.MultipartForm.RemoveAll(); err != nil {
+				h.log.Error("failed to remove multipart temporary files", zap.Error(err))
+			}
+		}()
+
+		file, header, err := r.FormFile(videoCardFormField)
+		if err != nil {
+			httputils.RespondWith400(w, "missed video file in form field \"video\" (required)", h.log)
+
+			return
+		}
+		defer file.Close()
+
+		info, err := videoutils.Inspect(file)
+		if err != nil {
+			if errors.Is(err, videoutils.ErrUnsupportedContainer) || errors.Is(err, videoutils.ErrInvalidVideo) {
+				httputils.RespondWith400(w, err.Error(), h.log)
+
+				return
+			}
+
+			h.log.Error("failed to inspect video", zap.Error(err))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		if err = videoutils.Validate(info, header.Size); err != nil {
+			httputils.RespondWith400(w, err.Error(), h.log)
+
+			return
+		}
+
+		if _, err = file.Seek(0, io.SeekStart); err != nil {
+			h.log.Error("failed to seek video", zap.Error(err))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		video := &object.File{
+			Extension:  string(info.Container),
+			FileReader: file,
+			Size:       header.Size,
+		}
+
+		if err = h.teacherService.UploadSkillVideoCard(r.Context(), userID, skillID, video, info.Duration); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorUserIsNotTeacher),
+				errors.Is(err, serviceErrors.ErrorSkillUnregistered):
+				httputils.RespondWith403(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorSkillNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+// GetVideoCard returns http.HandlerFunc which handle streaming of skill's video card
+// @Summary Get skill's video card
+// @Description Get uploaded video card of active (approved) skill. Supports HTTP range requests for seeking
+// @Tags teachers
+// @Produce video/mp4,video/quicktime,video/webm
+// @Param id path int true "Skill ID"
+// @Param Range header string false "Range of bytes, e.g. bytes=0-1023"
+// @Success 200 {file} binary "Video card"
+// @Success 206 {file} binary "Part of video card"
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 416
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /teachers/skills/{id}/video [get]
+func (h *TeacherHandlers) GetVideoCard() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		skillID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed or not-number {id} param in url path", h.log)
+
+			return
+		}
+
+		file, err := h.teacherService.GetSkillVideoCard(r.Context(), skillID)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorSkillNotFound),
+				errors.Is(err, serviceErrors.ErrorVideoCardNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.serveVideoCard(w, r, file, "public, max-age=3600") // cache for 1 hour
+	}
+}
+
+// GetOwnVideoCard returns http.HandlerFunc which handle streaming of teacher's own skill's video card
+// @Summary Get own skill's video card
+// @Description Get uploaded video card of teacher's (user id from token) skill, also not approved yet. Supports HTTP range requests for seeking
+// @Tags teachers
+// @Produce video/mp4,video/quicktime,video/webm
+// @Param id path int true "Skill ID"
+// @Param Range header string false "Range of bytes, e.g. bytes=0-1023"
+// @Success 200 {file} binary "Video card"
+// @Success 206 {file} binary "Part of video card"
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 404 {object} httputils.ErrorStruct
+// @Failure 416
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /teacher/skills/{id}/video [get]
+// @Security     BearerAuth
+func (h *TeacherHandlers) GetOwnVideoCard() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userIDValue := r.Context().Value(jwt.UserIDKey)
+		userID, ok := userIDValue.(int)
+		if !ok || userID == 0 {
+			h.log.Error("invalid or missing user ID in context", zap.Any("value", userIDValue))
+			httputils.RespondWith500(w, h.log)
+
+			return
+		}
+
+		skillID, err := httputils.GetIntParamFromRequestPath(r, "id")
+		if err != nil {
+			httputils.RespondWith400(w, "missed or not-number {id} param in url path", h.log)
+
+			return
+		}
+
+		file, err := h.teacherService.GetOwnSkillVideoCard(r.Context(), userID, skillID)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorUserIsNotTeacher),
+				errors.Is(err, serviceErrors.ErrorSkillUnregistered):
+				httputils.RespondWith403(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorSkillNotFound),
+				errors.Is(err, serviceErrors.ErrorVideoCardNotFound):
+				httputils.RespondWith404(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		h.serveVideoCard(w, r, file, "private, no-cache")
+	}
+}
+
+// serveVideoCard streams video card file (handles Range, If-Range and HEAD requests) and closes it.
+func (h *TeacherHandlers) serveVideoCard(w http.ResponseWriter, r *http.Request, file *object.File, cacheControl string) {
+	defer func() {
+		if closer, ok := file.FileReader.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				h.log.Error("failed to close reader", zap.Error(err))
+			}
+		}
+	}()
+
+	content, ok := file.FileReader.(io.ReadSeeker)
+	if !ok {
+		h.log.Error("video card reader is not seekable")
+		httputils.RespondWith500(w, h.log)
+
+		return
+	}
+
+	controller := http.NewResponseController(w)
+	if err := controller.SetWriteDeadline(time.Now().Add(videoStreamingTimeout)); err != nil {
+		h.log.Warn("failed to extend write deadline", zap.Error(err))
+	}
+
+	w.Header().Set("Content-Type", videoutils.Container(file.Extension).ContentType())
+	w.Header().Set("Cache-Control", cacheControl)
+
+	http.ServeContent(w, r, file.Name, time.Time{}, content)
+}
+
+// videoCardURL returns path of uploaded video card, empty if video isn't uploaded.
+// Video of not approved skill is available only to its teacher.
+func videoCardURL(skill *entities.Skill) string {
+	if skill.VideoCardFile == "" {
+		return ""
+	}
+
+	if !skill.IsActive {
+		return fmt.Sprintf("/api/teacher/skills/%d/video", skill.ID)
+	}
+
+	return fmt.Sprintf("/api/teachers/skills/%d/video", skill.ID)
+}
@@ -12,7 +12,7 @@ const checkOnAdminRoute = "/is-admin"
 
 // CheckOnAdmin returns http.HandlerFunc
 // @Summary Return boolean value is user an admin
-// @Description Return boolean value is user an admin or not
+// @Description Return boolean value is user an admin or not (has any role), his roles and permissions given by them
 // @Tags users
 // @Produce json
 // @Success 200 {object} BoolResponse
@@ -31,7 +31,7 @@ func (h *UserHandlers) CheckOnAdmin() http.HandlerFunc {
 			return
 		}
 
-		isAdmin, err := h.userService.CheckUserOnAdminByID(r.Context(), userID)
+		access, err := h.userService.GetUserAccess(r.Context(), userID)
 		if err != nil {
 			switch {
 			default:
@@ -42,12 +42,26 @@ func (h *UserHandlers) CheckOnAdmin() http.HandlerFunc {
 			return
 		}
 
-		resp := BoolResponse{IsAdmin: isAdmin}
+		resp := BoolResponse{
+			IsAdmin:     access.IsAdmin(),
+			Roles:       make([]string, 0, len(access.Roles)),
+			Permissions: make([]string, 0, len(access.Permissions)),
+		}
+
+		for _, role := range access.Roles {
+			resp.Roles = append(resp.Roles, string(role))
+		}
+
+		for _, permission := range access.Permissions {
+			resp.Permissions = append(resp.Permissions, string(permission))
+		}
 
 		httputils.SuccessRespondWith200(w, resp, h.log)
 	}
 }
 
 type BoolResponse struct {
-	IsAdmin bool `json:"is_admin"`
+	IsAdmin     bool     `json:"is_admin"`
+	Roles       []string `json:"roles"       example:"support"`
+	Permissions []string `json:"permissions" example:"complaints.view,complaints.manage"`
 }
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+)
+
+const ConfirmEmailRoute = "/email/confirm"
+
+// ConfirmEmail returns http.HandlerFunc
+// @Summary Confirm email
+// @Description Confirm email with token from the letter sent when admin required email verification, then user can log in again
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param confirmEmailRequest body confirmEmailRequest true "Verification token"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /auth/email/confirm [post]
+func (h *UserHandlers) ConfirmEmail() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req confirmEmailRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		if req.Token == "" {
+			httputils.RespondWith400(w, "token is empty", h.log)
+
+			return
+		}
+
+		if err := h.userService.ConfirmEmail(r.Context(), req.Token); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorEmailVerificationTokenInvalid):
+				httputils.RespondWith400(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+type confirmEmailRequest struct {
+	Token string `json:"token" example:"q3Jx0nS8cT1uVw2yZ4bC6dE8fG0hI2jK4lM6nO8pQ0r" binding:"required"`
+}
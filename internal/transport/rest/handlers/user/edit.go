@@ -117,6 +117,8 @@ func (h *UserHandlers) EditUser() http.HandlerFunc {
 			switch {
 			case errors.Is(err, serviceErrors.ErrorUserNotFound):
 				httputils.RespondWith401(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorContentRejected):
+				httputils.RespondWith400(w, err.Error(), h.log)
 			default:
 				h.log.Error(err.Error())
 				httputils.RespondWith500(w, h.log)
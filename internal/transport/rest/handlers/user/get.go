@@ -116,6 +116,8 @@ func mappingToUserResp(user *entities.User) *getUserResponse {
 		WaitingLessons:      user.Stat.CountOfWaitingLesson,
 		CountOfTeachers:     user.Stat.CountOfTeachers,
 		IsTeacher:           user.IsTeacher,
+
+		EmailVerificationRequired: user.EmailVerificationRequired,
 	}
 
 	return &resp
@@ -136,4 +138,6 @@ type getUserResponse struct {
 	WaitingLessons      int       `json:"waiting_lessons"      example:"0"`
 	CountOfTeachers     int       `json:"count_of_teachers"    example:"0"`
 	IsTeacher           bool      `json:"is_teacher"           example:"false"`
+
+	EmailVerificationRequired bool `json:"email_verification_required" example:"false"` // set by admin, user must confirm his email again
 }
@@ -22,24 +22,27 @@ type UserService interface {
 	GetUser(ctx context.Context, id int) (*entities.User, error)
 	EditUser(ctx context.Context, userID int, user *entities.User, avatarReader io.Reader, avatarSize int64) error
 	CheckUser(ctx context.Context, reqUser *entities.User) (int, error)
-	CheckUserOnAdminByID(ctx context.Context, id int) (bool, error)
+	GetUserAccess(ctx context.Context, userID int) (*entities.UserAccess, error)
+	ConfirmEmail(ctx context.Context, token string) error
 }
 
-type JwtService interface {
-	GenerateJWTToken(userID int) (string, error)
+type SessionService interface {
+	StartSession(ctx context.Context, userID int) (*entities.AuthTokens, error)
+	RefreshSession(ctx context.Context, refreshToken string) (*entities.AuthTokens, error)
+	Logout(ctx context.Context, refreshToken string, allSessions bool) error
 }
 
 type UserHandlers struct {
-	userService UserService
-	jwtService  JwtService
-	log         *zap.Logger
+	userService    UserService
+	sessionService SessionService
+	log            *zap.Logger
 }
 
-func NewUserHandlers(userService UserService, jwtService JwtService, log *zap.Logger) *UserHandlers {
+func NewUserHandlers(userService UserService, sessionService SessionService, log *zap.Logger) *UserHandlers {
 	return &UserHandlers{
-		userService: userService,
-		jwtService:  jwtService,
-		log:         log,
+		userService:    userService,
+		sessionService: sessionService,
+		log:            log,
 	}
 }
 
@@ -47,6 +50,9 @@ func (h *UserHandlers) SetupUserRoutes(router *chi.Mux, authMiddleware func(http
 	authRouter := chi.NewRouter()
 	authRouter.Post(RegistrationRoute, h.RegistrationUser())
 	authRouter.Post(LoginRoute, h.LoginUser())
+	authRouter.Post(RefreshRoute, h.RefreshToken())
+	authRouter.Post(LogoutRoute, h.Logout())
+	authRouter.Post(ConfirmEmailRoute, h.ConfirmEmail())
 	router.Mount(authRoute, authRouter)
 
 	router.Get(path.Join(usersRoute, GetPublicRoute), h.GetUserPublic())
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/LearnShareApp/learn-share-backend/internal/entities"
 	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
@@ -14,7 +15,7 @@ const LoginRoute = "/login"
 
 // LoginUser returns http.HandlerFunc
 // @Summary Login user
-// @Description Login with email and password
+// @Description Login with email and password, starts new session. Suspended and banned users and users who have to confirm email (required by admin) are rejected
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -22,6 +23,7 @@ const LoginRoute = "/login"
 // @Success 200 {object} authResponse
 // @Failure 400 {object} httputils.ErrorStruct
 // @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
 // @Failure 500 {object} httputils.ErrorStruct
 // @Router /auth/login [post]
 func (h *UserHandlers) LoginUser() http.HandlerFunc {
@@ -53,6 +55,9 @@ func (h *UserHandlers) LoginUser() http.HandlerFunc {
 				httputils.RespondWith401(w, err.Error(), h.log)
 			case errors.Is(err, serviceErrors.ErrorPasswordIncorrect):
 				httputils.RespondWith401(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorUserSuspended),
+				errors.Is(err, serviceErrors.ErrorEmailVerificationRequired):
+				httputils.RespondWith403(w, err.Error(), h.log)
 			default:
 				h.log.Error(err.Error())
 				httputils.RespondWith500(w, h.log)
@@ -61,7 +66,7 @@ func (h *UserHandlers) LoginUser() http.HandlerFunc {
 			return
 		}
 
-		token, err := h.jwtService.GenerateJWTToken(userID)
+		tokens, err := h.sessionService.StartSession(r.Context(), userID)
 		if err != nil {
 			h.log.Error(err.Error())
 			httputils.RespondWith500(w, h.log)
@@ -69,8 +74,7 @@ func (h *UserHandlers) LoginUser() http.HandlerFunc {
 			return
 		}
 
-		var resp authResponse
-		resp.Token = token
+		resp := newAuthResponse(tokens)
 
 		httputils.SuccessRespondWith200(w, resp, h.log)
 	}
@@ -81,7 +85,19 @@ type loginRequest struct {
 	Password string `json:"password" example:"strongpass123"  binding:"required"`
 }
 
-// @Description User registration authResponse.
+// @Description Tokens of new session authResponse: short-lived access token (Bearer) and one-time refresh token to get new ones.
 type authResponse struct {
-	Token string `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	Token                 string    `json:"token"                    example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	TokenExpiresAt        time.Time `json:"token_expires_at"         example:"2025-01-01T10:15:00Z"`
+	RefreshToken          string    `json:"refresh_token"            example:"q3Jx0nS8cT1uVw2yZ4bC6dE8fG0hI2jK4lM6nO8pQ0r"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at" example:"2025-01-31T10:00:00Z"`
+}
+
+func newAuthResponse(tokens *entities.AuthTokens) authResponse {
+	return authResponse{
+		Token:                 tokens.AccessToken,
+		TokenExpiresAt:        tokens.AccessExpiresAt,
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: tokens.RefreshExpiresAt,
+	}
 }
@@ -104,7 +104,8 @@ func (h *UserHandlers) RegistrationUser() http.HandlerFunc {
 			switch {
 			case errors.Is(err, serviceErrors.ErrorUserExists):
 				httputils.RespondWith409(w, err.Error(), h.log)
-			case errors.Is(err, serviceErrors.ErrorPasswordTooShort):
+			case errors.Is(err, serviceErrors.ErrorPasswordTooShort),
+				errors.Is(err, serviceErrors.ErrorContentRejected):
 				httputils.RespondWith400(w, err.Error(), h.log)
 			default:
 				h.log.Error(err.Error())
@@ -115,7 +116,7 @@ func (h *UserHandlers) RegistrationUser() http.HandlerFunc {
 
 		}
 
-		token, err := h.jwtService.GenerateJWTToken(userID)
+		tokens, err := h.sessionService.StartSession(r.Context(), userID)
 		if err != nil {
 			h.log.Error(err.Error())
 			httputils.RespondWith500(w, h.log)
@@ -123,8 +124,7 @@ func (h *UserHandlers) RegistrationUser() http.HandlerFunc {
 			return
 		}
 
-		var resp authResponse
-		resp.Token = token
+		resp := newAuthResponse(tokens)
 
 		httputils.SuccessRespondWith201(w, resp, h.log)
 	}
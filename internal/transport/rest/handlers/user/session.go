@@ -0,0 +1,119 @@
+package user
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+)
+
+const (
+	RefreshRoute = "/refresh"
+	LogoutRoute  = "/logout"
+)
+
+// RefreshToken returns http.HandlerFunc
+// @Summary Refresh tokens
+// @Description Exchange refresh token for new access and refresh tokens. Refresh token can be used only once: using it again revokes the whole session (token is considered stolen). Suspended and banned users are rejected
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param refreshRequest body refreshRequest true "Refresh token"
+// @Success 200 {object} authResponse
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 403 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /auth/refresh [post]
+func (h *UserHandlers) RefreshToken() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req refreshRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		if req.RefreshToken == "" {
+			httputils.RespondWith400(w, "refresh_token is empty", h.log)
+
+			return
+		}
+
+		tokens, err := h.sessionService.RefreshSession(r.Context(), req.RefreshToken)
+		if err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorRefreshTokenInvalid),
+				errors.Is(err, serviceErrors.ErrorRefreshTokenExpired),
+				errors.Is(err, serviceErrors.ErrorRefreshTokenReused),
+				errors.Is(err, serviceErrors.ErrorSessionRevoked):
+				httputils.RespondWith401(w, err.Error(), h.log)
+			case errors.Is(err, serviceErrors.ErrorUserSuspended):
+				httputils.RespondWith403(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		httputils.SuccessRespondWith200(w, newAuthResponse(tokens), h.log)
+	}
+}
+
+// Logout returns http.HandlerFunc
+// @Summary Logout
+// @Description End session of refresh token: its access and refresh tokens stop working immediately. With all_sessions user is logged out on all devices (e.g. phone is stolen), then refresh token must be valid (current one)
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param logoutRequest body logoutRequest true "Refresh token of session"
+// @Success 200
+// @Failure 400 {object} httputils.ErrorStruct
+// @Failure 401 {object} httputils.ErrorStruct
+// @Failure 500 {object} httputils.ErrorStruct
+// @Router /auth/logout [post]
+func (h *UserHandlers) Logout() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req logoutRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			httputils.RespondWith400(w, "failed to decode body", h.log)
+
+			return
+		}
+
+		if req.RefreshToken == "" {
+			httputils.RespondWith400(w, "refresh_token is empty", h.log)
+
+			return
+		}
+
+		if err := h.sessionService.Logout(r.Context(), req.RefreshToken, req.AllSessions); err != nil {
+			switch {
+			case errors.Is(err, serviceErrors.ErrorRefreshTokenInvalid):
+				httputils.RespondWith401(w, err.Error(), h.log)
+			default:
+				h.log.Error(err.Error())
+				httputils.RespondWith500(w, h.log)
+			}
+
+			return
+		}
+
+		httputils.SuccessRespondWith200(w, struct{}{}, h.log)
+	}
+}
+
+type refreshRequest struct {
+	RefreshToken string `json:"refresh_token" example:"q3Jx0nS8cT1uVw2yZ4bC6dE8fG0hI2jK4lM6nO8pQ0r" binding:"required"`
+}
+
+type logoutRequest struct {
+	RefreshToken string `json:"refresh_token" example:"q3Jx0nS8cT1uVw2yZ4bC6dE8fG0hI2jK4lM6nO8pQ0r" binding:"required"`
+	AllSessions  bool   `json:"all_sessions"  example:"false"`
+}
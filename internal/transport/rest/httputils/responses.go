@@ -107,6 +107,14 @@ func RespondWith409(w http.ResponseWriter, message string, log *zap.Logger) {
 	}
 }
 
+func RespondWith413(w http.ResponseWriter, message string, log *zap.Logger) {
+	if err := RespondWithError(w,
+		http.StatusRequestEntityTooLarge,
+		message); err != nil {
+		log.Error("response error", zap.Error(err))
+	}
+}
+
 func RespondWith500(w http.ResponseWriter, log *zap.Logger) {
 	if err := RespondWithError(w,
 		http.StatusInternalServerError,
@@ -130,3 +138,15 @@ func SuccessRespondWith201(w http.ResponseWriter, payload interface{}, log *zap.
 		log.Error("response error", zap.Error(err))
 	}
 }
+
+func RespondWithFile(w http.ResponseWriter, code int, reader io.Reader, contentType string) error {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "private, max-age=3600") // cache for 1 hour
+	w.WriteHeader(code)
+
+	if _, err := io.Copy(w, reader); err != nil {
+		return fmt.Errorf("failed to write response: %w", err)
+	}
+
+	return nil
+}
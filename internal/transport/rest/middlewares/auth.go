@@ -3,9 +3,13 @@ package middlewares
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -15,12 +19,25 @@ import (
 type TokenValidator interface {
 	ValidateJWTToken(tokenString string) (jwt.MapClaims, error)
 	ExtractUserID(claims jwt.MapClaims) (int, error)
+	ExtractSessionID(claims jwt.MapClaims) (int, error)
 	GetUserKey() string
 	GetExpiredError() error
 }
 
-// JWTMiddleware middleware for JWT token validation
-func JWTMiddleware(validator TokenValidator, log *zap.Logger) func(http.Handler) http.Handler {
+// SuspensionChecker gives current suspension of user (nil if user is not suspended).
+type SuspensionChecker interface {
+	GetActiveSuspension(ctx context.Context, userID int) (*entities.UserSuspension, error)
+}
+
+// SessionChecker tells if session of access token is still active (not logged out or revoked).
+type SessionChecker interface {
+	IsSessionActive(ctx context.Context, sessionID, userID int) (bool, error)
+}
+
+// JWTMiddleware middleware for JWT token validation,
+// tokens of revoked sessions and of suspended (or banned) users are rejected even if they are valid.
+func JWTMiddleware(validator TokenValidator, sessions SessionChecker, suspensions SuspensionChecker,
+	log *zap.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// get authorization header
@@ -66,8 +83,111 @@ func JWTMiddleware(validator TokenValidator, log *zap.Logger) func(http.Handler)
 				return
 			}
 
+			sessionID, err := validator.ExtractSessionID(claims)
+			if err != nil {
+				log.Error("failed to extract session ID", zap.Error(err))
+				httputils.RespondWith401(w, "Invalid token: missing field: sid", log)
+
+				return
+			}
+
+			active, err := sessions.IsSessionActive(r.Context(), sessionID, userID)
+			if err != nil {
+				log.Error("failed to check session", zap.Error(err))
+				httputils.RespondWith500(w, log)
+
+				return
+			}
+
+			if !active {
+				httputils.RespondWith401(w, serviceErrors.ErrorSessionRevoked.Error(), log)
+
+				return
+			}
+
+			suspension, err := suspensions.GetActiveSuspension(r.Context(), userID)
+			if err != nil {
+				log.Error("failed to check user suspension", zap.Error(err))
+				httputils.RespondWith500(w, log)
+
+				return
+			}
+
+			if suspension != nil {
+				httputils.RespondWith403(w, suspensionMessage(suspension), log)
+
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), validator.GetUserKey(), userID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// OptionalJWTMiddleware puts user id into context if request has valid token,
+// requests without token (or with invalid one, of revoked session or of suspended user) are handled as anonymous.
+func OptionalJWTMiddleware(validator TokenValidator, sessions SessionChecker, suspensions SuspensionChecker,
+	log *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			parts := strings.Split(r.Header.Get("Authorization"), " ")
+			if len(parts) != 2 || parts[0] != "Bearer" {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			claims, err := validator.ValidateJWTToken(parts[1])
+			if err != nil {
+				log.Debug("invalid token in optional auth, handle as anonymous", zap.Error(err))
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			userID, err := validator.ExtractUserID(claims)
+			if err != nil {
+				log.Debug("failed to extract user ID in optional auth, handle as anonymous", zap.Error(err))
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			sessionID, err := validator.ExtractSessionID(claims)
+			if err != nil {
+				log.Debug("failed to extract session ID in optional auth, handle as anonymous", zap.Error(err))
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			active, err := sessions.IsSessionActive(r.Context(), sessionID, userID)
+			if err != nil || !active {
+				log.Debug("revoked session or failed check in optional auth, handle as anonymous", zap.Error(err))
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			suspension, err := suspensions.GetActiveSuspension(r.Context(), userID)
+			if err != nil || suspension != nil {
+				log.Debug("suspended user or failed check in optional auth, handle as anonymous", zap.Error(err))
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
 			ctx := context.WithValue(r.Context(), validator.GetUserKey(), userID)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
+
+func suspensionMessage(suspension *entities.UserSuspension) string {
+	if suspension.IsBan() {
+		return fmt.Sprintf("user is banned: %s", suspension.Reason)
+	}
+
+	return fmt.Sprintf("user is suspended until %s: %s", suspension.Until.Format(time.RFC3339), suspension.Reason)
+}
@@ -0,0 +1,19 @@
+package middlewares
+
+import (
+	"net/http"
+
+	textmoderation "github.com/LearnShareApp/learn-share-backend/pkg/moderation"
+)
+
+// LanguageMiddleware puts language of request (from Accept-Language header) into context,
+// user-submitted texts are moderated with word lists of this language.
+func LanguageMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if language := textmoderation.ParseAcceptLanguage(r.Header.Get("Accept-Language")); language != "" {
+			r = r.WithContext(textmoderation.WithLanguage(r.Context(), language))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
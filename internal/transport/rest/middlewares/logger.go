@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-chi/chi/v5/middleware"
 	"go.uber.org/zap"
 )
 
@@ -129,6 +130,7 @@ func LoggerMiddleware(logger *zap.Logger) func(http.Handler) http.Handler {
 			}
 
 			logger.Info("request started",
+				zap.String("request_id", middleware.GetReqID(r.Context())),
 				zap.String("method", r.Method),
 				zap.String("path", r.URL.Path),
 			)
@@ -147,6 +149,7 @@ func LoggerMiddleware(logger *zap.Logger) func(http.Handler) http.Handler {
 
 			// form log with masked data
 			logFields := []zap.Field{
+				zap.String("request_id", middleware.GetReqID(r.Context())),
 				zap.Duration("duration", duration),
 				zap.Int("status", rw.status),
 			}
@@ -0,0 +1,52 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/LearnShareApp/learn-share-backend/internal/entities"
+	serviceErrors "github.com/LearnShareApp/learn-share-backend/internal/errors"
+	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/httputils"
+
+	"go.uber.org/zap"
+)
+
+// AccessChecker gives roles and permissions of user.
+type AccessChecker interface {
+	GetUserAccess(ctx context.Context, userID int) (*entities.UserAccess, error)
+}
+
+// PermissionMiddleware returns constructor of middlewares which let through only users having the permission,
+// they must be used after JWTMiddleware.
+func PermissionMiddleware(validator TokenValidator, access AccessChecker,
+	log *zap.Logger) func(permission entities.Permission) func(http.Handler) http.Handler {
+	return func(permission entities.Permission) func(http.Handler) http.Handler {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				userID, ok := r.Context().Value(validator.GetUserKey()).(int)
+				if !ok || userID == 0 {
+					log.Error("permission middleware is used without auth middleware")
+					httputils.RespondWith500(w, log)
+
+					return
+				}
+
+				userAccess, err := access.GetUserAccess(r.Context(), userID)
+				if err != nil {
+					log.Error("failed to get user access", zap.Error(err))
+					httputils.RespondWith500(w, log)
+
+					return
+				}
+
+				if !userAccess.HasPermission(permission) {
+					httputils.RespondWith403(w, serviceErrors.ErrorPermissionDenied.Error()+": "+string(permission)+" is required", log)
+
+					return
+				}
+
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+}
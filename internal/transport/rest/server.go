@@ -10,6 +10,7 @@ import (
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/handlers"
 	"github.com/LearnShareApp/learn-share-backend/internal/transport/rest/middlewares"
 	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"go.uber.org/zap"
 )
@@ -24,7 +25,10 @@ const (
 type Services interface {
 	handlers.Services
 
-	middlewares.TokenValidator // for auth
+	middlewares.TokenValidator    // for auth
+	middlewares.SessionChecker    // for auth
+	middlewares.SuspensionChecker // for auth
+	middlewares.AccessChecker     // for admin permissions
 }
 
 type Config struct {
@@ -39,11 +43,20 @@ type Server struct {
 func NewServer(services Services, config Config, log *zap.Logger) *Server {
 	router := chi.NewRouter()
 
+	router.Use(middleware.RequestID)
 	router.Use(middlewares.LoggerMiddleware(log.Named("log_middleware")))
 	router.Use(middlewares.CorsMiddleware)
+	router.Use(middlewares.LanguageMiddleware)
 
 	var TokenValidator middlewares.TokenValidator = services
-	authMiddleware := middlewares.JWTMiddleware(TokenValidator, log.Named("jwt_middleware"))
+	var SessionChecker middlewares.SessionChecker = services
+	var SuspensionChecker middlewares.SuspensionChecker = services
+	authMiddleware := middlewares.JWTMiddleware(TokenValidator, SessionChecker, SuspensionChecker, log.Named("jwt_middleware"))
+	optionalAuthMiddleware := middlewares.OptionalJWTMiddleware(TokenValidator, SessionChecker, SuspensionChecker,
+		log.Named("optional_jwt_middleware"))
+
+	var AccessChecker middlewares.AccessChecker = services
+	permissionMiddleware := middlewares.PermissionMiddleware(TokenValidator, AccessChecker, log.Named("permission_middleware"))
 
 	handler := handlers.NewHandlers(services, log)
 
@@ -51,7 +64,7 @@ func NewServer(services Services, config Config, log *zap.Logger) *Server {
 	apiRouter := chi.NewRouter()
 
 	// all routes
-	handler.SetupRoutes(apiRouter, authMiddleware)
+	handler.SetupRoutes(apiRouter, authMiddleware, optionalAuthMiddleware, permissionMiddleware)
 
 	router.Mount(apiRoute, apiRouter)
 
@@ -0,0 +1,112 @@
+package videoutils
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// inspectISOBMFF reads duration from moov/mvhd box of mp4 and mov (QuickTime) files.
+func inspectISOBMFF(r io.ReadSeeker, majorBrand string) (*Info, error) {
+	info := &Info{Container: ContainerMP4}
+	if majorBrand == "qt  " {
+		info.Container = ContainerMOV
+	}
+
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, ErrInvalidVideo
+	}
+
+	moovStart, moovEnd, err := findBox(r, 0, end, "moov")
+	if err != nil {
+		return nil, err
+	}
+
+	mvhdStart, _, err := findBox(r, moovStart, moovEnd, "mvhd")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = r.Seek(mvhdStart, io.SeekStart); err != nil {
+		return nil, ErrInvalidVideo
+	}
+
+	// version (1 byte) + flags (3 bytes)
+	var versionAndFlags [4]byte
+	if _, err = io.ReadFull(r, versionAndFlags[:]); err != nil {
+		return nil, ErrInvalidVideo
+	}
+
+	var timescale, duration uint64
+
+	if versionAndFlags[0] == 1 {
+		var fields [28]byte // creation (8), modification (8), timescale (4), duration (8)
+		if _, err = io.ReadFull(r, fields[:]); err != nil {
+			return nil, ErrInvalidVideo
+		}
+
+		timescale = uint64(binary.BigEndian.Uint32(fields[16:20]))
+		duration = binary.BigEndian.Uint64(fields[20:28])
+	} else {
+		var fields [16]byte // creation (4), modification (4), timescale (4), duration (4)
+		if _, err = io.ReadFull(r, fields[:]); err != nil {
+			return nil, ErrInvalidVideo
+		}
+
+		timescale = uint64(binary.BigEndian.Uint32(fields[8:12]))
+		duration = uint64(binary.BigEndian.Uint32(fields[12:16]))
+	}
+
+	if timescale == 0 {
+		return nil, ErrInvalidVideo
+	}
+
+	info.Duration = time.Duration(float64(duration) / float64(timescale) * float64(time.Second))
+
+	return info, nil
+}
+
+// findBox looks for box with boxType among boxes in [start, end) and returns bounds of its payload.
+func findBox(r io.ReadSeeker, start, end int64, boxType string) (int64, int64, error) {
+	offset := start
+
+	for offset+8 <= end {
+		if _, err := r.Seek(offset, io.SeekStart); err != nil {
+			return 0, 0, ErrInvalidVideo
+		}
+
+		var header [8]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return 0, 0, ErrInvalidVideo
+		}
+
+		size := int64(binary.BigEndian.Uint32(header[:4]))
+		headerSize := int64(8)
+
+		switch size {
+		case 0: // box extends to the end
+			size = end - offset
+		case 1: // 64-bit size follows the type
+			var largeSize [8]byte
+			if _, err := io.ReadFull(r, largeSize[:]); err != nil {
+				return 0, 0, ErrInvalidVideo
+			}
+
+			size = int64(binary.BigEndian.Uint64(largeSize[:]))
+			headerSize = 16
+		}
+
+		if size < headerSize || offset+size > end {
+			return 0, 0, ErrInvalidVideo
+		}
+
+		if string(header[4:8]) == boxType {
+			return offset + headerSize, offset + size, nil
+		}
+
+		offset += size
+	}
+
+	return 0, 0, ErrInvalidVideo
+}
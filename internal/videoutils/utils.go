@@ -0,0 +1,81 @@
+package videoutils
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	MaxSize     = 100 << 20
+	MinDuration = 5 * time.Second
+	MaxDuration = 3 * time.Minute
+)
+
+type Container string
+
+const (
+	ContainerMP4  Container = "mp4"
+	ContainerMOV  Container = "mov"
+	ContainerWebM Container = "webm"
+)
+
+var (
+	ErrUnsupportedContainer = errors.New("video must be mp4, mov or webm")
+	ErrInvalidVideo         = errors.New("invalid or damaged video file")
+)
+
+// Info is metadata of video read from its container.
+type Info struct {
+	Container Container
+	Duration  time.Duration
+}
+
+// ContentType returns MIME-type of container.
+func (c Container) ContentType() string {
+	switch c {
+	case ContainerMP4:
+		return "video/mp4"
+	case ContainerMOV:
+		return "video/quicktime"
+	case ContainerWebM:
+		return "video/webm"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// Inspect detects container of video and reads its duration without decoding of streams.
+func Inspect(r io.ReadSeeker) (*Info, error) {
+	header := make([]byte, 12)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, ErrInvalidVideo
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to seek video: %w", err)
+	}
+
+	switch {
+	case string(header[4:8]) == "ftyp":
+		return inspectISOBMFF(r, string(header[8:12]))
+	case header[0] == 0x1A && header[1] == 0x45 && header[2] == 0xDF && header[3] == 0xA3:
+		return inspectWebM(r)
+	default:
+		return nil, ErrUnsupportedContainer
+	}
+}
+
+// Validate checks size and duration of video.
+func Validate(info *Info, size int64) error {
+	if size > MaxSize {
+		return fmt.Errorf("video too large, max size is %d MB", MaxSize>>20)
+	}
+
+	if info.Duration < MinDuration || info.Duration > MaxDuration {
+		return fmt.Errorf("video duration must be from %s to %s", MinDuration, MaxDuration)
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package videoutils
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"time"
+)
+
+const (
+	ebmlHeaderID    = 0x1A45DFA3
+	ebmlDocTypeID   = 0x4282
+	segmentID       = 0x18538067
+	segmentInfoID   = 0x1549A966
+	timecodeScaleID = 0x2AD7B1
+	durationID      = 0x4489
+	clusterID       = 0x1F43B675
+
+	defaultTimecodeScale = 1_000_000 // nanoseconds
+	unknownSize          = -1
+
+	// sizes of read values are limited before allocation: they are taken from uploaded file
+	maxDocTypeSize = 64
+	maxNumberSize  = 8 // TimecodeScale and Duration
+)
+
+// inspectWebM reads duration from Segment/Info element of webm file.
+func inspectWebM(r io.ReadSeeker) (*Info, error) {
+	id, size, err := readElementHeader(r)
+	if err != nil || id != ebmlHeaderID || size == unknownSize {
+		return nil, ErrInvalidVideo
+	}
+
+	docType, err := readDocType(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	if docType != "webm" {
+		return nil, ErrUnsupportedContainer
+	}
+
+	if id, _, err = readElementHeader(r); err != nil || id != segmentID {
+		return nil, ErrInvalidVideo
+	}
+
+	// look for Info among Segment children (SeekHead, Void, ...), it is always before clusters
+	for {
+		id, size, err = readElementHeader(r)
+		if err != nil || id == clusterID || size == unknownSize {
+			return nil, ErrInvalidVideo
+		}
+
+		if id == segmentInfoID {
+			return readSegmentInfo(r, size)
+		}
+
+		if _, err = r.Seek(size, io.SeekCurrent); err != nil {
+			return nil, ErrInvalidVideo
+		}
+	}
+}
+
+func readDocType(r io.ReadSeeker, headerSize int64) (string, error) {
+	var read int64
+
+	for read < headerSize {
+		id, size, n, err := readElementHeaderN(r)
+		if err != nil || size == unknownSize || size > headerSize-read-n {
+			return "", ErrInvalidVideo
+		}
+
+		read += n + size
+
+		if id != ebmlDocTypeID {
+			if _, err = r.Seek(size, io.SeekCurrent); err != nil {
+				return "", ErrInvalidVideo
+			}
+
+			continue
+		}
+
+		if size > maxDocTypeSize {
+			return "", ErrInvalidVideo
+		}
+
+		value := make([]byte, size)
+		if _, err = io.ReadFull(r, value); err != nil {
+			return "", ErrInvalidVideo
+		}
+
+		if _, err = r.Seek(headerSize-read, io.SeekCurrent); err != nil {
+			return "", ErrInvalidVideo
+		}
+
+		return string(trimZeros(value)), nil
+	}
+
+	return "", ErrInvalidVideo
+}
+
+func readSegmentInfo(r io.ReadSeeker, infoSize int64) (*Info, error) {
+	var (
+		read          int64
+		timecodeScale uint64 = defaultTimecodeScale
+		duration      float64
+		hasDuration   bool
+	)
+
+	for read < infoSize {
+		id, size, n, err := readElementHeaderN(r)
+		if err != nil || size == unknownSize || size > infoSize-read-n ||
+			size > maxNumberSize && (id == timecodeScaleID || id == durationID) {
+			return nil, ErrInvalidVideo
+		}
+
+		read += n + size
+
+		switch id {
+		case timecodeScaleID:
+			value := make([]byte, size)
+			if _, err = io.ReadFull(r, value); err != nil {
+				return nil, ErrInvalidVideo
+			}
+
+			timecodeScale = 0
+			for _, b := range value {
+				timecodeScale = timecodeScale<<8 | uint64(b)
+			}
+		case durationID:
+			value := make([]byte, size)
+			if _, err = io.ReadFull(r, value); err != nil {
+				return nil, ErrInvalidVideo
+			}
+
+			switch size {
+			case 4:
+				duration = float64(math.Float32frombits(binary.BigEndian.Uint32(value)))
+			case 8:
+				duration = math.Float64frombits(binary.BigEndian.Uint64(value))
+			default:
+				return nil, ErrInvalidVideo
+			}
+
+			hasDuration = true
+		default:
+			if _, err = r.Seek(size, io.SeekCurrent); err != nil {
+				return nil, ErrInvalidVideo
+			}
+		}
+	}
+
+	// live streams have no duration, video card must have it
+	if !hasDuration || timecodeScale == 0 {
+		return nil, ErrInvalidVideo
+	}
+
+	return &Info{
+		Container: ContainerWebM,
+		Duration:  time.Duration(duration * float64(timecodeScale)),
+	}, nil
+}
+
+func readElementHeader(r io.Reader) (uint64, int64, error) {
+	id, size, _, err := readElementHeaderN(r)
+
+	return id, size, err
+}
+
+// readElementHeaderN reads EBML element ID and data size, n is count of read bytes.
+func readElementHeaderN(r io.Reader) (id uint64, size int64, n int64, err error) {
+	id, idLen, err := readVint(r, true)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	rawSize, sizeLen, err := readVint(r, false)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	size = int64(rawSize)
+	// all value bits set means unknown size
+	if rawSize == 1<<(7*sizeLen)-1 {
+		size = unknownSize
+	}
+
+	return id, size, int64(idLen + sizeLen), nil
+}
+
+// readVint reads EBML variable size integer, keepMarker is true for element IDs.
+func readVint(r io.Reader, keepMarker bool) (uint64, int, error) {
+	var first [1]byte
+	if _, err := io.ReadFull(r, first[:]); err != nil {
+		return 0, 0, ErrInvalidVideo
+	}
+
+	length := 1
+	for mask := byte(0x80); length <= 8 && first[0]&mask == 0; mask >>= 1 {
+		length++
+	}
+
+	if length > 8 {
+		return 0, 0, ErrInvalidVideo
+	}
+
+	value := uint64(first[0])
+	if !keepMarker {
+		value &= uint64(0xFF >> length)
+	}
+
+	rest := make([]byte, length-1)
+	if _, err := io.ReadFull(r, rest); err != nil {
+		return 0, 0, ErrInvalidVideo
+	}
+
+	for _, b := range rest {
+		value = value<<8 | uint64(b)
+	}
+
+	return value, length, nil
+}
+
+func trimZeros(value []byte) []byte {
+	for len(value) > 0 && value[len(value)-1] == 0 {
+		value = value[:len(value)-1]
+	}
+
+	return value
+}
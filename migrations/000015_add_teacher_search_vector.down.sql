@@ -0,0 +1,16 @@
+DROP TRIGGER IF EXISTS refresh_search_vector_on_category ON public.categories;
+DROP TRIGGER IF EXISTS refresh_search_vector_on_skill ON public.skills;
+DROP TRIGGER IF EXISTS refresh_search_vector_on_user ON public.users;
+DROP TRIGGER IF EXISTS refresh_search_vector_on_teacher ON public.teachers;
+
+DROP FUNCTION IF EXISTS refresh_teacher_search_vector_on_category;
+DROP FUNCTION IF EXISTS refresh_teacher_search_vector_on_skill;
+DROP FUNCTION IF EXISTS refresh_teacher_search_vector_on_user;
+DROP FUNCTION IF EXISTS refresh_teacher_search_vector_on_teacher;
+DROP FUNCTION IF EXISTS refresh_teacher_search_vector;
+
+DROP INDEX IF EXISTS public.teachers_search_vector_idx;
+
+ALTER TABLE public.teachers
+    DROP COLUMN IF EXISTS search_vector,
+    DROP COLUMN IF EXISTS search_document;
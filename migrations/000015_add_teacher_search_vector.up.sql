@@ -0,0 +1,147 @@
+ALTER TABLE public.teachers
+    ADD COLUMN IF NOT EXISTS search_document TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR NOT NULL DEFAULT ''::tsvector;
+
+CREATE INDEX IF NOT EXISTS teachers_search_vector_idx ON public.teachers USING GIN (search_vector);
+
+-- Rebuild search document of one teacher: user name and surname, names of categories
+-- and "about" texts of active skills.
+-- Names are indexed with 'simple' configuration (no stemming, good for autocomplete),
+-- texts are indexed with both 'english' (stemming) and 'simple' (prefix of raw words).
+CREATE OR REPLACE FUNCTION refresh_teacher_search_vector(p_teacher_id INTEGER)
+    RETURNS VOID AS $$
+DECLARE
+    v_names      TEXT;
+    v_categories TEXT;
+    v_about      TEXT;
+BEGIN
+    SELECT concat_ws(' ', u.name, u.surname)
+    INTO v_names
+    FROM teachers t
+    INNER JOIN users u ON u.user_id = t.user_id
+    WHERE t.teacher_id = p_teacher_id;
+
+    SELECT
+        COALESCE(string_agg(c.name, ' '), ''),
+        COALESCE(string_agg(s.about, ' '), '')
+    INTO v_categories, v_about
+    FROM skills s
+    INNER JOIN categories c ON c.category_id = s.category_id
+    WHERE s.teacher_id = p_teacher_id
+      AND s.is_active;
+
+    UPDATE teachers
+    SET
+        search_document = concat_ws(' ', v_names, v_categories, v_about),
+        search_vector =
+            setweight(to_tsvector('simple', COALESCE(v_names, '')), 'A') ||
+            setweight(to_tsvector('simple', v_categories), 'A') ||
+            setweight(to_tsvector('english', v_categories), 'A') ||
+            setweight(to_tsvector('english', v_about), 'B') ||
+            setweight(to_tsvector('simple', v_about), 'C')
+    WHERE teacher_id = p_teacher_id;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION refresh_teacher_search_vector_on_teacher()
+    RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM refresh_teacher_search_vector(NEW.teacher_id);
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION refresh_teacher_search_vector_on_user()
+    RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM refresh_teacher_search_vector(teacher_id)
+    FROM teachers
+    WHERE user_id = NEW.user_id;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION refresh_teacher_search_vector_on_skill()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM refresh_teacher_search_vector(OLD.teacher_id);
+
+        RETURN OLD;
+    END IF;
+
+    PERFORM refresh_teacher_search_vector(NEW.teacher_id);
+
+    IF TG_OP = 'UPDATE' AND OLD.teacher_id <> NEW.teacher_id THEN
+        PERFORM refresh_teacher_search_vector(OLD.teacher_id);
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION refresh_teacher_search_vector_on_category()
+    RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM refresh_teacher_search_vector(teacher_id)
+    FROM (SELECT DISTINCT teacher_id FROM skills WHERE category_id = NEW.category_id) s;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DO $$
+    BEGIN
+        IF NOT EXISTS (
+            SELECT trigger_name
+            FROM information_schema.triggers
+            WHERE event_object_table = 'teachers'
+              AND trigger_name = 'refresh_search_vector_on_teacher'
+        ) THEN
+            CREATE TRIGGER refresh_search_vector_on_teacher
+                AFTER INSERT ON teachers
+                FOR EACH ROW
+            EXECUTE FUNCTION refresh_teacher_search_vector_on_teacher();
+        END IF;
+
+        IF NOT EXISTS (
+            SELECT trigger_name
+            FROM information_schema.triggers
+            WHERE event_object_table = 'users'
+              AND trigger_name = 'refresh_search_vector_on_user'
+        ) THEN
+            CREATE TRIGGER refresh_search_vector_on_user
+                AFTER UPDATE OF name, surname ON users
+                FOR EACH ROW
+            EXECUTE FUNCTION refresh_teacher_search_vector_on_user();
+        END IF;
+
+        IF NOT EXISTS (
+            SELECT trigger_name
+            FROM information_schema.triggers
+            WHERE event_object_table = 'skills'
+              AND trigger_name = 'refresh_search_vector_on_skill'
+        ) THEN
+            CREATE TRIGGER refresh_search_vector_on_skill
+                AFTER INSERT OR DELETE OR UPDATE OF about, is_active, category_id, teacher_id ON skills
+                FOR EACH ROW
+            EXECUTE FUNCTION refresh_teacher_search_vector_on_skill();
+        END IF;
+
+        IF NOT EXISTS (
+            SELECT trigger_name
+            FROM information_schema.triggers
+            WHERE event_object_table = 'categories'
+              AND trigger_name = 'refresh_search_vector_on_category'
+        ) THEN
+            CREATE TRIGGER refresh_search_vector_on_category
+                AFTER UPDATE OF name ON categories
+                FOR EACH ROW
+            EXECUTE FUNCTION refresh_teacher_search_vector_on_category();
+        END IF;
+    END $$;
+
+-- fill search vectors of already existing teachers
+SELECT refresh_teacher_search_vector(teacher_id) FROM teachers;
@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS public.lessons_student_idx;
+DROP INDEX IF EXISTS public.lessons_teacher_idx;
+DROP INDEX IF EXISTS public.schedule_times_available_idx;
+DROP INDEX IF EXISTS public.teachers_finished_lessons_count_idx;
+DROP INDEX IF EXISTS public.teachers_reviews_count_idx;
+DROP INDEX IF EXISTS public.teachers_rate_idx;
+DROP INDEX IF EXISTS public.skills_active_teacher_idx;
+
+DROP TRIGGER IF EXISTS update_teacher_finished_lessons_count ON public.state_machines_items;
+
+DROP FUNCTION IF EXISTS update_teacher_finished_lessons_count;
+DROP FUNCTION IF EXISTS is_lesson_finished_state;
+
+ALTER TABLE public.teachers DROP COLUMN IF EXISTS finished_lessons_count;
+
+ALTER TABLE public.skills DROP COLUMN IF EXISTS price;
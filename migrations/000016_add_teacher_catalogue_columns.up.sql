@@ -0,0 +1,69 @@
+ALTER TABLE public.skills ADD COLUMN IF NOT EXISTS price INTEGER NOT NULL DEFAULT 0 CHECK (price >= 0);
+
+ALTER TABLE public.teachers ADD COLUMN IF NOT EXISTS finished_lessons_count INTEGER NOT NULL DEFAULT 0;
+
+-- lesson is counted as finished when it reaches one of these states
+CREATE OR REPLACE FUNCTION is_lesson_finished_state(p_state_id INTEGER)
+    RETURNS BOOLEAN AS $$
+    SELECT EXISTS (
+        SELECT 1 FROM states
+        WHERE state_id = p_state_id
+          AND name IN ('finished', 'conflicted', 'completed')
+    );
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION update_teacher_finished_lessons_count()
+    RETURNS TRIGGER AS $$
+DECLARE
+    v_was_finished BOOLEAN := is_lesson_finished_state(OLD.state_id);
+    v_is_finished  BOOLEAN := is_lesson_finished_state(NEW.state_id);
+BEGIN
+    IF v_was_finished = v_is_finished THEN
+        RETURN NEW;
+    END IF;
+
+    UPDATE teachers t
+    SET finished_lessons_count = finished_lessons_count + CASE WHEN v_is_finished THEN 1 ELSE -1 END
+    FROM lessons l
+    WHERE l.state_machine_item_id = NEW.item_id
+      AND t.teacher_id = l.teacher_id;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DO $$
+    BEGIN
+        IF NOT EXISTS (
+            SELECT trigger_name
+            FROM information_schema.triggers
+            WHERE event_object_table = 'state_machines_items'
+              AND trigger_name = 'update_teacher_finished_lessons_count'
+        ) THEN
+            CREATE TRIGGER update_teacher_finished_lessons_count
+                AFTER UPDATE OF state_id ON state_machines_items
+                FOR EACH ROW
+            EXECUTE FUNCTION update_teacher_finished_lessons_count();
+        END IF;
+    END $$;
+
+-- fill counters for already existing lessons
+UPDATE teachers t
+SET finished_lessons_count = sub.cnt
+FROM (
+    SELECT l.teacher_id, COUNT(*) AS cnt
+    FROM lessons l
+    INNER JOIN state_machines_items smi ON smi.item_id = l.state_machine_item_id
+    WHERE is_lesson_finished_state(smi.state_id)
+    GROUP BY l.teacher_id
+) sub
+WHERE t.teacher_id = sub.teacher_id;
+
+-- indexes for catalogue sorting and filtering
+CREATE INDEX IF NOT EXISTS skills_active_teacher_idx ON public.skills (teacher_id, price) WHERE is_active;
+CREATE INDEX IF NOT EXISTS teachers_rate_idx ON public.teachers (rate, teacher_id);
+CREATE INDEX IF NOT EXISTS teachers_reviews_count_idx ON public.teachers (reviews_count, teacher_id);
+CREATE INDEX IF NOT EXISTS teachers_finished_lessons_count_idx ON public.teachers (finished_lessons_count, teacher_id);
+CREATE INDEX IF NOT EXISTS schedule_times_available_idx ON public.schedule_times (teacher_id, datetime) WHERE is_available;
+CREATE INDEX IF NOT EXISTS lessons_teacher_idx ON public.lessons (teacher_id);
+CREATE INDEX IF NOT EXISTS lessons_student_idx ON public.lessons (student_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS public.favorite_teachers;
\ No newline at end of file
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS public.favorite_teachers (
+        user_id INTEGER NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+        teacher_id INTEGER NOT NULL REFERENCES teachers(teacher_id) ON DELETE CASCADE,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        PRIMARY KEY (user_id, teacher_id)
+);
+
+CREATE INDEX IF NOT EXISTS favorite_teachers_teacher_idx ON public.favorite_teachers (teacher_id);
@@ -0,0 +1,55 @@
+DROP TRIGGER IF EXISTS refresh_search_vector_on_teacher_certificate ON public.teacher_certificates;
+DROP TRIGGER IF EXISTS refresh_search_vector_on_teacher_education ON public.teacher_educations;
+DROP TRIGGER IF EXISTS refresh_search_vector_on_teacher_language ON public.teacher_languages;
+DROP TRIGGER IF EXISTS refresh_search_vector_on_teacher_profile ON public.teachers;
+
+DROP FUNCTION IF EXISTS refresh_teacher_search_vector_on_profile_item;
+
+DROP TABLE IF EXISTS public.teacher_certificates;
+DROP TABLE IF EXISTS public.teacher_educations;
+DROP TABLE IF EXISTS public.teacher_languages;
+
+ALTER TABLE public.teachers
+    DROP COLUMN IF EXISTS bio,
+    DROP COLUMN IF EXISTS headline;
+
+-- Rebuild search document of one teacher: user name and surname, names of categories
+-- and "about" texts of active skills.
+-- Names are indexed with 'simple' configuration (no stemming, good for autocomplete),
+-- texts are indexed with both 'english' (stemming) and 'simple' (prefix of raw words).
+CREATE OR REPLACE FUNCTION refresh_teacher_search_vector(p_teacher_id INTEGER)
+    RETURNS VOID AS $$
+DECLARE
+    v_names      TEXT;
+    v_categories TEXT;
+    v_about      TEXT;
+BEGIN
+    SELECT concat_ws(' ', u.name, u.surname)
+    INTO v_names
+    FROM teachers t
+    INNER JOIN users u ON u.user_id = t.user_id
+    WHERE t.teacher_id = p_teacher_id;
+
+    SELECT
+        COALESCE(string_agg(c.name, ' '), ''),
+        COALESCE(string_agg(s.about, ' '), '')
+    INTO v_categories, v_about
+    FROM skills s
+    INNER JOIN categories c ON c.category_id = s.category_id
+    WHERE s.teacher_id = p_teacher_id
+      AND s.is_active;
+
+    UPDATE teachers
+    SET
+        search_document = concat_ws(' ', v_names, v_categories, v_about),
+        search_vector =
+            setweight(to_tsvector('simple', COALESCE(v_names, '')), 'A') ||
+            setweight(to_tsvector('simple', v_categories), 'A') ||
+            setweight(to_tsvector('english', v_categories), 'A') ||
+            setweight(to_tsvector('english', v_about), 'B') ||
+            setweight(to_tsvector('simple', v_about), 'C')
+    WHERE teacher_id = p_teacher_id;
+END;
+$$ LANGUAGE plpgsql;
+
+SELECT refresh_teacher_search_vector(teacher_id) FROM teachers;
@@ -0,0 +1,174 @@
+ALTER TABLE public.teachers
+    ADD COLUMN IF NOT EXISTS headline VARCHAR(150) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS bio TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS public.teacher_languages (
+        teacher_language_id SERIAL PRIMARY KEY,
+        teacher_id INTEGER NOT NULL REFERENCES teachers(teacher_id) ON DELETE CASCADE,
+        language VARCHAR(50) NOT NULL,
+        level VARCHAR(10) NOT NULL CHECK (level IN ('A1', 'A2', 'B1', 'B2', 'C1', 'C2', 'native')),
+        CONSTRAINT unique_teacher_language UNIQUE (teacher_id, language)
+);
+
+CREATE TABLE IF NOT EXISTS public.teacher_educations (
+        education_id SERIAL PRIMARY KEY,
+        teacher_id INTEGER NOT NULL REFERENCES teachers(teacher_id) ON DELETE CASCADE,
+        institution VARCHAR(200) NOT NULL,
+        degree VARCHAR(100) NOT NULL DEFAULT '',
+        field_of_study VARCHAR(200) NOT NULL DEFAULT '',
+        start_year INTEGER,
+        end_year INTEGER,
+        CHECK (end_year IS NULL OR start_year IS NULL OR end_year >= start_year)
+);
+
+CREATE INDEX IF NOT EXISTS teacher_educations_teacher_idx ON public.teacher_educations (teacher_id);
+
+CREATE TABLE IF NOT EXISTS public.teacher_certificates (
+        certificate_id SERIAL PRIMARY KEY,
+        teacher_id INTEGER NOT NULL REFERENCES teachers(teacher_id) ON DELETE CASCADE,
+        title VARCHAR(200) NOT NULL,
+        file_name VARCHAR(100) NOT NULL,
+        is_verified BOOLEAN NOT NULL DEFAULT FALSE,
+        verified_at TIMESTAMPTZ,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS teacher_certificates_teacher_idx ON public.teacher_certificates (teacher_id);
+
+-- Rebuild search document of one teacher: user name and surname, headline, names of categories,
+-- "about" texts of active skills, bio, languages, education and certificates titles.
+-- Names are indexed with 'simple' configuration (no stemming, good for autocomplete),
+-- texts are indexed with both 'english' (stemming) and 'simple' (prefix of raw words).
+CREATE OR REPLACE FUNCTION refresh_teacher_search_vector(p_teacher_id INTEGER)
+    RETURNS VOID AS $$
+DECLARE
+    v_names        TEXT;
+    v_headline     TEXT;
+    v_bio          TEXT;
+    v_categories   TEXT;
+    v_about        TEXT;
+    v_languages    TEXT;
+    v_education    TEXT;
+    v_certificates TEXT;
+BEGIN
+    SELECT concat_ws(' ', u.name, u.surname), t.headline, t.bio
+    INTO v_names, v_headline, v_bio
+    FROM teachers t
+    INNER JOIN users u ON u.user_id = t.user_id
+    WHERE t.teacher_id = p_teacher_id;
+
+    SELECT
+        COALESCE(string_agg(c.name, ' '), ''),
+        COALESCE(string_agg(s.about, ' '), '')
+    INTO v_categories, v_about
+    FROM skills s
+    INNER JOIN categories c ON c.category_id = s.category_id
+    WHERE s.teacher_id = p_teacher_id
+      AND s.is_active;
+
+    SELECT COALESCE(string_agg(language, ' '), '')
+    INTO v_languages
+    FROM teacher_languages
+    WHERE teacher_id = p_teacher_id;
+
+    SELECT COALESCE(string_agg(concat_ws(' ', institution, degree, field_of_study), ' '), '')
+    INTO v_education
+    FROM teacher_educations
+    WHERE teacher_id = p_teacher_id;
+
+    SELECT COALESCE(string_agg(title, ' '), '')
+    INTO v_certificates
+    FROM teacher_certificates
+    WHERE teacher_id = p_teacher_id;
+
+    v_headline := COALESCE(v_headline, '');
+    v_bio := concat_ws(' ', v_bio, v_education, v_certificates);
+
+    UPDATE teachers
+    SET
+        search_document = concat_ws(' ', v_names, v_headline, v_categories, v_languages, v_about, v_bio),
+        search_vector =
+            setweight(to_tsvector('simple', COALESCE(v_names, '')), 'A') ||
+            setweight(to_tsvector('simple', v_categories), 'A') ||
+            setweight(to_tsvector('english', v_categories), 'A') ||
+            setweight(to_tsvector('english', v_headline), 'B') ||
+            setweight(to_tsvector('simple', v_languages), 'B') ||
+            setweight(to_tsvector('english', v_about), 'B') ||
+            setweight(to_tsvector('simple', v_headline), 'C') ||
+            setweight(to_tsvector('simple', v_about), 'C') ||
+            setweight(to_tsvector('english', v_bio), 'C') ||
+            setweight(to_tsvector('simple', v_bio), 'D')
+    WHERE teacher_id = p_teacher_id;
+END;
+$$ LANGUAGE plpgsql;
+
+-- for teacher's profile tables (languages, education, certificates)
+CREATE OR REPLACE FUNCTION refresh_teacher_search_vector_on_profile_item()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM refresh_teacher_search_vector(OLD.teacher_id);
+
+        RETURN OLD;
+    END IF;
+
+    PERFORM refresh_teacher_search_vector(NEW.teacher_id);
+
+    IF TG_OP = 'UPDATE' AND OLD.teacher_id <> NEW.teacher_id THEN
+        PERFORM refresh_teacher_search_vector(OLD.teacher_id);
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DO $$
+    BEGIN
+        IF NOT EXISTS (
+            SELECT trigger_name
+            FROM information_schema.triggers
+            WHERE event_object_table = 'teachers'
+              AND trigger_name = 'refresh_search_vector_on_teacher_profile'
+        ) THEN
+            CREATE TRIGGER refresh_search_vector_on_teacher_profile
+                AFTER UPDATE OF headline, bio ON teachers
+                FOR EACH ROW
+            EXECUTE FUNCTION refresh_teacher_search_vector_on_teacher();
+        END IF;
+
+        IF NOT EXISTS (
+            SELECT trigger_name
+            FROM information_schema.triggers
+            WHERE event_object_table = 'teacher_languages'
+              AND trigger_name = 'refresh_search_vector_on_teacher_language'
+        ) THEN
+            CREATE TRIGGER refresh_search_vector_on_teacher_language
+                AFTER INSERT OR DELETE OR UPDATE ON teacher_languages
+                FOR EACH ROW
+            EXECUTE FUNCTION refresh_teacher_search_vector_on_profile_item();
+        END IF;
+
+        IF NOT EXISTS (
+            SELECT trigger_name
+            FROM information_schema.triggers
+            WHERE event_object_table = 'teacher_educations'
+              AND trigger_name = 'refresh_search_vector_on_teacher_education'
+        ) THEN
+            CREATE TRIGGER refresh_search_vector_on_teacher_education
+                AFTER INSERT OR DELETE OR UPDATE ON teacher_educations
+                FOR EACH ROW
+            EXECUTE FUNCTION refresh_teacher_search_vector_on_profile_item();
+        END IF;
+
+        IF NOT EXISTS (
+            SELECT trigger_name
+            FROM information_schema.triggers
+            WHERE event_object_table = 'teacher_certificates'
+              AND trigger_name = 'refresh_search_vector_on_teacher_certificate'
+        ) THEN
+            CREATE TRIGGER refresh_search_vector_on_teacher_certificate
+                AFTER INSERT OR DELETE OR UPDATE OF title, teacher_id ON teacher_certificates
+                FOR EACH ROW
+            EXECUTE FUNCTION refresh_teacher_search_vector_on_profile_item();
+        END IF;
+    END $$;
@@ -0,0 +1,3 @@
+ALTER TABLE public.skills
+    DROP COLUMN IF EXISTS video_card_duration,
+    DROP COLUMN IF EXISTS video_card_file;
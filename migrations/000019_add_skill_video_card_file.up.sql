@@ -0,0 +1,3 @@
+ALTER TABLE public.skills
+    ADD COLUMN IF NOT EXISTS video_card_file VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS video_card_duration INTEGER NOT NULL DEFAULT 0 CHECK (video_card_duration >= 0);
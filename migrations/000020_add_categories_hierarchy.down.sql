@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS public.categories_parent_idx;
+
+ALTER TABLE public.categories DROP CONSTRAINT IF EXISTS categories_parent_not_self;
+
+ALTER TABLE public.categories
+    DROP COLUMN IF EXISTS position,
+    DROP COLUMN IF EXISTS is_archived,
+    DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,18 @@
+ALTER TABLE public.categories
+    ADD COLUMN IF NOT EXISTS parent_id INTEGER REFERENCES categories(category_id) ON DELETE RESTRICT,
+    ADD COLUMN IF NOT EXISTS is_archived BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS position INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE public.categories
+    ADD CONSTRAINT categories_parent_not_self CHECK (parent_id IS NULL OR parent_id <> category_id);
+
+CREATE INDEX IF NOT EXISTS categories_parent_idx ON public.categories (parent_id);
+
+UPDATE public.categories SET position = category_id;
+
+-- categories were seeded with explicit ids, so sequence must be moved forward for new categories
+SELECT setval(
+    pg_get_serial_sequence('public.categories', 'category_id'),
+    COALESCE((SELECT MAX(category_id) FROM public.categories), 0) + 1,
+    false
+);
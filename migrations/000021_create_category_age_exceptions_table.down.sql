@@ -0,0 +1 @@
+DROP TABLE IF EXISTS public.category_age_exceptions;
\ No newline at end of file
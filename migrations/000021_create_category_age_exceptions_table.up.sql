@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS public.category_age_exceptions (
+        user_id INTEGER NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+        category_id INTEGER NOT NULL REFERENCES categories(category_id) ON DELETE CASCADE,
+        granted_by INTEGER REFERENCES users(user_id) ON DELETE SET NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        PRIMARY KEY (user_id, category_id)
+);
+
+CREATE INDEX IF NOT EXISTS category_age_exceptions_category_idx ON public.category_age_exceptions (category_id);
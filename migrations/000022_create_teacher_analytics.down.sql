@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS public.teacher_daily_stats;
+
+DROP TRIGGER IF EXISTS log_state_machine_item_transition ON public.state_machines_items;
+DROP FUNCTION IF EXISTS log_state_machine_item_transition();
+DROP TABLE IF EXISTS public.state_transitions_log;
+
+DROP INDEX IF EXISTS public.schedule_times_datetime_idx;
+DROP INDEX IF EXISTS public.reviews_teacher_created_idx;
+ALTER TABLE public.reviews DROP COLUMN IF EXISTS created_at;
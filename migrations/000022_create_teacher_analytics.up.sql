@@ -0,0 +1,67 @@
+ALTER TABLE public.reviews ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS reviews_teacher_created_idx ON public.reviews (teacher_id, created_at);
+CREATE INDEX IF NOT EXISTS schedule_times_datetime_idx ON public.schedule_times (datetime);
+
+-- lesson's price wasn't filled on booking, take current price of the teacher's skill for old lessons
+UPDATE public.lessons l
+SET price = s.price
+FROM public.skills s
+WHERE l.price = 0 AND s.teacher_id = l.teacher_id AND s.category_id = l.category_id;
+
+-- Log of all state changes of state machine items
+CREATE TABLE IF NOT EXISTS public.state_transitions_log (
+        log_id BIGSERIAL PRIMARY KEY,
+        item_id INTEGER NOT NULL REFERENCES state_machines_items(item_id) ON DELETE CASCADE,
+        from_state_id INTEGER REFERENCES states(state_id), -- NULL for item creation
+        to_state_id INTEGER NOT NULL REFERENCES states(state_id),
+        changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS state_transitions_log_item_idx ON public.state_transitions_log (item_id);
+CREATE INDEX IF NOT EXISTS state_transitions_log_changed_at_idx ON public.state_transitions_log (changed_at);
+
+-- existing items are logged only with their current state
+INSERT INTO public.state_transitions_log (item_id, from_state_id, to_state_id, changed_at)
+SELECT item_id, NULL, state_id, COALESCE(created_at, NOW())
+FROM public.state_machines_items;
+
+CREATE OR REPLACE FUNCTION log_state_machine_item_transition()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO state_transitions_log (item_id, from_state_id, to_state_id)
+        VALUES (NEW.item_id, NULL, NEW.state_id);
+    ELSIF NEW.state_id IS DISTINCT FROM OLD.state_id THEN
+        INSERT INTO state_transitions_log (item_id, from_state_id, to_state_id)
+        VALUES (NEW.item_id, OLD.state_id, NEW.state_id);
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS log_state_machine_item_transition ON public.state_machines_items;
+
+CREATE TRIGGER log_state_machine_item_transition
+    AFTER INSERT OR UPDATE OF state_id ON public.state_machines_items
+    FOR EACH ROW
+EXECUTE FUNCTION log_state_machine_item_transition();
+
+-- Rollup of teacher's lessons and reviews by day (UTC) and category,
+-- lessons are counted by day of their schedule time, reviews by day of creation.
+-- Maintained by the analytics background job.
+CREATE TABLE IF NOT EXISTS public.teacher_daily_stats (
+        teacher_id INTEGER NOT NULL REFERENCES teachers(teacher_id) ON DELETE CASCADE,
+        category_id INTEGER NOT NULL REFERENCES categories(category_id) ON DELETE CASCADE,
+        day DATE NOT NULL,
+        lessons_count INTEGER NOT NULL DEFAULT 0,
+        finished_count INTEGER NOT NULL DEFAULT 0,
+        cancelled_count INTEGER NOT NULL DEFAULT 0,
+        rejected_count INTEGER NOT NULL DEFAULT 0,
+        no_show_count INTEGER NOT NULL DEFAULT 0,
+        earnings BIGINT NOT NULL DEFAULT 0,
+        reviews_count INTEGER NOT NULL DEFAULT 0,
+        rate_sum INTEGER NOT NULL DEFAULT 0,
+        PRIMARY KEY (teacher_id, day, category_id)
+);
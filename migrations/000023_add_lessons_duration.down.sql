@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS set_lesson_duration_on_finish ON public.state_transitions_log;
+DROP FUNCTION IF EXISTS set_lesson_duration_on_finish();
+DROP FUNCTION IF EXISTS lesson_duration_minutes(TIMESTAMPTZ, TIMESTAMPTZ);
+
+ALTER TABLE public.lessons DROP COLUMN IF EXISTS duration_minutes;
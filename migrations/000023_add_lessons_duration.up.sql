@@ -0,0 +1,57 @@
+-- actual duration of the lesson (from start to finish), NULL if lesson wasn't finished or wasn't logged
+ALTER TABLE public.lessons ADD COLUMN IF NOT EXISTS duration_minutes INTEGER CHECK (duration_minutes > 0);
+
+-- forgotten to finish lessons mustn't last for days
+CREATE OR REPLACE FUNCTION lesson_duration_minutes(started_at TIMESTAMPTZ, finished_at TIMESTAMPTZ)
+    RETURNS INTEGER AS $$
+BEGIN
+    RETURN LEAST(240, GREATEST(1, CEIL(EXTRACT(EPOCH FROM (finished_at - started_at)) / 60)))::INTEGER;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+UPDATE public.lessons l
+SET duration_minutes = lesson_duration_minutes(t.started_at, t.finished_at)
+FROM (
+    SELECT
+        tl.item_id,
+        MAX(tl.changed_at) FILTER (WHERE s.name = 'ongoing') AS started_at,
+        MAX(tl.changed_at) FILTER (WHERE s.name = 'finished') AS finished_at
+    FROM public.state_transitions_log tl
+    INNER JOIN public.states s ON s.state_id = tl.to_state_id
+    GROUP BY tl.item_id
+) t
+WHERE t.item_id = l.state_machine_item_id
+  AND t.started_at IS NOT NULL
+  AND t.finished_at > t.started_at;
+
+CREATE OR REPLACE FUNCTION set_lesson_duration_on_finish()
+    RETURNS TRIGGER AS $$
+DECLARE
+    v_started_at TIMESTAMPTZ;
+BEGIN
+    IF NEW.to_state_id <> (SELECT state_id FROM states WHERE name = 'finished') THEN
+        RETURN NEW;
+    END IF;
+
+    SELECT MAX(tl.changed_at)
+    INTO v_started_at
+    FROM state_transitions_log tl
+    INNER JOIN states s ON s.state_id = tl.to_state_id
+    WHERE tl.item_id = NEW.item_id AND s.name = 'ongoing';
+
+    IF v_started_at IS NOT NULL THEN
+        UPDATE lessons
+        SET duration_minutes = lesson_duration_minutes(v_started_at, NEW.changed_at)
+        WHERE state_machine_item_id = NEW.item_id;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS set_lesson_duration_on_finish ON public.state_transitions_log;
+
+CREATE TRIGGER set_lesson_duration_on_finish
+    AFTER INSERT ON public.state_transitions_log
+    FOR EACH ROW
+EXECUTE FUNCTION set_lesson_duration_on_finish();
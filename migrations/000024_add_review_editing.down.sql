@@ -0,0 +1,33 @@
+CREATE OR REPLACE FUNCTION update_skill_and_teacher_on_review()
+    RETURNS TRIGGER AS $$
+BEGIN
+    -- Update skills table
+    UPDATE skills
+    SET
+        reviews_count = reviews_count + 1,
+        total_rate_score = total_rate_score + NEW.rate,
+        rate = (total_rate_score + NEW.rate)::decimal / (reviews_count + 1)
+    WHERE skill_id = NEW.skill_id;
+
+    -- Update teachers table
+    UPDATE teachers
+    SET
+        reviews_count = reviews_count + 1,
+        total_rate_score = total_rate_score + NEW.rate,
+        rate = (total_rate_score + NEW.rate)::decimal / (reviews_count + 1)
+    WHERE teacher_id = NEW.teacher_id;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS update_skill_and_teacher_on_review ON public.reviews;
+
+CREATE TRIGGER update_skill_and_teacher_on_review
+    AFTER INSERT ON public.reviews
+    FOR EACH ROW
+EXECUTE FUNCTION update_skill_and_teacher_on_review();
+
+DROP FUNCTION IF EXISTS apply_review_rate_delta(INTEGER, INTEGER, INTEGER, INTEGER);
+
+ALTER TABLE public.reviews DROP COLUMN IF EXISTS updated_at;
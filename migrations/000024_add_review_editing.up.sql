@@ -0,0 +1,64 @@
+ALTER TABLE public.reviews ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ;
+
+-- Apply change of reviews to skill and teacher rating aggregates
+CREATE OR REPLACE FUNCTION apply_review_rate_delta(
+    p_teacher_id INTEGER,
+    p_skill_id INTEGER,
+    p_count_delta INTEGER,
+    p_score_delta INTEGER
+)
+    RETURNS VOID AS $$
+BEGIN
+    UPDATE skills
+    SET
+        reviews_count = reviews_count + p_count_delta,
+        total_rate_score = total_rate_score + p_score_delta,
+        rate = CASE
+            WHEN reviews_count + p_count_delta > 0
+                THEN (total_rate_score + p_score_delta)::decimal / (reviews_count + p_count_delta)
+            ELSE 0
+        END
+    WHERE skill_id = p_skill_id;
+
+    UPDATE teachers
+    SET
+        reviews_count = reviews_count + p_count_delta,
+        total_rate_score = total_rate_score + p_score_delta,
+        rate = CASE
+            WHEN reviews_count + p_count_delta > 0
+                THEN (total_rate_score + p_score_delta)::decimal / (reviews_count + p_count_delta)
+            ELSE 0
+        END
+    WHERE teacher_id = p_teacher_id;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION update_skill_and_teacher_on_review()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM apply_review_rate_delta(NEW.teacher_id, NEW.skill_id, 1, NEW.rate);
+
+        RETURN NEW;
+    END IF;
+
+    IF TG_OP = 'DELETE' THEN
+        PERFORM apply_review_rate_delta(OLD.teacher_id, OLD.skill_id, -1, -OLD.rate);
+
+        RETURN OLD;
+    END IF;
+
+    -- UPDATE: remove old review and add new one
+    PERFORM apply_review_rate_delta(OLD.teacher_id, OLD.skill_id, -1, -OLD.rate);
+    PERFORM apply_review_rate_delta(NEW.teacher_id, NEW.skill_id, 1, NEW.rate);
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS update_skill_and_teacher_on_review ON public.reviews;
+
+CREATE TRIGGER update_skill_and_teacher_on_review
+    AFTER INSERT OR DELETE OR UPDATE OF rate, skill_id, teacher_id ON public.reviews
+    FOR EACH ROW
+EXECUTE FUNCTION update_skill_and_teacher_on_review();
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS public.notifications;
+DROP TABLE IF EXISTS public.review_replies;
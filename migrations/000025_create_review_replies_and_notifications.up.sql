@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS public.review_replies (
+        review_id INTEGER PRIMARY KEY REFERENCES reviews(review_id) ON DELETE CASCADE,
+        teacher_id INTEGER NOT NULL REFERENCES teachers(teacher_id) ON DELETE CASCADE,
+        comment TEXT NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        updated_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS public.notifications (
+        notification_id SERIAL PRIMARY KEY,
+        user_id INTEGER NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+        type VARCHAR(50) NOT NULL,
+        entity_id INTEGER,
+        is_read BOOLEAN NOT NULL DEFAULT FALSE,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS notifications_user_created_idx ON public.notifications (user_id, created_at DESC);
@@ -0,0 +1,43 @@
+CREATE OR REPLACE FUNCTION update_skill_and_teacher_on_review()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM apply_review_rate_delta(NEW.teacher_id, NEW.skill_id, 1, NEW.rate);
+
+        RETURN NEW;
+    END IF;
+
+    IF TG_OP = 'DELETE' THEN
+        PERFORM apply_review_rate_delta(OLD.teacher_id, OLD.skill_id, -1, -OLD.rate);
+
+        RETURN OLD;
+    END IF;
+
+    -- UPDATE: remove old review and add new one
+    PERFORM apply_review_rate_delta(OLD.teacher_id, OLD.skill_id, -1, -OLD.rate);
+    PERFORM apply_review_rate_delta(NEW.teacher_id, NEW.skill_id, 1, NEW.rate);
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS update_skill_and_teacher_on_review ON public.reviews;
+
+CREATE TRIGGER update_skill_and_teacher_on_review
+    AFTER INSERT OR DELETE OR UPDATE OF rate, skill_id, teacher_id ON public.reviews
+    FOR EACH ROW
+EXECUTE FUNCTION update_skill_and_teacher_on_review();
+
+DROP TABLE IF EXISTS public.review_reports;
+
+ALTER TABLE public.notifications DROP COLUMN IF EXISTS message;
+
+ALTER TABLE public.review_replies
+    DROP COLUMN IF EXISTS is_hidden,
+    DROP COLUMN IF EXISTS moderation_reason,
+    DROP COLUMN IF EXISTS moderated_at;
+
+ALTER TABLE public.reviews
+    DROP COLUMN IF EXISTS is_hidden,
+    DROP COLUMN IF EXISTS moderation_reason,
+    DROP COLUMN IF EXISTS moderated_at;
@@ -0,0 +1,66 @@
+ALTER TABLE public.reviews
+    ADD COLUMN IF NOT EXISTS is_hidden BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS moderation_reason TEXT,
+    ADD COLUMN IF NOT EXISTS moderated_at TIMESTAMPTZ;
+
+ALTER TABLE public.review_replies
+    ADD COLUMN IF NOT EXISTS is_hidden BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS moderation_reason TEXT,
+    ADD COLUMN IF NOT EXISTS moderated_at TIMESTAMPTZ;
+
+ALTER TABLE public.notifications ADD COLUMN IF NOT EXISTS message TEXT;
+
+CREATE TABLE IF NOT EXISTS public.review_reports (
+        report_id SERIAL PRIMARY KEY,
+        review_id INTEGER NOT NULL REFERENCES reviews(review_id) ON DELETE CASCADE,
+        target VARCHAR(10) NOT NULL DEFAULT 'review', -- 'review' or 'reply'
+        reporter_id INTEGER NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+        reason TEXT NOT NULL,
+        resolution VARCHAR(10), -- moderation action, NULL while report is pending
+        resolved_by INTEGER REFERENCES users(user_id) ON DELETE SET NULL,
+        resolved_at TIMESTAMPTZ,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        CONSTRAINT unique_review_report UNIQUE (review_id, target, reporter_id)
+);
+
+CREATE INDEX IF NOT EXISTS review_reports_pending_idx ON public.review_reports (review_id, target) WHERE resolution IS NULL;
+
+-- Hidden reviews aren't counted in skill and teacher ratings
+CREATE OR REPLACE FUNCTION update_skill_and_teacher_on_review()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        IF NOT NEW.is_hidden THEN
+            PERFORM apply_review_rate_delta(NEW.teacher_id, NEW.skill_id, 1, NEW.rate);
+        END IF;
+
+        RETURN NEW;
+    END IF;
+
+    IF TG_OP = 'DELETE' THEN
+        IF NOT OLD.is_hidden THEN
+            PERFORM apply_review_rate_delta(OLD.teacher_id, OLD.skill_id, -1, -OLD.rate);
+        END IF;
+
+        RETURN OLD;
+    END IF;
+
+    -- UPDATE: remove old review and add new one
+    IF NOT OLD.is_hidden THEN
+        PERFORM apply_review_rate_delta(OLD.teacher_id, OLD.skill_id, -1, -OLD.rate);
+    END IF;
+
+    IF NOT NEW.is_hidden THEN
+        PERFORM apply_review_rate_delta(NEW.teacher_id, NEW.skill_id, 1, NEW.rate);
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS update_skill_and_teacher_on_review ON public.reviews;
+
+CREATE TRIGGER update_skill_and_teacher_on_review
+    AFTER INSERT OR DELETE OR UPDATE OF rate, skill_id, teacher_id, is_hidden ON public.reviews
+    FOR EACH ROW
+EXECUTE FUNCTION update_skill_and_teacher_on_review();
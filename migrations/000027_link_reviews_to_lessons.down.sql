@@ -0,0 +1,16 @@
+ALTER TABLE public.reviews DROP CONSTRAINT IF EXISTS unique_lesson_review;
+
+-- Only the first review of student about teacher's skill can be kept
+DELETE FROM reviews r
+WHERE EXISTS (
+    SELECT 1 FROM reviews o
+    WHERE o.teacher_id = r.teacher_id
+      AND o.student_id = r.student_id
+      AND o.category_id = r.category_id
+      AND o.skill_id = r.skill_id
+      AND o.review_id < r.review_id
+);
+
+ALTER TABLE public.reviews ADD CONSTRAINT unique_review UNIQUE (teacher_id, student_id, category_id, skill_id);
+
+ALTER TABLE public.reviews DROP COLUMN IF EXISTS lesson_id;
@@ -0,0 +1,22 @@
+ALTER TABLE public.reviews ADD COLUMN IF NOT EXISTS lesson_id INTEGER REFERENCES lessons(lesson_id) ON DELETE SET NULL;
+
+-- Link existing reviews to the latest finished lesson of student with teacher on category
+UPDATE reviews r
+SET lesson_id = (
+    SELECT l.lesson_id
+    FROM lessons l
+    INNER JOIN schedule_times st ON st.schedule_time_id = l.schedule_time_id
+    INNER JOIN state_machines_items smi ON smi.item_id = l.state_machine_item_id
+    INNER JOIN states s ON s.state_id = smi.state_id
+    WHERE l.teacher_id = r.teacher_id
+      AND l.student_id = r.student_id
+      AND l.category_id = r.category_id
+      AND s.name = 'finished'
+    ORDER BY st.datetime DESC
+    LIMIT 1
+)
+WHERE r.lesson_id IS NULL;
+
+ALTER TABLE public.reviews DROP CONSTRAINT IF EXISTS unique_review;
+
+ALTER TABLE public.reviews ADD CONSTRAINT unique_lesson_review UNIQUE (lesson_id);
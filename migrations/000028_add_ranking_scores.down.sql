@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS teachers_ranking_score_idx;
+
+ALTER TABLE public.skills DROP COLUMN IF EXISTS ranking_score;
+ALTER TABLE public.teachers DROP COLUMN IF EXISTS ranking_score;
@@ -0,0 +1,5 @@
+-- Bayesian average of reviews rate, recomputed by background job (see ranking service)
+ALTER TABLE public.teachers ADD COLUMN IF NOT EXISTS ranking_score DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE public.skills ADD COLUMN IF NOT EXISTS ranking_score DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS teachers_ranking_score_idx ON public.teachers (ranking_score DESC, teacher_id DESC);
@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS public.complaint_notes;
+
+ALTER TABLE public.complaints
+    DROP COLUMN IF EXISTS state_machine_item_id,
+    DROP COLUMN IF EXISTS assignee_id,
+    DROP COLUMN IF EXISTS resolution,
+    DROP COLUMN IF EXISTS resolved_by,
+    DROP COLUMN IF EXISTS resolved_at;
+
+DELETE FROM public.state_machines_items WHERE state_machine_id = 3;
+DELETE FROM public.state_transitions WHERE state_machine_id = 3;
+DELETE FROM public.state_machines WHERE state_machine_id = 3;
+DELETE FROM public.states WHERE state_id IN (10, 11, 12, 13);
@@ -0,0 +1,55 @@
+INSERT INTO public.states (state_id, name)
+VALUES
+    (10, 'open'), -- complaint
+    (11, 'in_review'), -- complaint
+    (12, 'resolved'), -- complaint
+    (13, 'dismissed') -- complaint
+ON CONFLICT DO NOTHING;
+
+INSERT INTO public.state_machines (state_machine_id, name, start_state_id)
+VALUES
+    (3, 'complaint', 10)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO public.state_transitions (transition_id, state_machine_id, current_state_id, next_state_id)
+VALUES
+    --complaint
+    (14, 3, 10, 11),
+    (15, 3, 10, 13),
+    (16, 3, 11, 12),
+    (17, 3, 11, 13)
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE public.complaints
+    ADD COLUMN IF NOT EXISTS state_machine_item_id INTEGER REFERENCES state_machines_items(item_id),
+    ADD COLUMN IF NOT EXISTS assignee_id INTEGER REFERENCES users(user_id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS resolution TEXT,
+    ADD COLUMN IF NOT EXISTS resolved_by INTEGER REFERENCES users(user_id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS resolved_at TIMESTAMPTZ;
+
+-- existing complaints are open
+DO $$
+    DECLARE
+        c RECORD;
+        new_item_id INTEGER;
+    BEGIN
+        FOR c IN SELECT complaint_id FROM complaints WHERE state_machine_item_id IS NULL LOOP
+            INSERT INTO state_machines_items (state_machine_id, state_id)
+            VALUES (3, 10)
+            RETURNING item_id INTO new_item_id;
+
+            UPDATE complaints SET state_machine_item_id = new_item_id WHERE complaint_id = c.complaint_id;
+        END LOOP;
+    END $$;
+
+ALTER TABLE public.complaints ALTER COLUMN state_machine_item_id SET NOT NULL;
+
+CREATE TABLE IF NOT EXISTS public.complaint_notes (
+        note_id SERIAL PRIMARY KEY,
+        complaint_id INTEGER NOT NULL REFERENCES complaints(complaint_id) ON DELETE CASCADE,
+        author_id INTEGER REFERENCES users(user_id) ON DELETE SET NULL,
+        text TEXT NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS complaint_notes_complaint_idx ON public.complaint_notes (complaint_id);
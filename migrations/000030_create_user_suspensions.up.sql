@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS public.user_suspensions (
+        suspension_id SERIAL PRIMARY KEY,
+        user_id INTEGER NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+        admin_id INTEGER REFERENCES users(user_id) ON DELETE SET NULL,
+        reason TEXT NOT NULL,
+        until TIMESTAMPTZ, -- NULL for permanent ban
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        lifted_at TIMESTAMPTZ,
+        lifted_by INTEGER REFERENCES users(user_id) ON DELETE SET NULL
+);
+
+-- user has at most one not lifted suspension (it is checked on every authorized request)
+CREATE UNIQUE INDEX IF NOT EXISTS user_suspensions_not_lifted_idx
+    ON public.user_suspensions (user_id) WHERE lifted_at IS NULL;
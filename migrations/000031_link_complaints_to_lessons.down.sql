@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS public.complaint_attachments;
+
+ALTER TABLE public.complaints DROP COLUMN IF EXISTS lesson_id;
@@ -0,0 +1,14 @@
+ALTER TABLE public.complaints
+    ADD COLUMN IF NOT EXISTS lesson_id INTEGER REFERENCES lessons(lesson_id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS complaints_lesson_idx ON public.complaints (lesson_id);
+
+-- evidence files (screenshots, documents) of complaint stored in object storage
+CREATE TABLE IF NOT EXISTS public.complaint_attachments (
+        attachment_id SERIAL PRIMARY KEY,
+        complaint_id INTEGER NOT NULL REFERENCES complaints(complaint_id) ON DELETE CASCADE,
+        file_name VARCHAR(255) NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS complaint_attachments_complaint_idx ON public.complaint_attachments (complaint_id);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS public.moderation_queue (
+        item_id SERIAL PRIMARY KEY,
+        kind VARCHAR(32) NOT NULL, -- review, review_reply, complaint, skill_about, teacher_profile, user_name
+        author_id INTEGER REFERENCES users(user_id) ON DELETE CASCADE, -- NULL if author was not registered yet
+        entity_id INTEGER, -- id of flagged entity, NULL if it was not saved yet when flagged
+        text TEXT NOT NULL,
+        reasons TEXT[] NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        decision VARCHAR(16), -- NULL while pending, approved or violation
+        decided_by INTEGER REFERENCES users(user_id) ON DELETE SET NULL,
+        decided_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS moderation_queue_pending_idx
+    ON public.moderation_queue (item_id) WHERE decision IS NULL;
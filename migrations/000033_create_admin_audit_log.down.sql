@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS public.admin_audit_log;
+
+DROP FUNCTION IF EXISTS forbid_admin_audit_log_change();
@@ -0,0 +1,35 @@
+-- admin_id has no foreign key: log must outlive admin's account and must never be updated
+CREATE TABLE IF NOT EXISTS public.admin_audit_log (
+        audit_id BIGSERIAL PRIMARY KEY,
+        admin_id INTEGER NOT NULL,
+        action VARCHAR(64) NOT NULL,
+        entity_type VARCHAR(32) NOT NULL,
+        entity_id INTEGER,
+        before JSONB, -- NULL if entity didn't exist
+        after JSONB, -- NULL if entity was deleted
+        request_id VARCHAR(128) NOT NULL DEFAULT '',
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS admin_audit_log_entity_idx
+    ON public.admin_audit_log (entity_type, entity_id);
+
+CREATE INDEX IF NOT EXISTS admin_audit_log_admin_idx
+    ON public.admin_audit_log (admin_id);
+
+CREATE OR REPLACE FUNCTION forbid_admin_audit_log_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'admin_audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER admin_audit_log_append_only
+    BEFORE UPDATE OR DELETE ON public.admin_audit_log
+    FOR EACH ROW
+    EXECUTE FUNCTION forbid_admin_audit_log_change();
+
+CREATE TRIGGER admin_audit_log_no_truncate
+    BEFORE TRUNCATE ON public.admin_audit_log
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION forbid_admin_audit_log_change();
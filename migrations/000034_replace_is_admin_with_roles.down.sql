@@ -0,0 +1,11 @@
+ALTER TABLE public.users ADD COLUMN IF NOT EXISTS is_admin BOOLEAN DEFAULT FALSE NOT NULL;
+
+-- any role gave access to admin panel
+UPDATE public.users
+SET is_admin = TRUE
+WHERE user_id IN (SELECT user_id FROM user_roles);
+
+DROP TABLE IF EXISTS public.user_roles;
+DROP TABLE IF EXISTS public.role_permissions;
+DROP TABLE IF EXISTS public.permissions;
+DROP TABLE IF EXISTS public.roles;
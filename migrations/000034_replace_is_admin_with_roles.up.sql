@@ -0,0 +1,72 @@
+CREATE TABLE IF NOT EXISTS public.roles (
+        role_id SERIAL PRIMARY KEY,
+        name VARCHAR(32) NOT NULL UNIQUE,
+        description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS public.permissions (
+        permission_id SERIAL PRIMARY KEY,
+        name VARCHAR(64) NOT NULL UNIQUE,
+        description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS public.role_permissions (
+        role_id INTEGER NOT NULL REFERENCES roles(role_id) ON DELETE CASCADE,
+        permission_id INTEGER NOT NULL REFERENCES permissions(permission_id) ON DELETE CASCADE,
+        PRIMARY KEY (role_id, permission_id)
+);
+
+CREATE TABLE IF NOT EXISTS public.user_roles (
+        user_id INTEGER NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+        role_id INTEGER NOT NULL REFERENCES roles(role_id) ON DELETE CASCADE,
+        granted_by INTEGER REFERENCES users(user_id) ON DELETE SET NULL,
+        granted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        PRIMARY KEY (user_id, role_id)
+);
+
+INSERT INTO public.roles (name, description) VALUES
+    ('moderator', 'moderates user content, skills, certificates and users'),
+    ('support', 'handles complaints of users'),
+    ('finance', 'sees ratings, audit and money related data'),
+    ('superadmin', 'has every permission and manages roles');
+
+INSERT INTO public.permissions (name, description) VALUES
+    ('skills.view', 'see skills of teachers'),
+    ('skills.approve', 'approve skills of teachers'),
+    ('certificates.view', 'see unverified certificates of teachers'),
+    ('certificates.verify', 'verify certificates of teachers'),
+    ('categories.manage', 'create, change, archive and reorder categories, manage age exceptions'),
+    ('complaints.view', 'see complaints with notes and attachments'),
+    ('complaints.manage', 'assign complaints and add notes to them'),
+    ('complaints.resolve', 'resolve and dismiss complaints'),
+    ('reviews.moderate', 'hide, restore and delete reported reviews'),
+    ('ratings.repair', 'recompute rating aggregates'),
+    ('content.moderate', 'decide on texts flagged by moderation'),
+    ('users.suspend', 'suspend, ban and lift suspensions of users'),
+    ('audit.view', 'see and export admin audit log'),
+    ('roles.manage', 'assign roles to users and revoke them');
+
+INSERT INTO public.role_permissions (role_id, permission_id)
+SELECT r.role_id, p.permission_id
+FROM roles r
+JOIN permissions p ON
+    (r.name = 'moderator' AND p.name IN (
+        'skills.view', 'skills.approve', 'certificates.view', 'certificates.verify',
+        'complaints.view', 'reviews.moderate', 'content.moderate', 'users.suspend'
+    ))
+    OR (r.name = 'support' AND p.name IN (
+        'complaints.view', 'complaints.manage', 'complaints.resolve', 'users.suspend'
+    ))
+    OR (r.name = 'finance' AND p.name IN (
+        'skills.view', 'ratings.repair', 'audit.view'
+    ))
+    OR r.name = 'superadmin';
+
+-- former admins become superadmins
+INSERT INTO public.user_roles (user_id, role_id)
+SELECT u.user_id, r.role_id
+FROM users u
+JOIN roles r ON r.name = 'superadmin'
+WHERE u.is_admin;
+
+ALTER TABLE public.users DROP COLUMN IF EXISTS is_admin;
@@ -0,0 +1,5 @@
+DELETE FROM public.permissions WHERE name IN ('users.view', 'users.manage', 'users.merge');
+
+DROP INDEX IF EXISTS users_email_lower_idx;
+
+ALTER TABLE public.users DROP COLUMN IF EXISTS email_verification_required;
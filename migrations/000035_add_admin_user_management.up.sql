@@ -0,0 +1,17 @@
+-- admin can make user confirm his email again
+ALTER TABLE public.users
+    ADD COLUMN IF NOT EXISTS email_verification_required BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS users_email_lower_idx ON public.users (LOWER(email));
+
+INSERT INTO public.permissions (name, description) VALUES
+    ('users.view', 'search users and see their lessons, skills, complaints and sanctions'),
+    ('users.manage', 'reset avatars of users and make them verify email again'),
+    ('users.merge', 'merge duplicate accounts of users');
+
+INSERT INTO public.role_permissions (role_id, permission_id)
+SELECT r.role_id, p.permission_id
+FROM roles r
+JOIN permissions p ON
+    (r.name IN ('moderator', 'support') AND p.name IN ('users.view', 'users.manage'))
+    OR (r.name = 'superadmin' AND p.name IN ('users.view', 'users.manage', 'users.merge'));
@@ -0,0 +1,14 @@
+DELETE FROM public.permissions WHERE name = 'analytics.view';
+
+DROP TABLE IF EXISTS public.category_daily_stats;
+DROP TABLE IF EXISTS public.platform_daily_stats;
+
+DROP TRIGGER IF EXISTS track_skill_approval ON public.skills;
+DROP FUNCTION IF EXISTS track_skill_approval();
+DROP TABLE IF EXISTS public.skill_approvals;
+
+DROP INDEX IF EXISTS teachers_created_at_idx;
+DROP INDEX IF EXISTS users_registration_date_idx;
+
+ALTER TABLE public.skills DROP COLUMN IF EXISTS submitted_at;
+ALTER TABLE public.teachers DROP COLUMN IF EXISTS created_at;
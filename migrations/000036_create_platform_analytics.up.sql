@@ -0,0 +1,73 @@
+-- creation times are unknown (NULL) for rows created before
+ALTER TABLE public.teachers ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ;
+ALTER TABLE public.teachers ALTER COLUMN created_at SET DEFAULT NOW();
+
+-- time when skill was sent to approval (created or changed after approval),
+-- unknown (NULL) for skills created before, so they don't count into approval time
+ALTER TABLE public.skills ADD COLUMN IF NOT EXISTS submitted_at TIMESTAMPTZ;
+ALTER TABLE public.skills ALTER COLUMN submitted_at SET DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS users_registration_date_idx ON public.users (registration_date);
+CREATE INDEX IF NOT EXISTS teachers_created_at_idx ON public.teachers (created_at);
+
+-- Log of skill approvals, approval time is approved_at - submitted_at
+CREATE TABLE IF NOT EXISTS public.skill_approvals (
+        approval_id BIGSERIAL PRIMARY KEY,
+        skill_id INTEGER NOT NULL REFERENCES skills(skill_id) ON DELETE CASCADE,
+        submitted_at TIMESTAMPTZ,
+        approved_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS skill_approvals_approved_at_idx ON public.skill_approvals (approved_at);
+
+CREATE OR REPLACE FUNCTION track_skill_approval()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF OLD.is_active AND NOT NEW.is_active THEN
+        -- skill is sent to approval again
+        NEW.submitted_at := NOW();
+    ELSIF NOT OLD.is_active AND NEW.is_active THEN
+        INSERT INTO skill_approvals (skill_id, submitted_at) VALUES (NEW.skill_id, NEW.submitted_at);
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS track_skill_approval ON public.skills;
+
+CREATE TRIGGER track_skill_approval
+    BEFORE UPDATE OF is_active ON public.skills
+    FOR EACH ROW
+EXECUTE FUNCTION track_skill_approval();
+
+-- Rollups of platform events by day (UTC) of event.
+-- Maintained by the analytics background job.
+CREATE TABLE IF NOT EXISTS public.platform_daily_stats (
+        day DATE PRIMARY KEY,
+        signups INTEGER NOT NULL DEFAULT 0,
+        new_teachers INTEGER NOT NULL DEFAULT 0,
+        skills_approved INTEGER NOT NULL DEFAULT 0
+);
+
+-- lessons are counted by day of their state changes (booking, start, finish, cancel, reject)
+CREATE TABLE IF NOT EXISTS public.category_daily_stats (
+        category_id INTEGER NOT NULL REFERENCES categories(category_id) ON DELETE CASCADE,
+        day DATE NOT NULL,
+        lessons_booked INTEGER NOT NULL DEFAULT 0,
+        lessons_started INTEGER NOT NULL DEFAULT 0,
+        lessons_completed INTEGER NOT NULL DEFAULT 0,
+        lessons_cancelled INTEGER NOT NULL DEFAULT 0,
+        lessons_rejected INTEGER NOT NULL DEFAULT 0,
+        gmv BIGINT NOT NULL DEFAULT 0, -- sum of prices of completed lessons
+        PRIMARY KEY (day, category_id)
+);
+
+INSERT INTO public.permissions (name, description) VALUES
+    ('analytics.view', 'see platform analytics');
+
+INSERT INTO public.role_permissions (role_id, permission_id)
+SELECT r.role_id, p.permission_id
+FROM roles r
+JOIN permissions p ON p.name = 'analytics.view'
+WHERE r.name IN ('finance', 'superadmin');
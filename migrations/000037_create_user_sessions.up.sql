@@ -0,0 +1,24 @@
+-- Login session of user (one device), access tokens carry its id and are rejected once it's revoked
+CREATE TABLE IF NOT EXISTS public.user_sessions (
+        session_id BIGSERIAL PRIMARY KEY,
+        user_id INTEGER NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        last_refreshed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        expires_at TIMESTAMPTZ NOT NULL, -- expiration of the newest refresh token
+        revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS user_sessions_user_id_idx ON public.user_sessions (user_id);
+
+-- Refresh tokens of session (only sha256 hashes are stored), every token is used once and replaced by the next one.
+-- Used tokens are kept until they expire: presenting used token again means it was stolen and revokes the session
+CREATE TABLE IF NOT EXISTS public.refresh_tokens (
+        token_id BIGSERIAL PRIMARY KEY,
+        session_id BIGINT NOT NULL REFERENCES user_sessions(session_id) ON DELETE CASCADE,
+        token_hash TEXT NOT NULL UNIQUE,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        expires_at TIMESTAMPTZ NOT NULL,
+        used_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS refresh_tokens_session_id_idx ON public.refresh_tokens (session_id);
@@ -0,0 +1,3 @@
+ALTER TABLE public.users
+    DROP COLUMN IF EXISTS email_verification_expires_at,
+    DROP COLUMN IF EXISTS email_verification_token_hash;
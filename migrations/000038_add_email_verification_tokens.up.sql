@@ -0,0 +1,4 @@
+-- token of email verification required by admin (only sha256 hash is stored), user confirms email with it
+ALTER TABLE public.users
+    ADD COLUMN IF NOT EXISTS email_verification_token_hash TEXT UNIQUE,
+    ADD COLUMN IF NOT EXISTS email_verification_expires_at TIMESTAMPTZ;
@@ -0,0 +1 @@
+-- Links of reviews to lessons are kept, they are valid for the previous version too
@@ -0,0 +1,18 @@
+-- Reviews can be left on every finished lesson (finished, conflicted or completed),
+-- so link remaining existing reviews to the latest such lesson not reviewed yet
+UPDATE reviews r
+SET lesson_id = (
+    SELECT l.lesson_id
+    FROM lessons l
+    INNER JOIN schedule_times st ON st.schedule_time_id = l.schedule_time_id
+    INNER JOIN state_machines_items smi ON smi.item_id = l.state_machine_item_id
+    INNER JOIN states s ON s.state_id = smi.state_id
+    WHERE l.teacher_id = r.teacher_id
+      AND l.student_id = r.student_id
+      AND l.category_id = r.category_id
+      AND s.name IN ('finished', 'conflicted', 'completed')
+      AND NOT EXISTS (SELECT 1 FROM reviews lr WHERE lr.lesson_id = l.lesson_id)
+    ORDER BY st.datetime DESC
+    LIMIT 1
+)
+WHERE r.lesson_id IS NULL;
@@ -0,0 +1,76 @@
+-- Rebuild search document of one teacher: user name and surname, headline, names of categories,
+-- "about" texts of active skills, bio, languages, education and certificates titles.
+-- Names are indexed with 'simple' configuration (no stemming, good for autocomplete),
+-- texts are indexed with both 'english' (stemming) and 'simple' (prefix of raw words).
+CREATE OR REPLACE FUNCTION refresh_teacher_search_vector(p_teacher_id INTEGER)
+    RETURNS VOID AS $$
+DECLARE
+    v_names        TEXT;
+    v_headline     TEXT;
+    v_bio          TEXT;
+    v_categories   TEXT;
+    v_about        TEXT;
+    v_languages    TEXT;
+    v_education    TEXT;
+    v_certificates TEXT;
+BEGIN
+    SELECT concat_ws(' ', u.name, u.surname), t.headline, t.bio
+    INTO v_names, v_headline, v_bio
+    FROM teachers t
+    INNER JOIN users u ON u.user_id = t.user_id
+    WHERE t.teacher_id = p_teacher_id;
+
+    SELECT
+        COALESCE(string_agg(c.name, ' '), ''),
+        COALESCE(string_agg(s.about, ' '), '')
+    INTO v_categories, v_about
+    FROM skills s
+    INNER JOIN categories c ON c.category_id = s.category_id
+    WHERE s.teacher_id = p_teacher_id
+      AND s.is_active;
+
+    SELECT COALESCE(string_agg(language, ' '), '')
+    INTO v_languages
+    FROM teacher_languages
+    WHERE teacher_id = p_teacher_id;
+
+    SELECT COALESCE(string_agg(concat_ws(' ', institution, degree, field_of_study), ' '), '')
+    INTO v_education
+    FROM teacher_educations
+    WHERE teacher_id = p_teacher_id;
+
+    SELECT COALESCE(string_agg(title, ' '), '')
+    INTO v_certificates
+    FROM teacher_certificates
+    WHERE teacher_id = p_teacher_id;
+
+    v_headline := COALESCE(v_headline, '');
+    v_bio := concat_ws(' ', v_bio, v_education, v_certificates);
+
+    UPDATE teachers
+    SET
+        search_document = concat_ws(' ', v_names, v_headline, v_categories, v_languages, v_about, v_bio),
+        search_vector =
+            setweight(to_tsvector('simple', COALESCE(v_names, '')), 'A') ||
+            setweight(to_tsvector('simple', v_categories), 'A') ||
+            setweight(to_tsvector('english', v_categories), 'A') ||
+            setweight(to_tsvector('english', v_headline), 'B') ||
+            setweight(to_tsvector('simple', v_languages), 'B') ||
+            setweight(to_tsvector('english', v_about), 'B') ||
+            setweight(to_tsvector('simple', v_headline), 'C') ||
+            setweight(to_tsvector('simple', v_about), 'C') ||
+            setweight(to_tsvector('english', v_bio), 'C') ||
+            setweight(to_tsvector('simple', v_bio), 'D')
+    WHERE teacher_id = p_teacher_id;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS refresh_search_vector_on_teacher_certificate ON teacher_certificates;
+
+CREATE TRIGGER refresh_search_vector_on_teacher_certificate
+    AFTER INSERT OR DELETE OR UPDATE OF title, teacher_id ON teacher_certificates
+    FOR EACH ROW
+EXECUTE FUNCTION refresh_teacher_search_vector_on_profile_item();
+
+SELECT refresh_teacher_search_vector(teacher_id)
+FROM (SELECT DISTINCT teacher_id FROM teacher_certificates WHERE NOT is_verified) t;
@@ -9,8 +9,9 @@ import (
 )
 
 const (
-	UserIDKey  = "user_id"
-	defaultTTL = time.Hour * 24 * 7
+	UserIDKey    = "user_id"
+	SessionIDKey = "sid"
+	defaultTTL   = time.Minute * 15
 )
 
 var ErrorTokenExpired = errors.New("token is expired")
@@ -49,17 +50,18 @@ func NewService(secretKey string, opts ...Option) *JWTService {
 	return s
 }
 
-// GenerateJWTToken creates a JWT token for a user.
-func (s *JWTService) GenerateJWTToken(userID int) (string, error) {
+// GenerateJWTToken creates a JWT access token for a user's session, returns token and its expiration time.
+func (s *JWTService) GenerateJWTToken(userID, sessionID int) (string, time.Time, error) {
 	// Set token expiration time
 	expirationTime := time.Now().Add(s.duration)
 
 	// Create claims
 	claims := jwt.MapClaims{
-		UserIDKey: userID,
-		"exp":     expirationTime.Unix(),
-		"iat":     time.Now().Unix(),
-		"iss":     s.issuer,
+		UserIDKey:    userID,
+		SessionIDKey: sessionID,
+		"exp":        expirationTime.Unix(),
+		"iat":        time.Now().Unix(),
+		"iss":        s.issuer,
 	}
 
 	// Create token with signing algorithm
@@ -68,10 +70,10 @@ func (s *JWTService) GenerateJWTToken(userID int) (string, error) {
 	// Sign the token with secret key
 	tokenString, err := token.SignedString(s.secretKey)
 	if err != nil {
-		return "", fmt.Errorf("failed to generate token: %w", err)
+		return "", time.Time{}, fmt.Errorf("failed to generate token: %w", err)
 	}
 
-	return tokenString, nil
+	return tokenString, expirationTime, nil
 }
 
 // ValidateJWTToken validates the JWT token.
@@ -112,6 +114,16 @@ func (s *JWTService) ExtractUserID(claims jwt.MapClaims) (int, error) {
 	return int(userID), nil
 }
 
+// ExtractSessionID extracts session ID from claims.
+func (s *JWTService) ExtractSessionID(claims jwt.MapClaims) (int, error) {
+	sessionID, ok := claims[SessionIDKey].(float64)
+	if !ok {
+		return 0, errors.New("invalid or missing session ID in claims")
+	}
+
+	return int(sessionID), nil
+}
+
 func (s *JWTService) GetUserKey() string {
 	return UserIDKey
 }
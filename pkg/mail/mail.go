@@ -0,0 +1,62 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+)
+
+type Config struct {
+	Host     string `env:"SMTP_HOST"     env-default:""` // empty disables sending
+	Port     int    `env:"SMTP_PORT"     env-default:"587"`
+	Username string `env:"SMTP_USERNAME" env-default:""`
+	Password string `env:"SMTP_PASSWORD" env-default:""`
+	From     string `env:"SMTP_FROM"     env-default:""`
+}
+
+// Sender sends plain text letters through SMTP server.
+type Sender struct {
+	config Config
+}
+
+func NewSender(config Config) *Sender {
+	return &Sender{
+		config: config,
+	}
+}
+
+// Send sends plain text letter to address, it fails if SMTP server is not configured.
+func (s *Sender) Send(_ context.Context, to, subject, body string) error {
+	if s.config.Host == "" {
+		return fmt.Errorf("failed to send letter: smtp server is not configured") //nolint:err113
+	}
+
+	// header injection through address or subject is not possible
+	if strings.ContainsAny(to+subject, "\r\n") {
+		return fmt.Errorf("failed to send letter: invalid address or subject") //nolint:err113
+	}
+
+	var auth smtp.Auth
+	if s.config.Username != "" {
+		auth = smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+	}
+
+	message := "From: " + s.config.From + "\r\n" +
+		"To: " + to + "\r\n" +
+		"Subject: " + subject + "\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n" +
+		"\r\n" +
+		strings.ReplaceAll(body, "\n", "\r\n")
+
+	address := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+
+	if err := smtp.SendMail(address, auth, s.config.From, []string{to}, []byte(message)); err != nil {
+		return fmt.Errorf("failed to send letter: %w", err)
+	}
+
+	return nil
+}
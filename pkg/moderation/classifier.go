@@ -0,0 +1,58 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+)
+
+// Classifier estimates text, e.g. with ML model or external moderation API.
+type Classifier interface {
+	// Classify returns scores from 0 to 1 of text by label (e.g. "toxic", "spam").
+	Classify(ctx context.Context, text Text) (map[string]float64, error)
+}
+
+// ClassifierChecker gives verdict to text by scores of classifier.
+type ClassifierChecker struct {
+	classifier      Classifier
+	flagThreshold   float64
+	rejectThreshold float64
+}
+
+// NewClassifierChecker creates checker which flags text if any score is at least flagThreshold
+// and rejects it if any score is at least rejectThreshold.
+func NewClassifierChecker(classifier Classifier, flagThreshold, rejectThreshold float64) *ClassifierChecker {
+	return &ClassifierChecker{
+		classifier:      classifier,
+		flagThreshold:   flagThreshold,
+		rejectThreshold: rejectThreshold,
+	}
+}
+
+func (c *ClassifierChecker) Check(ctx context.Context, text Text) (Result, error) {
+	scores, err := c.classifier.Classify(ctx, text)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to classify text: %w", err)
+	}
+
+	result := Result{Verdict: Allow}
+
+	for label, score := range scores {
+		verdict := Allow
+
+		switch {
+		case score >= c.rejectThreshold:
+			verdict = Reject
+		case score >= c.flagThreshold:
+			verdict = Flag
+		}
+
+		if verdict == Allow {
+			continue
+		}
+
+		result.Verdict = max(result.Verdict, verdict)
+		result.Reasons = append(result.Reasons, fmt.Sprintf("classified as %s (%.2f)", label, score))
+	}
+
+	return result, nil
+}
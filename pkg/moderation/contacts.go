@@ -0,0 +1,51 @@
+package moderation
+
+import (
+	"context"
+	"regexp"
+)
+
+var (
+	linkPattern  = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+|\b[a-z0-9-]+(?:\.[a-z0-9-]+)*\.(?:com|net|org|io|me|ru|info|biz|co|app|link|ly)\b(?:/\S*)?`)
+	emailPattern = regexp.MustCompile(`(?i)\b[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,}\b`)
+	// phonePattern matches 9 to 15 digits with optional "+" and separators between them.
+	phonePattern = regexp.MustCompile(`\+?\d(?:[\s().-]*\d){8,14}`)
+)
+
+// ContactsChecker detects links, emails and phone numbers in text,
+// it's used to stop users from moving deals off the platform.
+type ContactsChecker struct {
+	verdict Verdict
+}
+
+// NewContactsChecker creates checker which gives verdict to text with contacts.
+func NewContactsChecker(verdict Verdict) *ContactsChecker {
+	return &ContactsChecker{
+		verdict: verdict,
+	}
+}
+
+func (c *ContactsChecker) Check(_ context.Context, text Text) (Result, error) {
+	result := Result{Verdict: Allow}
+
+	// emails are checked before links because domain of email looks like link
+	body := text.Body
+	if emailPattern.MatchString(body) {
+		result.Reasons = append(result.Reasons, "contains email")
+		body = emailPattern.ReplaceAllString(body, " ")
+	}
+
+	if linkPattern.MatchString(body) {
+		result.Reasons = append(result.Reasons, "contains link")
+	}
+
+	if phonePattern.MatchString(body) {
+		result.Reasons = append(result.Reasons, "contains phone number")
+	}
+
+	if len(result.Reasons) > 0 {
+		result.Verdict = c.verdict
+	}
+
+	return result, nil
+}
@@ -0,0 +1,53 @@
+package moderation
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+type languageKey struct{}
+
+// WithLanguage returns copy of ctx carrying language code of texts moderated within it.
+func WithLanguage(ctx context.Context, language string) context.Context {
+	return context.WithValue(ctx, languageKey{}, language)
+}
+
+// LanguageFromContext returns language code put into ctx by WithLanguage, empty if there is none.
+func LanguageFromContext(ctx context.Context) string {
+	language, _ := ctx.Value(languageKey{}).(string)
+
+	return language
+}
+
+// ParseAcceptLanguage returns primary code of the most preferred language from Accept-Language header value
+// ("en" for "en-US,ru;q=0.8"), empty if there is no such language.
+func ParseAcceptLanguage(header string) string {
+	var (
+		language string
+		bestQ    float64
+	)
+
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+
+			q = parsed
+		}
+
+		primary, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+		if primary == "" || primary == "*" || q <= bestQ {
+			continue
+		}
+
+		language, bestQ = strings.ToLower(primary), q
+	}
+
+	return language
+}
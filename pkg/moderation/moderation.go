@@ -0,0 +1,98 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+)
+
+// Verdict is a decision of moderation about text.
+type Verdict int
+
+// Verdicts are ordered by strictness, the strictest one wins in Pipeline.
+const (
+	Allow Verdict = iota
+	Flag
+	Reject
+)
+
+func (v Verdict) String() string {
+	switch v {
+	case Allow:
+		return "allow"
+	case Flag:
+		return "flag"
+	case Reject:
+		return "reject"
+	default:
+		return fmt.Sprintf("verdict(%d)", int(v))
+	}
+}
+
+// ParseVerdict parses verdict from its string representation.
+func ParseVerdict(s string) (Verdict, error) {
+	switch s {
+	case "allow":
+		return Allow, nil
+	case "flag":
+		return Flag, nil
+	case "reject":
+		return Reject, nil
+	default:
+		return Allow, fmt.Errorf("unknown moderation verdict: %q", s) //nolint:err113
+	}
+}
+
+// Text is a text to moderate.
+type Text struct {
+	// Language is a code of text language (e.g. "en"), empty if unknown.
+	Language string
+	Body     string
+}
+
+// Result is a decision of moderation with reasons of it.
+type Result struct {
+	Verdict Verdict
+	Reasons []string
+}
+
+// Checker checks text and returns its verdict.
+type Checker interface {
+	Check(ctx context.Context, text Text) (Result, error)
+}
+
+// Pipeline passes text through all checkers and returns the strictest verdict
+// with reasons of all checkers that didn't allow text. It stops on first reject.
+type Pipeline struct {
+	checkers []Checker
+}
+
+// NewPipeline creates pipeline of checkers, they are called in given order.
+func NewPipeline(checkers ...Checker) *Pipeline {
+	return &Pipeline{
+		checkers: checkers,
+	}
+}
+
+func (p *Pipeline) Check(ctx context.Context, text Text) (Result, error) {
+	result := Result{Verdict: Allow}
+
+	for _, checker := range p.checkers {
+		checkerResult, err := checker.Check(ctx, text)
+		if err != nil {
+			return Result{}, err
+		}
+
+		if checkerResult.Verdict == Allow {
+			continue
+		}
+
+		result.Verdict = max(result.Verdict, checkerResult.Verdict)
+		result.Reasons = append(result.Reasons, checkerResult.Reasons...)
+
+		if result.Verdict == Reject {
+			break
+		}
+	}
+
+	return result, nil
+}
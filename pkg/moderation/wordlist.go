@@ -0,0 +1,157 @@
+package moderation
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+const (
+	rejectListSuffix = ".reject.txt"
+	flagListSuffix   = ".flag.txt"
+)
+
+// WordList contains forbidden words of one language by verdict they lead to.
+type WordList struct {
+	Reject []string
+	Flag   []string
+}
+
+// WordListChecker checks text on forbidden words. Words are matched as whole words case-insensitively,
+// text with unknown language is checked with lists of all languages.
+type WordListChecker struct {
+	// words are verdicts of words by language
+	words map[string]map[string]Verdict
+}
+
+// NewWordListChecker creates checker from word lists by language code.
+func NewWordListChecker(lists map[string]WordList) *WordListChecker {
+	words := make(map[string]map[string]Verdict, len(lists))
+
+	for language, list := range lists {
+		languageWords := make(map[string]Verdict, len(list.Reject)+len(list.Flag))
+
+		for _, word := range list.Flag {
+			languageWords[strings.ToLower(word)] = Flag
+		}
+
+		// reject list wins if word is in both lists
+		for _, word := range list.Reject {
+			languageWords[strings.ToLower(word)] = Reject
+		}
+
+		words[strings.ToLower(language)] = languageWords
+	}
+
+	return &WordListChecker{
+		words: words,
+	}
+}
+
+// LoadWordLists reads word lists from dir, files are named "<language>.reject.txt" and "<language>.flag.txt"
+// and contain one word per line, empty lines and lines starting with "#" are skipped.
+func LoadWordLists(dir string) (map[string]WordList, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read word lists dir: %w", err)
+	}
+
+	lists := make(map[string]WordList)
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		name := entry.Name()
+
+		var (
+			language string
+			isReject bool
+		)
+
+		switch {
+		case strings.HasSuffix(name, rejectListSuffix):
+			language, isReject = strings.TrimSuffix(name, rejectListSuffix), true
+		case strings.HasSuffix(name, flagListSuffix):
+			language = strings.TrimSuffix(name, flagListSuffix)
+		default:
+			continue
+		}
+
+		words, err := readWords(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+
+		list := lists[language]
+		if isReject {
+			list.Reject = append(list.Reject, words...)
+		} else {
+			list.Flag = append(list.Flag, words...)
+		}
+
+		lists[language] = list
+	}
+
+	return lists, nil
+}
+
+func readWords(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open word list %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var words []string
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+
+		words = append(words, word)
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read word list %s: %w", path, err)
+	}
+
+	return words, nil
+}
+
+func (c *WordListChecker) Check(_ context.Context, text Text) (Result, error) {
+	lists := c.words
+	if languageWords, ok := c.words[strings.ToLower(text.Language)]; ok {
+		lists = map[string]map[string]Verdict{strings.ToLower(text.Language): languageWords}
+	}
+
+	result := Result{Verdict: Allow}
+	found := make(map[string]bool)
+
+	tokens := strings.FieldsFunc(strings.ToLower(text.Body), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	for _, token := range tokens {
+		for _, languageWords := range lists {
+			verdict, ok := languageWords[token]
+			if !ok || found[token] {
+				continue
+			}
+
+			found[token] = true
+			result.Verdict = max(result.Verdict, verdict)
+			result.Reasons = append(result.Reasons, fmt.Sprintf("forbidden word %q", token))
+		}
+	}
+
+	return result, nil
+}
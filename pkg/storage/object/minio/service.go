@@ -63,3 +63,11 @@ func (s *Service) IsFileExists(ctx context.Context, fileName string) (bool, erro
 
 	return true, nil
 }
+
+func (s *Service) DeleteFile(ctx context.Context, fileName string) error {
+	if err := s.client.RemoveObject(ctx, s.bucket, fileName, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+
+	return nil
+}